@@ -1,29 +1,139 @@
 package aws
 
 import (
+	"errors"
+	"io"
+	"io/ioutil"
 	"log"
-	"os"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
+	v4 "github.com/aws/aws-sdk-go/aws/signer/v4"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 
 	"github.com/gold-kou/ToeBeans/backend/app"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
 )
 
-func UploadObject(bucket, filename string, file *os.File) (*s3manager.UploadOutput, error) {
+var ErrObjectNotFound = errors.New("object not found")
+
+// the clients are shared because creating a session loads the configuration and the credentials every time
+var (
+	s3ClientOnce          sync.Once
+	s3ClientShared        *s3.S3
+	presignS3ClientOnce   sync.Once
+	presignS3ClientShared *s3.S3
+)
+
+func s3Client() *s3.S3 {
+	s3ClientOnce.Do(func() {
+		s3ClientShared = s3.New(session.Must(session.NewSession(generateS3Config())))
+	})
+	return s3ClientShared
+}
+
+func presignS3Client() *s3.S3 {
+	presignS3ClientOnce.Do(func() {
+		presignS3ClientShared = s3.New(session.Must(session.NewSession(generatePresignS3Config())))
+	})
+	return presignS3ClientShared
+}
+
+func UploadObject(bucket, filename string, body io.Reader, contentType, cacheControl string) (*s3manager.UploadOutput, error) {
 	// TODO デバッグコードなので検証後に削除する
 	log.Println(bucket)
-	sess := session.Must(session.NewSession(generateS3Config()))
-	uploader := s3manager.NewUploader(sess)
+	uploader := s3manager.NewUploaderWithClient(s3Client())
 	return uploader.Upload(&s3manager.UploadInput{
-		ACL:    aws.String("public-read"),
+		Bucket:       aws.String(bucket),
+		Key:          aws.String(filename),
+		Body:         body,
+		ContentType:  aws.String(contentType),
+		CacheControl: aws.String(cacheControl),
+	})
+}
+
+func GetObject(bucket, filename string) ([]byte, error) {
+	svc := s3Client()
+	o, err := svc.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(filename),
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer o.Body.Close()
+	return ioutil.ReadAll(o.Body)
+}
+
+// HeadObject returns the object without its content. ErrObjectNotFound is returned when it doesn't exist.
+func HeadObject(bucket, filename string) (object model.StoredObject, err error) {
+	svc := s3Client()
+	o, err := svc.HeadObject(&s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(filename),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == "NotFound" {
+			err = ErrObjectNotFound
+		}
+		return
+	}
+	object = model.StoredObject{
+		Key:          filename,
+		Size:         aws.Int64Value(o.ContentLength),
+		LastModified: aws.TimeValue(o.LastModified),
+	}
+	return
+}
+
+// PresignPutObject returns a URL with which a client can put an object of the content type and length until it expires.
+func PresignPutObject(bucket, filename, contentType string, contentLength int64, expires time.Duration) (string, error) {
+	svc := presignS3Client()
+	req, _ := svc.PutObjectRequest(&s3.PutObjectInput{
+		Bucket:        aws.String(bucket),
+		Key:           aws.String(filename),
+		ContentType:   aws.String(contentType),
+		ContentLength: aws.Int64(contentLength),
+	})
+	return req.Presign(expires)
+}
+
+// PresignGetObject returns a URL with which anyone can get the object until expires passes from signedAt.
+// The URL is the same as long as signedAt is, so that browsers can cache the object by the URL.
+func PresignGetObject(bucket, filename string, signedAt time.Time, expires time.Duration) (string, error) {
+	svc := presignS3Client()
+	req, _ := svc.GetObjectRequest(&s3.GetObjectInput{
 		Bucket: aws.String(bucket),
 		Key:    aws.String(filename),
-		Body:   file,
 	})
+	req.Handlers.Sign.Swap(v4.SignRequestHandler.Name, request.NamedHandler{
+		Name: v4.SignRequestHandler.Name,
+		Fn: func(r *request.Request) {
+			v4.SignSDKRequestWithCurrentTime(r, func() time.Time { return signedAt })
+		},
+	})
+	return req.Presign(expires)
+}
+
+// CopyObject copies the object in the bucket and replaces its metadata.
+func CopyObject(bucket, srcFilename, dstFilename, contentType, cacheControl string) (err error) {
+	svc := s3Client()
+	_, err = svc.CopyObject(&s3.CopyObjectInput{
+		Bucket:            aws.String(bucket),
+		CopySource:        aws.String(strings.Trim(bucket, "/") + "/" + srcFilename),
+		Key:               aws.String(dstFilename),
+		ContentType:       aws.String(contentType),
+		CacheControl:      aws.String(cacheControl),
+		MetadataDirective: aws.String(s3.MetadataDirectiveReplace),
+	})
+	return
 }
 
 // func UploadObject(bucket, filename string, file []byte) (*s3.PutObjectOutput, error) {
@@ -38,8 +148,7 @@ func UploadObject(bucket, filename string, file *os.File) (*s3manager.UploadOutp
 // }
 
 func DeleteObject(bucket, filename string) (err error) {
-	sess := session.Must(session.NewSession(generateS3Config()))
-	svc := s3.New(sess)
+	svc := s3Client()
 	_, err = svc.DeleteObject(&s3.DeleteObjectInput{
 		Bucket: aws.String(bucket),
 		Key:    aws.String(filename),
@@ -54,6 +163,60 @@ func DeleteObject(bucket, filename string) (err error) {
 	return
 }
 
+// BlockPublicAccess makes S3 ignore the public ACLs of the objects and reject new public ACLs and policies of the bucket.
+func BlockPublicAccess(bucket string) (err error) {
+	svc := s3Client()
+	_, err = svc.PutPublicAccessBlock(&s3.PutPublicAccessBlockInput{
+		Bucket: aws.String(bucket),
+		PublicAccessBlockConfiguration: &s3.PublicAccessBlockConfiguration{
+			BlockPublicAcls:       aws.Bool(true),
+			IgnorePublicAcls:      aws.Bool(true),
+			BlockPublicPolicy:     aws.Bool(true),
+			RestrictPublicBuckets: aws.Bool(true),
+		},
+	})
+	return
+}
+
+// PutObjectACLPrivate drops the grants other than the owner's from the object.
+func PutObjectACLPrivate(bucket, filename string) (err error) {
+	svc := s3Client()
+	_, err = svc.PutObjectAcl(&s3.PutObjectAclInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(filename),
+		ACL:    aws.String(s3.ObjectCannedACLPrivate),
+	})
+	return
+}
+
+// ListObjects returns all the objects in the bucket.
+func ListObjects(bucket string) (objects []model.StoredObject, err error) {
+	svc := s3Client()
+	err = svc.ListObjectsV2Pages(&s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, o := range page.Contents {
+			objects = append(objects, model.StoredObject{
+				Key:          aws.StringValue(o.Key),
+				Size:         aws.Int64Value(o.Size),
+				LastModified: aws.TimeValue(o.LastModified),
+			})
+		}
+		return true
+	})
+	return
+}
+
+// generatePresignS3Config is for URLs used by browsers, which can't reach minio by the container name.
+// The host is a part of the signature, so it can't be replaced after signing as the object URLs are.
+func generatePresignS3Config() *aws.Config {
+	c := generateS3Config()
+	if app.IsLocal() {
+		c.Endpoint = aws.String("http://localhost:9000")
+	}
+	return c
+}
+
 func generateS3Config() *aws.Config {
 	// use minio in local or test
 	if app.IsLocal() || app.IsTest() {
@@ -0,0 +1,135 @@
+package classifier
+
+/*
+decide whether an image shows a cat
+*/
+
+import (
+	"context"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gold-kou/ToeBeans/backend/app"
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/gcp"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+)
+
+const (
+	TypeVision = "vision"
+	TypeFake   = "fake"
+
+	scoreThresholdDefault = 0.7
+	catLabelsDefault      = "cat,small to medium-sized cats,felidae"
+
+	altTextMaxLabels = 5
+	altTextMaxLength = 255
+)
+
+var classifierType string
+var scoreThreshold float32
+var catLabels map[string]bool
+
+func init() {
+	// Vision API costs money, so it is used only in prd unless IMAGE_CLASSIFIER says otherwise
+	classifierType = os.Getenv("IMAGE_CLASSIFIER")
+	if classifierType == "" {
+		if app.IsProduction() {
+			classifierType = TypeVision
+		} else {
+			classifierType = TypeFake
+		}
+	}
+	if classifierType != TypeVision && classifierType != TypeFake {
+		panic("IMAGE_CLASSIFIER must be " + TypeVision + " or " + TypeFake)
+	}
+
+	t, e := strconv.ParseFloat(os.Getenv("CAT_LABEL_SCORE_THRESHOLD"), 32)
+	if e != nil {
+		scoreThreshold = scoreThresholdDefault
+	} else {
+		scoreThreshold = float32(t)
+	}
+
+	labels := os.Getenv("CAT_LABELS")
+	if labels == "" {
+		labels = catLabelsDefault
+	}
+	catLabels = parseLabelSet(labels)
+}
+
+type ImageClassifier interface {
+	DetectLabels(ctx context.Context, image io.Reader) ([]model.Label, error)
+}
+
+// NewImageClassifier returns the classifier selected by IMAGE_CLASSIFIER.
+func NewImageClassifier() ImageClassifier {
+	if classifierType == TypeVision {
+		return &VisionClassifier{}
+	}
+	return NewFakeClassifierFromEnv()
+}
+
+type VisionClassifier struct{}
+
+func (c *VisionClassifier) DetectLabels(ctx context.Context, image io.Reader) ([]model.Label, error) {
+	return gcp.DetectLabels(ctx, image)
+}
+
+// IsCat reports whether any label is one of CAT_LABELS with a score of at least CAT_LABEL_SCORE_THRESHOLD.
+func IsCat(labels []model.Label) bool {
+	return isCat(labels, catLabels, scoreThreshold)
+}
+
+// AltText describes the image by its labels of at least CAT_LABEL_SCORE_THRESHOLD, e.g. "Cat, Whiskers, Tabby cat".
+// It is the default alt text of a posting, so it is empty when nothing confident is seen.
+func AltText(labels []model.Label) string {
+	return altText(labels, scoreThreshold)
+}
+
+func altText(labels []model.Label, threshold float32) string {
+	sorted := make([]model.Label, len(labels))
+	copy(sorted, labels)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Score > sorted[j].Score })
+
+	var descriptions []string
+	seen := map[string]bool{}
+	for _, l := range sorted {
+		d := strings.TrimSpace(l.Description)
+		if d == "" || l.Score < threshold || seen[strings.ToLower(d)] {
+			continue
+		}
+		// a label which doesn't fit is dropped rather than cut in the middle
+		if len(strings.Join(append(descriptions, d), ", ")) > altTextMaxLength {
+			break
+		}
+		seen[strings.ToLower(d)] = true
+		descriptions = append(descriptions, d)
+		if len(descriptions) == altTextMaxLabels {
+			break
+		}
+	}
+	return strings.Join(descriptions, ", ")
+}
+
+func isCat(labels []model.Label, accepted map[string]bool, threshold float32) bool {
+	for _, l := range labels {
+		if accepted[strings.ToLower(l.Description)] && l.Score >= threshold {
+			return true
+		}
+	}
+	return false
+}
+
+func parseLabelSet(s string) map[string]bool {
+	set := map[string]bool{}
+	for _, l := range strings.Split(s, ",") {
+		l = strings.ToLower(strings.TrimSpace(l))
+		if l != "" {
+			set[l] = true
+		}
+	}
+	return set
+}
@@ -0,0 +1,95 @@
+package classifier
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+)
+
+func TestIsCat(t *testing.T) {
+	accepted := parseLabelSet(catLabelsDefault)
+	tests := []struct {
+		name   string
+		labels []model.Label
+		want   bool
+	}{
+		{
+			name:   "cat",
+			labels: []model.Label{{Description: "Whiskers", Score: 0.95}, {Description: "Cat", Score: 0.9}},
+			want:   true,
+		},
+		{
+			name:   "case insensitive",
+			labels: []model.Label{{Description: "Small to medium-sized Cats", Score: 0.8}},
+			want:   true,
+		},
+		{
+			name:   "under threshold",
+			labels: []model.Label{{Description: "Cat", Score: 0.5}},
+			want:   false,
+		},
+		{
+			name:   "not allowed label containing cat",
+			labels: []model.Label{{Description: "Cattle", Score: 0.99}},
+			want:   false,
+		},
+		{
+			name:   "no labels",
+			labels: nil,
+			want:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isCat(tt.labels, accepted, scoreThresholdDefault))
+		})
+	}
+}
+
+func TestParseLabels(t *testing.T) {
+	got := parseLabels("Cat:0.98, Tabby cat:0.5,Dog,Broken:x")
+	want := []model.Label{
+		{Description: "Cat", Score: 0.98},
+		{Description: "Tabby cat", Score: 0.5},
+		{Description: "Dog", Score: 1},
+		{Description: "Broken:x", Score: 1},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestAltText(t *testing.T) {
+	tests := []struct {
+		name   string
+		labels []model.Label
+		want   string
+	}{
+		{
+			name:   "in descending order of score",
+			labels: []model.Label{{Description: "Whiskers", Score: 0.9}, {Description: "Cat", Score: 0.98}, {Description: "Tabby cat", Score: 0.85}},
+			want:   "Cat, Whiskers, Tabby cat",
+		},
+		{
+			name:   "under threshold and duplicates are skipped",
+			labels: []model.Label{{Description: "Cat", Score: 0.98}, {Description: "cat", Score: 0.9}, {Description: "Dog", Score: 0.3}},
+			want:   "Cat",
+		},
+		{
+			name: "at most 5 labels",
+			labels: []model.Label{{Description: "A", Score: 0.99}, {Description: "B", Score: 0.98}, {Description: "C", Score: 0.97},
+				{Description: "D", Score: 0.96}, {Description: "E", Score: 0.95}, {Description: "F", Score: 0.94}},
+			want: "A, B, C, D, E",
+		},
+		{
+			name:   "no labels",
+			labels: nil,
+			want:   "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, altText(tt.labels, scoreThresholdDefault))
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package classifier
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+)
+
+const fakeLabelsDefault = "Cat:0.98,Whiskers:0.9,Tabby cat:0.85"
+
+// FakeClassifier returns the same labels for any image so that local and test runs are deterministic.
+// Set FAKE_IMAGE_LABELS (e.g. "Dog:0.95") to try the rejection path locally.
+type FakeClassifier struct {
+	Labels []model.Label
+}
+
+func NewFakeClassifier(labels []model.Label) *FakeClassifier {
+	return &FakeClassifier{
+		Labels: labels,
+	}
+}
+
+func NewFakeClassifierFromEnv() *FakeClassifier {
+	s := os.Getenv("FAKE_IMAGE_LABELS")
+	if s == "" {
+		s = fakeLabelsDefault
+	}
+	return NewFakeClassifier(parseLabels(s))
+}
+
+func (c *FakeClassifier) DetectLabels(ctx context.Context, image io.Reader) ([]model.Label, error) {
+	// consume the image as the real classifier does
+	if _, err := io.Copy(ioutil.Discard, image); err != nil {
+		return nil, err
+	}
+	return c.Labels, nil
+}
+
+// parseLabels parses "description:score" pairs separated by commas. A missing score means 1.
+func parseLabels(s string) (labels []model.Label) {
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		l := model.Label{Description: pair, Score: 1}
+		if i := strings.LastIndex(pair, ":"); i >= 0 {
+			if score, err := strconv.ParseFloat(pair[i+1:], 32); err == nil {
+				l = model.Label{Description: pair[:i], Score: float32(score)}
+			}
+		}
+		labels = append(labels, l)
+	}
+	return
+}
@@ -2,11 +2,13 @@ package gcp
 
 import (
 	"context"
+	"io"
 	"os"
 
 	vision "cloud.google.com/go/vision/apiv1"
-	"github.com/pkg/errors"
 	"google.golang.org/api/option"
+
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
 )
 
 var apiKey string
@@ -18,23 +20,15 @@ func init() {
 	}
 }
 
-// DetectLabels gets labels from the Vision API for an image at the given file path.
-func DetectLabels(file string) (labels []string, err error) {
-	ctx := context.Background()
-
+// DetectLabels gets labels with scores from the Vision API for an image read from r.
+func DetectLabels(ctx context.Context, r io.Reader) (labels []model.Label, err error) {
 	client, err := vision.NewImageAnnotatorClient(ctx, option.WithCredentialsJSON([]byte(apiKey)))
 	if err != nil {
 		return
 	}
 	defer client.Close()
 
-	f, err := os.Open(file)
-	if err != nil {
-		return
-	}
-	defer f.Close()
-
-	image, err := vision.NewImageFromReader(f)
+	image, err := vision.NewImageFromReader(r)
 	if err != nil {
 		return
 	}
@@ -43,13 +37,9 @@ func DetectLabels(file string) (labels []string, err error) {
 		return
 	}
 
-	if len(annotations) == 0 {
-		return labels, errors.New("No labels found")
-	} else {
-		for _, annotation := range annotations {
-			labels = append(labels, annotation.Description)
-		}
+	// no labels is not an error. it just means nothing was recognized.
+	for _, annotation := range annotations {
+		labels = append(labels, model.Label{Description: annotation.Description, Score: annotation.Score})
 	}
-
 	return labels, nil
 }
@@ -0,0 +1,240 @@
+package controller
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/gorilla/mux"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/http/context"
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/http/helper"
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/application/usecase"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+)
+
+func BookmarkController(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.URL.Path == "/bookmarks":
+		switch r.Method {
+		case http.MethodGet:
+			postings, userNames, likedCounts, likes, next, err := getBookmarks(r)
+			switch err := err.(type) {
+			case nil:
+				resp := newResponseGetPostings(postings, userNames, likedCounts, likes)
+				resp.NextCursor = next
+				w.Header().Set(helper.HeaderKeyContentType, helper.HeaderValueApplicationJSON)
+				w.WriteHeader(http.StatusOK)
+				if err := json.NewEncoder(w).Encode(resp); err != nil {
+					log.Println(err.Error())
+				}
+			case *helper.BadRequestError:
+				helper.ResponseBadRequest(w, err.Error())
+			case *helper.AuthorizationError:
+				helper.ResponseUnauthorized(w, err.Error())
+			case *helper.InternalServerError:
+				helper.ResponseInternalServerError(w, err.Error())
+			default:
+				helper.ResponseInternalServerError(w, err.Error())
+			}
+		default:
+			methods := []string{http.MethodGet}
+			helper.ResponseNotAllowedMethod(w, errMsgNotAllowedMethod, methods)
+		}
+	case strings.HasPrefix(r.URL.Path, "/bookmarks/"):
+		switch r.Method {
+		case http.MethodPost:
+			err := registerBookmark(r)
+			switch err := err.(type) {
+			case nil:
+				helper.ResponseSimpleSuccess(w)
+			case *helper.BadRequestError:
+				helper.ResponseBadRequest(w, err.Error())
+			case *helper.AuthorizationError:
+				helper.ResponseUnauthorized(w, err.Error())
+			case *helper.ConflictError:
+				helper.ResponseConflictError(w, err.Error())
+			case *helper.InternalServerError:
+				helper.ResponseInternalServerError(w, err.Error())
+			default:
+				helper.ResponseInternalServerError(w, err.Error())
+			}
+		case http.MethodDelete:
+			err := deleteBookmark(r)
+			switch err := err.(type) {
+			case nil:
+				helper.ResponseSimpleSuccess(w)
+			case *helper.BadRequestError:
+				helper.ResponseBadRequest(w, err.Error())
+			case *helper.AuthorizationError:
+				helper.ResponseUnauthorized(w, err.Error())
+			case *helper.ConflictError:
+				helper.ResponseConflictError(w, err.Error())
+			case *helper.InternalServerError:
+				helper.ResponseInternalServerError(w, err.Error())
+			default:
+				helper.ResponseInternalServerError(w, err.Error())
+			}
+		default:
+			methods := []string{http.MethodPost, http.MethodDelete}
+			helper.ResponseNotAllowedMethod(w, errMsgNotAllowedMethod, methods)
+		}
+	default:
+		helper.ResponseInternalServerError(w, errMsgControllerPath)
+	}
+}
+
+// getPostingID returns the posting_id path parameter.
+func getPostingID(r *http.Request) (int64, error) {
+	vars := mux.Vars(r)
+	paramPostingID, _ := vars["posting_id"]
+	postingID, err := strconv.ParseInt(paramPostingID, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	if err = validation.Validate(postingID, validation.Required); err != nil {
+		return 0, err
+	}
+	return postingID, nil
+}
+
+func registerBookmark(r *http.Request) error {
+	tokenUserName, err := context.GetTokenUserName(r.Context())
+	if err != nil {
+		log.Println(err)
+		return helper.NewInternalServerError(err.Error())
+	}
+
+	// get request parameter and validation check
+	postingID, err := getPostingID(r)
+	if err != nil {
+		log.Println(err)
+		return helper.NewBadRequestError(err.Error())
+	}
+
+	// db connect
+	db, err := mysql.NewDB()
+	if err != nil {
+		log.Println(err)
+		return helper.NewInternalServerError(err.Error())
+	}
+	defer db.Close()
+	tx := mysql.NewDBTransaction(db)
+
+	// repository
+	userRepo := repository.NewUserRepository(db)
+	postingRepo := repository.NewPostingRepository(db)
+	bookmarkRepo := repository.NewBookmarkRepository(db)
+
+	// UseCase
+	u := usecase.NewRegisterBookmark(tx, tokenUserName, postingID, userRepo, postingRepo, bookmarkRepo)
+	if err = u.RegisterBookmarkUseCase(r.Context()); err != nil {
+		log.Println(err)
+		if err == usecase.ErrNotExistsData {
+			return helper.NewBadRequestError(err.Error())
+		} else if err == usecase.ErrAlreadyBookmarked {
+			return helper.NewConflictError(err.Error())
+		} else if err == usecase.ErrTokenInvalidNotExistingUserName {
+			return helper.NewAuthorizationError(err.Error())
+		}
+		return helper.NewInternalServerError(err.Error())
+	}
+	return nil
+}
+
+func deleteBookmark(r *http.Request) error {
+	tokenUserName, err := context.GetTokenUserName(r.Context())
+	if err != nil {
+		log.Println(err)
+		return helper.NewInternalServerError(err.Error())
+	}
+
+	// get request parameter and validation check
+	postingID, err := getPostingID(r)
+	if err != nil {
+		log.Println(err)
+		return helper.NewBadRequestError(err.Error())
+	}
+
+	// db connect
+	db, err := mysql.NewDB()
+	if err != nil {
+		log.Println(err)
+		return helper.NewInternalServerError(err.Error())
+	}
+	defer db.Close()
+	tx := mysql.NewDBTransaction(db)
+
+	// repository
+	userRepo := repository.NewUserRepository(db)
+	bookmarkRepo := repository.NewBookmarkRepository(db)
+
+	// UseCase
+	u := usecase.NewDeleteBookmark(tx, tokenUserName, postingID, userRepo, bookmarkRepo)
+	if err = u.DeleteBookmarkUseCase(r.Context()); err != nil {
+		log.Println(err)
+		if err == usecase.ErrDeleteNotExistsBookmark {
+			return helper.NewConflictError(err.Error())
+		} else if err == usecase.ErrTokenInvalidNotExistingUserName {
+			return helper.NewAuthorizationError(err.Error())
+		}
+		return helper.NewInternalServerError(err.Error())
+	}
+	return nil
+}
+
+func getBookmarks(r *http.Request) (postings []model.Posting, userNames []string, likedCounts []int64, likes []model.Like, next string, err error) {
+	tokenUserName, err := context.GetTokenUserName(r.Context())
+	if err != nil {
+		log.Println(err)
+		err = helper.NewInternalServerError(err.Error())
+		return
+	}
+
+	// get request parameter and validation check
+	cursor, limit, err := getPagingParams(r, true)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	// db connect
+	db, err := mysql.NewDB()
+	if err != nil {
+		log.Println(err)
+		err = helper.NewInternalServerError(err.Error())
+		return
+	}
+	defer db.Close()
+	tx := mysql.NewDBTransaction(db)
+
+	// repository
+	userRepo := repository.NewUserRepository(db)
+	postingRepo := repository.NewPostingRepository(db)
+	likeRepo := repository.NewLikeRepository(db)
+	postingCounterRepo := repository.NewPostingCounterRepository(db)
+	postingImageRepo := repository.NewPostingImageRepository(db)
+
+	// UseCase
+	u := usecase.NewGetBookmarks(tx, tokenUserName, cursor, int8(limit), userRepo, postingRepo, likeRepo, postingCounterRepo, postingImageRepo)
+	var nextCursor model.Cursor
+	if postings, userNames, likedCounts, likes, nextCursor, err = u.GetBookmarksUseCase(r.Context()); err != nil {
+		log.Println(err)
+		if err == usecase.ErrTokenInvalidNotExistingUserName {
+			err = helper.NewAuthorizationError(err.Error())
+			return
+		}
+		err = helper.NewInternalServerError(err.Error())
+		return
+	}
+	// the bookmarks are ordered by when they were made, so the cursor points at a bookmark
+	if !nextCursor.IsZero() {
+		next = helper.EncodeCursor(nextCursor)
+	}
+	return
+}
@@ -0,0 +1,316 @@
+package controller
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	httpContext "github.com/gold-kou/ToeBeans/backend/app/adapter/http/context"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+	testingHelper "github.com/gold-kou/ToeBeans/backend/testing"
+	"github.com/gold-kou/ToeBeans/backend/testing/dummy"
+)
+
+var errRespRegisterBookmarkWithoutPostingID = `
+{
+  "status": 400,
+  "message": "cannot be blank"
+}
+`
+var errRespRegisterBookmarkNotExistingPosting = `
+{
+  "status": 400,
+  "message": "not exists data error"
+}
+`
+var errRespRegisterBookmarkDuplicate = `
+{
+  "status": 409,
+  "message": "you already bookmarked the posting"
+}
+`
+var errRespDeleteBookmarkNotExisting = `
+{
+  "status": 409,
+  "message": "can't delete not existing bookmark"
+}
+`
+
+func insertDummyBookmarks(t *testing.T, db *sql.DB) {
+	userRepo := repository.NewUserRepository(db)
+	postingRepo := repository.NewPostingRepository(db)
+	bookmarkRepo := repository.NewBookmarkRepository(db)
+	err := userRepo.Create(context.Background(), &dummy.User1)
+	assert.NoError(t, err)
+	err = userRepo.Create(context.Background(), &dummy.User2)
+	assert.NoError(t, err)
+	err = postingRepo.Create(context.Background(), &dummy.Posting1)
+	assert.NoError(t, err)
+	err = postingRepo.Create(context.Background(), &dummy.Posting2)
+	assert.NoError(t, err)
+	err = testingHelper.UpdateNow(db, "postings")
+	assert.NoError(t, err)
+	err = bookmarkRepo.Create(context.Background(), &dummy.Bookmark1)
+	assert.NoError(t, err)
+	err = testingHelper.UpdateNow(db, "bookmarks")
+	assert.NoError(t, err)
+}
+
+func TestRegisterBookmark(t *testing.T) {
+	type args struct {
+		postingID int64
+	}
+	tests := []struct {
+		name       string
+		args       args
+		method     string
+		want       string
+		wantStatus int
+	}{
+		{
+			name:       "success own posting",
+			args:       args{postingID: dummy.Posting1.ID},
+			method:     http.MethodPost,
+			want:       testingHelper.RespSimpleSuccess,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "error empty posting_id",
+			args:       args{postingID: 0},
+			method:     http.MethodPost,
+			want:       errRespRegisterBookmarkWithoutPostingID,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "error not existing posting",
+			args:       args{postingID: 100},
+			method:     http.MethodPost,
+			want:       errRespRegisterBookmarkNotExistingPosting,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "error duplicate",
+			args:       args{postingID: dummy.Posting2.ID},
+			method:     http.MethodPost,
+			want:       errRespRegisterBookmarkDuplicate,
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name:       "not allowed method",
+			args:       args{postingID: dummy.Posting1.ID},
+			method:     http.MethodGet,
+			want:       testingHelper.ErrNotAllowedMethod,
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// init
+			db := testingHelper.SetupDBTest()
+			defer testingHelper.TeardownDBTest(db)
+
+			// insert dummy data
+			insertDummyBookmarks(t, db)
+
+			// http request
+			req, err := http.NewRequest(tt.method, fmt.Sprintf("/bookmarks/%d", tt.args.postingID), nil)
+			assert.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{"posting_id": strconv.Itoa(int(tt.args.postingID))})
+			req = req.WithContext(httpContext.SetTokenUserName(req.Context(), dummy.User1.Name))
+			resp := httptest.NewRecorder()
+
+			// test target
+			BookmarkController(resp, req)
+
+			// assert db
+			bookmarks, err := testingHelper.FindAllBookmarks(context.Background(), db)
+			assert.NoError(t, err)
+			if tt.wantStatus == http.StatusOK {
+				assert.Equal(t, 2, len(bookmarks))
+				assert.Equal(t, dummy.User1.ID, bookmarks[1].UserID)
+				assert.Equal(t, tt.args.postingID, bookmarks[1].PostingID)
+			} else {
+				assert.Equal(t, 1, len(bookmarks))
+			}
+
+			// assert http
+			assert.Equal(t, tt.wantStatus, resp.Code)
+			respBodyByte, err := ioutil.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			respBody := string(respBodyByte)
+			assert.JSONEq(t, tt.want, respBody)
+		})
+	}
+}
+
+func TestDeleteBookmark(t *testing.T) {
+	type args struct {
+		postingID int64
+	}
+	tests := []struct {
+		name       string
+		args       args
+		method     string
+		want       string
+		wantStatus int
+	}{
+		{
+			name:       "success",
+			args:       args{postingID: dummy.Posting2.ID},
+			method:     http.MethodDelete,
+			want:       testingHelper.RespSimpleSuccess,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "error not bookmarked",
+			args:       args{postingID: dummy.Posting1.ID},
+			method:     http.MethodDelete,
+			want:       errRespDeleteBookmarkNotExisting,
+			wantStatus: http.StatusConflict,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// init
+			db := testingHelper.SetupDBTest()
+			defer testingHelper.TeardownDBTest(db)
+
+			// insert dummy data
+			insertDummyBookmarks(t, db)
+
+			// http request
+			req, err := http.NewRequest(tt.method, fmt.Sprintf("/bookmarks/%d", tt.args.postingID), nil)
+			assert.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{"posting_id": strconv.Itoa(int(tt.args.postingID))})
+			req = req.WithContext(httpContext.SetTokenUserName(req.Context(), dummy.User1.Name))
+			resp := httptest.NewRecorder()
+
+			// test target
+			BookmarkController(resp, req)
+
+			// assert db
+			bookmarks, err := testingHelper.FindAllBookmarks(context.Background(), db)
+			assert.NoError(t, err)
+			if tt.wantStatus == http.StatusOK {
+				assert.Equal(t, 0, len(bookmarks))
+			} else {
+				assert.Equal(t, 1, len(bookmarks))
+			}
+
+			// assert http
+			assert.Equal(t, tt.wantStatus, resp.Code)
+			respBodyByte, err := ioutil.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			respBody := string(respBodyByte)
+			assert.JSONEq(t, tt.want, respBody)
+		})
+	}
+}
+
+var successRespGetBookmarks = `
+{
+  "postings": [
+    {
+      "posting_id": 2,
+      "user_name": "testUser2",
+      "uploaded_at": "2020-01-01T00:00:00+09:00",
+      "title": "test title",
+      "alt_text": "test alt text",
+      "image_url": "test url",
+      "image_urls": {
+        "thumb": "test url",
+        "feed": "test url",
+        "full": "test url"
+      },
+      "images": [
+        {
+          "thumb": "test url",
+          "feed": "test url",
+          "full": "test url"
+        }
+      ],
+      "liked_count": 0,
+      "liked": false
+    }
+  ]
+}
+`
+
+func TestGetBookmarks(t *testing.T) {
+	type args struct {
+		userName string
+		limit    string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		method     string
+		want       string
+		wantStatus int
+	}{
+		{
+			name:       "success",
+			args:       args{userName: dummy.User1.Name, limit: "50"},
+			method:     http.MethodGet,
+			want:       successRespGetBookmarks,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "success others can't see",
+			args:       args{userName: dummy.User2.Name, limit: "50"},
+			method:     http.MethodGet,
+			want:       successRespGetPostingsEmpty,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "error empty limit",
+			args:       args{userName: dummy.User1.Name},
+			method:     http.MethodGet,
+			want:       errRespGetPostingsWithoutLimit,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "not allowed method",
+			args:       args{userName: dummy.User1.Name},
+			method:     http.MethodPost,
+			want:       testingHelper.ErrNotAllowedMethod,
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// init
+			db := testingHelper.SetupDBTest()
+			defer testingHelper.TeardownDBTest(db)
+			testingHelper.SetTestTime()
+			defer testingHelper.ResetTime()
+
+			// insert dummy data
+			insertDummyBookmarks(t, db)
+
+			// http request
+			req, err := http.NewRequest(tt.method, fmt.Sprintf("/bookmarks?limit=%s", tt.args.limit), nil)
+			assert.NoError(t, err)
+			req = req.WithContext(httpContext.SetTokenUserName(req.Context(), tt.args.userName))
+			resp := httptest.NewRecorder()
+
+			// test target
+			BookmarkController(resp, req)
+
+			// assert http
+			assert.Equal(t, tt.wantStatus, resp.Code)
+			respBodyByte, err := ioutil.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			respBody := string(respBodyByte)
+			assert.JSONEq(t, tt.want, testingHelper.StripURLSignatures(respBody))
+		})
+	}
+}
@@ -0,0 +1,465 @@
+package controller
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/gorilla/mux"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/http/context"
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/http/helper"
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/application/usecase"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+	modelHTTP "github.com/gold-kou/ToeBeans/backend/app/domain/model/http"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+)
+
+func CatController(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.URL.Path == "/cats":
+		switch r.Method {
+		case http.MethodPost:
+			err := registerCat(r)
+			switch err := err.(type) {
+			case nil:
+				helper.ResponseSimpleSuccess(w)
+			case *helper.BadRequestError:
+				helper.ResponseBadRequest(w, err.Error())
+			case *helper.AuthorizationError:
+				helper.ResponseUnauthorized(w, err.Error())
+			case *helper.ForbiddenError:
+				helper.ResponseForbidden(w, err.Error())
+			case *helper.InternalServerError:
+				helper.ResponseInternalServerError(w, err.Error())
+			default:
+				helper.ResponseInternalServerError(w, err.Error())
+			}
+		default:
+			methods := []string{http.MethodPost}
+			helper.ResponseNotAllowedMethod(w, errMsgNotAllowedMethod, methods)
+		}
+	case strings.HasPrefix(r.URL.Path, "/cats/") && strings.HasSuffix(r.URL.Path, "/postings"):
+		switch r.Method {
+		case http.MethodGet:
+			postings, userNames, likedCounts, likes, next, err := getCatPostings(r)
+			switch err := err.(type) {
+			case nil:
+				resp := newResponseGetPostings(postings, userNames, likedCounts, likes)
+				resp.NextCursor = next
+				w.Header().Set(helper.HeaderKeyContentType, helper.HeaderValueApplicationJSON)
+				w.WriteHeader(http.StatusOK)
+				if err := json.NewEncoder(w).Encode(resp); err != nil {
+					log.Println(err.Error())
+				}
+			case *helper.BadRequestError:
+				helper.ResponseBadRequest(w, err.Error())
+			case *helper.AuthorizationError:
+				helper.ResponseUnauthorized(w, err.Error())
+			case *helper.NotFoundError:
+				helper.ResponseNotFound(w, err.Error())
+			case *helper.InternalServerError:
+				helper.ResponseInternalServerError(w, err.Error())
+			default:
+				helper.ResponseInternalServerError(w, err.Error())
+			}
+		default:
+			methods := []string{http.MethodGet}
+			helper.ResponseNotAllowedMethod(w, errMsgNotAllowedMethod, methods)
+		}
+	case strings.HasPrefix(r.URL.Path, "/cats/"):
+		switch r.Method {
+		case http.MethodGet:
+			cat, userName, err := getCat(r)
+			switch err := err.(type) {
+			case nil:
+				resp := newResponseGetCat(cat, userName)
+				w.Header().Set(helper.HeaderKeyContentType, helper.HeaderValueApplicationJSON)
+				w.WriteHeader(http.StatusOK)
+				if err := json.NewEncoder(w).Encode(resp); err != nil {
+					log.Println(err.Error())
+				}
+			case *helper.BadRequestError:
+				helper.ResponseBadRequest(w, err.Error())
+			case *helper.AuthorizationError:
+				helper.ResponseUnauthorized(w, err.Error())
+			case *helper.NotFoundError:
+				helper.ResponseNotFound(w, err.Error())
+			case *helper.InternalServerError:
+				helper.ResponseInternalServerError(w, err.Error())
+			default:
+				helper.ResponseInternalServerError(w, err.Error())
+			}
+		case http.MethodPut:
+			err := updateCat(r)
+			switch err := err.(type) {
+			case nil:
+				helper.ResponseSimpleSuccess(w)
+			case *helper.BadRequestError:
+				helper.ResponseBadRequest(w, err.Error())
+			case *helper.AuthorizationError:
+				helper.ResponseUnauthorized(w, err.Error())
+			case *helper.ForbiddenError:
+				helper.ResponseForbidden(w, err.Error())
+			case *helper.NotFoundError:
+				helper.ResponseNotFound(w, err.Error())
+			case *helper.InternalServerError:
+				helper.ResponseInternalServerError(w, err.Error())
+			default:
+				helper.ResponseInternalServerError(w, err.Error())
+			}
+		case http.MethodDelete:
+			err := deleteCat(r)
+			switch err := err.(type) {
+			case nil:
+				helper.ResponseSimpleSuccess(w)
+			case *helper.BadRequestError:
+				helper.ResponseBadRequest(w, err.Error())
+			case *helper.AuthorizationError:
+				helper.ResponseUnauthorized(w, err.Error())
+			case *helper.ForbiddenError:
+				helper.ResponseForbidden(w, err.Error())
+			case *helper.NotFoundError:
+				helper.ResponseNotFound(w, err.Error())
+			case *helper.InternalServerError:
+				helper.ResponseInternalServerError(w, err.Error())
+			default:
+				helper.ResponseInternalServerError(w, err.Error())
+			}
+		default:
+			methods := []string{http.MethodGet, http.MethodPut, http.MethodDelete}
+			helper.ResponseNotAllowedMethod(w, errMsgNotAllowedMethod, methods)
+		}
+	default:
+		helper.ResponseInternalServerError(w, errMsgControllerPath)
+	}
+}
+
+func newResponseGetCat(c model.Cat, userName string) modelHTTP.ResponseGetCat {
+	resp := modelHTTP.ResponseGetCat{
+		CatId:     c.ID,
+		UserName:  userName,
+		Name:      c.Name,
+		Breed:     c.Breed,
+		Icon:      usecase.SignIconURL(c.Icon),
+		Bio:       c.Bio,
+		CreatedAt: c.CreatedAt,
+	}
+	if c.Birthday != nil {
+		resp.Birthday = c.Birthday.Format(modelHTTP.CatBirthdayLayout)
+	}
+	return resp
+}
+
+// newResponseGetCats is for the cats of one user.
+func newResponseGetCats(cats []model.Cat, userName string) []modelHTTP.ResponseGetCat {
+	var httpCats = []modelHTTP.ResponseGetCat{}
+	for _, c := range cats {
+		httpCats = append(httpCats, newResponseGetCat(c, userName))
+	}
+	return httpCats
+}
+
+// getCatID returns the cat_id path parameter.
+func getCatID(r *http.Request) (int64, error) {
+	vars := mux.Vars(r)
+	paramCatID, _ := vars["cat_id"]
+	catID, err := strconv.ParseInt(paramCatID, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	if err = validation.Validate(catID, validation.Required); err != nil {
+		return 0, err
+	}
+	return catID, nil
+}
+
+func registerCat(r *http.Request) error {
+	// not allowed to guest user
+	tokenUserName, err := context.GetTokenUserName(r.Context())
+	if err != nil {
+		log.Println(err)
+		return helper.NewInternalServerError(err.Error())
+	}
+	if tokenUserName == helper.GuestUserName {
+		log.Println(errMsgGuestUserForbidden)
+		return helper.NewForbiddenError(errMsgGuestUserForbidden)
+	}
+
+	// get request parameter
+	var reqRegisterCat *modelHTTP.RequestRegisterCat
+	b, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		log.Println(err)
+		return helper.NewBadRequestError(err.Error())
+	}
+	defer r.Body.Close()
+	if err = json.Unmarshal(b, &reqRegisterCat); err != nil {
+		log.Println(err)
+		return helper.NewBadRequestError(err.Error())
+	}
+
+	// validation check
+	if err = reqRegisterCat.ValidateParam(); err != nil {
+		log.Println(err)
+		return helper.NewBadRequestError(err.Error())
+	}
+
+	// db connect
+	db, err := mysql.NewDB()
+	if err != nil {
+		log.Println(err)
+		return helper.NewInternalServerError(err.Error())
+	}
+	defer db.Close()
+	tx := mysql.NewDBTransaction(db)
+
+	// repository
+	userRepo := repository.NewUserRepository(db)
+	catRepo := repository.NewCatRepository(db)
+	objectDeletionRepo := repository.NewObjectDeletionRepository(db)
+
+	// UseCase
+	u := usecase.NewRegisterCat(tx, tokenUserName, reqRegisterCat, userRepo, catRepo, objectDeletionRepo)
+	if err = u.RegisterCatUseCase(r.Context()); err != nil {
+		log.Println(err)
+		if err == usecase.ErrDecodeImage {
+			return helper.NewBadRequestError(err.Error())
+		}
+		if err == usecase.ErrTokenInvalidNotExistingUserName {
+			return helper.NewAuthorizationError(err.Error())
+		}
+		return helper.NewInternalServerError(err.Error())
+	}
+	return nil
+}
+
+func getCat(r *http.Request) (cat model.Cat, userName string, err error) {
+	tokenUserName, err := context.GetTokenUserName(r.Context())
+	if err != nil {
+		log.Println(err)
+		err = helper.NewInternalServerError(err.Error())
+		return
+	}
+
+	// get request parameter and validation check
+	catID, err := getCatID(r)
+	if err != nil {
+		log.Println(err)
+		err = helper.NewBadRequestError(err.Error())
+		return
+	}
+
+	// db connect
+	db, err := mysql.NewDB()
+	if err != nil {
+		log.Println(err)
+		err = helper.NewInternalServerError(err.Error())
+		return
+	}
+	defer db.Close()
+	tx := mysql.NewDBTransaction(db)
+
+	// repository
+	userRepo := repository.NewUserRepository(db)
+	catRepo := repository.NewCatRepository(db)
+
+	// UseCase
+	u := usecase.NewGetCat(tx, tokenUserName, catID, userRepo, catRepo)
+	if cat, userName, err = u.GetCatUseCase(r.Context()); err != nil {
+		log.Println(err)
+		if err == usecase.ErrNotExistsData {
+			err = helper.NewNotFoundError(err.Error())
+			return
+		}
+		if err == usecase.ErrTokenInvalidNotExistingUserName {
+			err = helper.NewAuthorizationError(err.Error())
+			return
+		}
+		err = helper.NewInternalServerError(err.Error())
+		return
+	}
+	return
+}
+
+func updateCat(r *http.Request) error {
+	// not allowed to guest user
+	tokenUserName, err := context.GetTokenUserName(r.Context())
+	if err != nil {
+		log.Println(err)
+		return helper.NewInternalServerError(err.Error())
+	}
+	if tokenUserName == helper.GuestUserName {
+		log.Println(errMsgGuestUserForbidden)
+		return helper.NewForbiddenError(errMsgGuestUserForbidden)
+	}
+
+	// get request parameter
+	catID, err := getCatID(r)
+	if err != nil {
+		log.Println(err)
+		return helper.NewBadRequestError(err.Error())
+	}
+	var reqUpdateCat *modelHTTP.RequestUpdateCat
+	b, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		log.Println(err)
+		return helper.NewBadRequestError(err.Error())
+	}
+	defer r.Body.Close()
+	if err = json.Unmarshal(b, &reqUpdateCat); err != nil {
+		log.Println(err)
+		return helper.NewBadRequestError(err.Error())
+	}
+
+	// validation check
+	if err = reqUpdateCat.ValidateParam(); err != nil {
+		log.Println(err)
+		return helper.NewBadRequestError(err.Error())
+	}
+
+	// db connect
+	db, err := mysql.NewDB()
+	if err != nil {
+		log.Println(err)
+		return helper.NewInternalServerError(err.Error())
+	}
+	defer db.Close()
+	tx := mysql.NewDBTransaction(db)
+
+	// repository
+	userRepo := repository.NewUserRepository(db)
+	catRepo := repository.NewCatRepository(db)
+	objectDeletionRepo := repository.NewObjectDeletionRepository(db)
+
+	// UseCase
+	u := usecase.NewUpdateCat(tx, catID, tokenUserName, reqUpdateCat, userRepo, catRepo, objectDeletionRepo)
+	if err = u.UpdateCatUseCase(r.Context()); err != nil {
+		log.Println(err)
+		if err == usecase.ErrDecodeImage {
+			return helper.NewBadRequestError(err.Error())
+		}
+		if err == usecase.ErrNotExistsData {
+			return helper.NewNotFoundError(err.Error())
+		}
+		if err == usecase.ErrNotCatOwner {
+			return helper.NewForbiddenError(err.Error())
+		}
+		if err == usecase.ErrTokenInvalidNotExistingUserName {
+			return helper.NewAuthorizationError(err.Error())
+		}
+		return helper.NewInternalServerError(err.Error())
+	}
+	return nil
+}
+
+func deleteCat(r *http.Request) error {
+	// not allowed to guest user
+	tokenUserName, err := context.GetTokenUserName(r.Context())
+	if err != nil {
+		log.Println(err)
+		return helper.NewInternalServerError(err.Error())
+	}
+	if tokenUserName == helper.GuestUserName {
+		log.Println(errMsgGuestUserForbidden)
+		return helper.NewForbiddenError(errMsgGuestUserForbidden)
+	}
+
+	// get request parameter and validation check
+	catID, err := getCatID(r)
+	if err != nil {
+		log.Println(err)
+		return helper.NewBadRequestError(err.Error())
+	}
+
+	// db connect
+	db, err := mysql.NewDB()
+	if err != nil {
+		log.Println(err)
+		return helper.NewInternalServerError(err.Error())
+	}
+	defer db.Close()
+	tx := mysql.NewDBTransaction(db)
+
+	// repository
+	userRepo := repository.NewUserRepository(db)
+	catRepo := repository.NewCatRepository(db)
+	postingCatRepo := repository.NewPostingCatRepository(db)
+	objectDeletionRepo := repository.NewObjectDeletionRepository(db)
+
+	// UseCase
+	u := usecase.NewDeleteCat(tx, catID, tokenUserName, userRepo, catRepo, postingCatRepo, objectDeletionRepo)
+	if err = u.DeleteCatUseCase(r.Context()); err != nil {
+		log.Println(err)
+		if err == usecase.ErrNotExistsData {
+			return helper.NewNotFoundError(err.Error())
+		}
+		if err == usecase.ErrNotCatOwner {
+			return helper.NewForbiddenError(err.Error())
+		}
+		if err == usecase.ErrTokenInvalidNotExistingUserName {
+			return helper.NewAuthorizationError(err.Error())
+		}
+		return helper.NewInternalServerError(err.Error())
+	}
+	return nil
+}
+
+func getCatPostings(r *http.Request) (postings []model.Posting, userNames []string, likedCounts []int64, likes []model.Like, next string, err error) {
+	tokenUserName, err := context.GetTokenUserName(r.Context())
+	if err != nil {
+		log.Println(err)
+		err = helper.NewInternalServerError(err.Error())
+		return
+	}
+
+	// get request parameter and validation check
+	catID, err := getCatID(r)
+	if err != nil {
+		log.Println(err)
+		err = helper.NewBadRequestError(err.Error())
+		return
+	}
+	cursor, limit, err := getPagingParams(r, true)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	// db connect
+	db, err := mysql.NewDB()
+	if err != nil {
+		log.Println(err)
+		err = helper.NewInternalServerError(err.Error())
+		return
+	}
+	defer db.Close()
+	tx := mysql.NewDBTransaction(db)
+
+	// repository
+	userRepo := repository.NewUserRepository(db)
+	postingRepo := repository.NewPostingRepository(db)
+	likeRepo := repository.NewLikeRepository(db)
+	postingCounterRepo := repository.NewPostingCounterRepository(db)
+	postingImageRepo := repository.NewPostingImageRepository(db)
+	catRepo := repository.NewCatRepository(db)
+
+	// UseCase
+	u := usecase.NewGetCatPostings(tx, tokenUserName, catID, cursor, int8(limit), userRepo, postingRepo, likeRepo, postingCounterRepo, postingImageRepo, catRepo)
+	if postings, userNames, likedCounts, likes, err = u.GetCatPostingsUseCase(r.Context()); err != nil {
+		log.Println(err)
+		if err == usecase.ErrNotExistsData {
+			err = helper.NewNotFoundError(err.Error())
+			return
+		}
+		err = helper.NewInternalServerError(err.Error())
+		return
+	}
+	next = postingsNextCursor(postings, limit)
+	return
+}
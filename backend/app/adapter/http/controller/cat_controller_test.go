@@ -0,0 +1,583 @@
+package controller
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	httpContext "github.com/gold-kou/ToeBeans/backend/app/adapter/http/context"
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/http/helper"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+	testingHelper "github.com/gold-kou/ToeBeans/backend/testing"
+	"github.com/gold-kou/ToeBeans/backend/testing/dummy"
+)
+
+var successReqRegisterCat = `
+{
+  "name": "Tama",
+  "breed": "Japanese Bobtail",
+  "birthday": "2018-04-01",
+  "bio": "likes napping in the sun"
+}
+`
+var errReqRegisterCatWithoutName = `
+{
+  "breed": "Japanese Bobtail"
+}
+`
+var errReqRegisterCatFutureBirthday = `
+{
+  "name": "Tama",
+  "birthday": "2100-01-01"
+}
+`
+var errRespRegisterCatWithoutName = `
+{
+  "status": 400,
+  "message": "name: cannot be blank."
+}
+`
+var errRespRegisterCatFutureBirthday = `
+{
+  "status": 400,
+  "message": "birthday: must not be a future date."
+}
+`
+
+func insertDummyCats(t *testing.T, db *sql.DB) {
+	userRepo := repository.NewUserRepository(db)
+	postingRepo := repository.NewPostingRepository(db)
+	catRepo := repository.NewCatRepository(db)
+	postingCatRepo := repository.NewPostingCatRepository(db)
+	err := userRepo.Create(context.Background(), &dummy.User1)
+	assert.NoError(t, err)
+	err = userRepo.Create(context.Background(), &dummy.User2)
+	assert.NoError(t, err)
+	err = postingRepo.Create(context.Background(), &dummy.Posting1)
+	assert.NoError(t, err)
+	err = testingHelper.UpdateNow(db, "postings")
+	assert.NoError(t, err)
+	err = catRepo.Create(context.Background(), &dummy.Cat1)
+	assert.NoError(t, err)
+	err = catRepo.Create(context.Background(), &dummy.Cat2)
+	assert.NoError(t, err)
+	err = testingHelper.UpdateNow(db, "cats")
+	assert.NoError(t, err)
+	err = postingCatRepo.Create(context.Background(), &dummy.PostingCat1)
+	assert.NoError(t, err)
+}
+
+func TestRegisterCat(t *testing.T) {
+	type args struct {
+		reqBody string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		method     string
+		want       string
+		wantStatus int
+	}{
+		{
+			name:       "success",
+			args:       args{reqBody: successReqRegisterCat},
+			method:     http.MethodPost,
+			want:       testingHelper.RespSimpleSuccess,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "error empty name",
+			args:       args{reqBody: errReqRegisterCatWithoutName},
+			method:     http.MethodPost,
+			want:       errRespRegisterCatWithoutName,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "error future birthday",
+			args:       args{reqBody: errReqRegisterCatFutureBirthday},
+			method:     http.MethodPost,
+			want:       errRespRegisterCatFutureBirthday,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "error forbidden guest user",
+			args:       args{reqBody: successReqRegisterCat},
+			method:     http.MethodPost,
+			want:       testingHelper.ErrForbidden,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "not allowed method",
+			args:       args{},
+			method:     http.MethodGet,
+			want:       testingHelper.ErrNotAllowedMethod,
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// init
+			db := testingHelper.SetupDBTest()
+			defer testingHelper.TeardownDBTest(db)
+			testingHelper.SetTestTime()
+			defer testingHelper.ResetTime()
+
+			// insert dummy data
+			userRepo := repository.NewUserRepository(db)
+			err := userRepo.Create(context.Background(), &dummy.User1)
+			assert.NoError(t, err)
+
+			// http request
+			req, err := http.NewRequest(tt.method, "/cats", strings.NewReader(tt.args.reqBody))
+			assert.NoError(t, err)
+			if tt.name == "error forbidden guest user" {
+				req = req.WithContext(httpContext.SetTokenUserName(req.Context(), helper.GuestUserName))
+			} else {
+				req = req.WithContext(httpContext.SetTokenUserName(req.Context(), dummy.User1.Name))
+			}
+			resp := httptest.NewRecorder()
+
+			// test target
+			CatController(resp, req)
+
+			// assert db
+			if tt.wantStatus == http.StatusOK {
+				cats, err := testingHelper.FindAllCats(context.Background(), db)
+				assert.NoError(t, err)
+				assert.Equal(t, 1, len(cats))
+				assert.Equal(t, dummy.User1.ID, cats[0].UserID)
+				assert.Equal(t, dummy.Cat1.Name, cats[0].Name)
+				assert.Equal(t, dummy.Cat1.Breed, cats[0].Breed)
+				assert.Equal(t, "2018-04-01", cats[0].Birthday.Format("2006-01-02"))
+				assert.Equal(t, "UNKNOWN", cats[0].Icon)
+				assert.Equal(t, dummy.Cat1.Bio, cats[0].Bio)
+			}
+
+			// assert http
+			assert.Equal(t, tt.wantStatus, resp.Code)
+			respBodyByte, err := ioutil.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			respBody := string(respBodyByte)
+			assert.JSONEq(t, tt.want, respBody)
+		})
+	}
+}
+
+var successRespGetCat = `
+{
+  "cat_id": 1,
+  "user_name": "testUser1",
+  "name": "Tama",
+  "breed": "Japanese Bobtail",
+  "birthday": "2018-04-01",
+  "icon": "UNKNOWN",
+  "bio": "likes napping in the sun",
+  "created_at": "2020-01-01T00:00:00+09:00"
+}
+`
+var successRespGetCatWithoutBirthday = `
+{
+  "cat_id": 2,
+  "user_name": "testUser2",
+  "name": "Mike",
+  "breed": "",
+  "icon": "UNKNOWN",
+  "bio": "",
+  "created_at": "2020-01-01T00:00:00+09:00"
+}
+`
+var errRespCatNotExistingID = `
+{
+  "status": 404,
+  "message": "not exists data error"
+}
+`
+
+func TestGetCat(t *testing.T) {
+	type args struct {
+		catID int64
+	}
+	tests := []struct {
+		name       string
+		args       args
+		method     string
+		want       string
+		wantStatus int
+	}{
+		{
+			name:       "success",
+			args:       args{catID: dummy.Cat1.ID},
+			method:     http.MethodGet,
+			want:       successRespGetCat,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "success other user's cat without birthday",
+			args:       args{catID: dummy.Cat2.ID},
+			method:     http.MethodGet,
+			want:       successRespGetCatWithoutBirthday,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "error not existing cat",
+			args:       args{catID: 100},
+			method:     http.MethodGet,
+			want:       errRespCatNotExistingID,
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "not allowed method",
+			args:       args{catID: dummy.Cat1.ID},
+			method:     http.MethodHead,
+			want:       testingHelper.ErrNotAllowedMethod,
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// init
+			db := testingHelper.SetupDBTest()
+			defer testingHelper.TeardownDBTest(db)
+			testingHelper.SetTestTime()
+			defer testingHelper.ResetTime()
+
+			// insert dummy data
+			insertDummyCats(t, db)
+
+			// http request
+			req, err := http.NewRequest(tt.method, fmt.Sprintf("/cats/%v", tt.args.catID), nil)
+			assert.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{"cat_id": strconv.Itoa(int(tt.args.catID))})
+			req = req.WithContext(httpContext.SetTokenUserName(req.Context(), dummy.User1.Name))
+			resp := httptest.NewRecorder()
+
+			// test target
+			CatController(resp, req)
+
+			// assert http
+			assert.Equal(t, tt.wantStatus, resp.Code)
+			respBodyByte, err := ioutil.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			respBody := string(respBodyByte)
+			assert.JSONEq(t, tt.want, testingHelper.StripURLSignatures(respBody))
+		})
+	}
+}
+
+var successReqUpdateCat = `
+{
+  "name": "Tama-chan",
+  "bio": "likes boxes"
+}
+`
+var errRespCatNotOwner = `
+{
+  "status": 403,
+  "message": "you can edit only your cat"
+}
+`
+
+func TestUpdateCat(t *testing.T) {
+	type args struct {
+		catID   int64
+		reqBody string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		method     string
+		want       string
+		wantStatus int
+	}{
+		{
+			name:       "success",
+			args:       args{catID: dummy.Cat1.ID, reqBody: successReqUpdateCat},
+			method:     http.MethodPut,
+			want:       testingHelper.RespSimpleSuccess,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "error empty name",
+			args:       args{catID: dummy.Cat1.ID, reqBody: errReqRegisterCatWithoutName},
+			method:     http.MethodPut,
+			want:       errRespRegisterCatWithoutName,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "error not existing cat",
+			args:       args{catID: 100, reqBody: successReqUpdateCat},
+			method:     http.MethodPut,
+			want:       errRespCatNotExistingID,
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "error not owner",
+			args:       args{catID: dummy.Cat2.ID, reqBody: successReqUpdateCat},
+			method:     http.MethodPut,
+			want:       errRespCatNotOwner,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "error forbidden guest user",
+			args:       args{catID: dummy.Cat1.ID, reqBody: successReqUpdateCat},
+			method:     http.MethodPut,
+			want:       testingHelper.ErrForbidden,
+			wantStatus: http.StatusForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// init
+			db := testingHelper.SetupDBTest()
+			defer testingHelper.TeardownDBTest(db)
+			testingHelper.SetTestTime()
+			defer testingHelper.ResetTime()
+
+			// insert dummy data
+			insertDummyCats(t, db)
+
+			// http request
+			req, err := http.NewRequest(tt.method, fmt.Sprintf("/cats/%v", tt.args.catID), strings.NewReader(tt.args.reqBody))
+			assert.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{"cat_id": strconv.Itoa(int(tt.args.catID))})
+			if tt.name == "error forbidden guest user" {
+				req = req.WithContext(httpContext.SetTokenUserName(req.Context(), helper.GuestUserName))
+			} else {
+				req = req.WithContext(httpContext.SetTokenUserName(req.Context(), dummy.User1.Name))
+			}
+			resp := httptest.NewRecorder()
+
+			// test target
+			CatController(resp, req)
+
+			// assert db
+			if tt.wantStatus == http.StatusOK {
+				cats, err := testingHelper.FindAllCats(context.Background(), db)
+				assert.NoError(t, err)
+				assert.Equal(t, "Tama-chan", cats[0].Name)
+				assert.Equal(t, "", cats[0].Breed)
+				assert.Nil(t, cats[0].Birthday)
+				assert.Equal(t, "likes boxes", cats[0].Bio)
+				// other cats are left as they are
+				assert.Equal(t, dummy.Cat2.Name, cats[1].Name)
+			}
+
+			// assert http
+			assert.Equal(t, tt.wantStatus, resp.Code)
+			respBodyByte, err := ioutil.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			respBody := string(respBodyByte)
+			assert.JSONEq(t, tt.want, respBody)
+		})
+	}
+}
+
+func TestDeleteCat(t *testing.T) {
+	type args struct {
+		catID int64
+	}
+	tests := []struct {
+		name       string
+		args       args
+		method     string
+		want       string
+		wantStatus int
+	}{
+		{
+			name:       "success",
+			args:       args{catID: dummy.Cat1.ID},
+			method:     http.MethodDelete,
+			want:       testingHelper.RespSimpleSuccess,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "error not existing cat",
+			args:       args{catID: 100},
+			method:     http.MethodDelete,
+			want:       errRespCatNotExistingID,
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "error not owner",
+			args:       args{catID: dummy.Cat2.ID},
+			method:     http.MethodDelete,
+			want:       errRespCatNotOwner,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "error forbidden guest user",
+			args:       args{catID: dummy.Cat1.ID},
+			method:     http.MethodDelete,
+			want:       testingHelper.ErrForbidden,
+			wantStatus: http.StatusForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// init
+			db := testingHelper.SetupDBTest()
+			defer testingHelper.TeardownDBTest(db)
+			testingHelper.SetTestTime()
+			defer testingHelper.ResetTime()
+
+			// insert dummy data
+			insertDummyCats(t, db)
+
+			// http request
+			req, err := http.NewRequest(tt.method, fmt.Sprintf("/cats/%v", tt.args.catID), nil)
+			assert.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{"cat_id": strconv.Itoa(int(tt.args.catID))})
+			if tt.name == "error forbidden guest user" {
+				req = req.WithContext(httpContext.SetTokenUserName(req.Context(), helper.GuestUserName))
+			} else {
+				req = req.WithContext(httpContext.SetTokenUserName(req.Context(), dummy.User1.Name))
+			}
+			resp := httptest.NewRecorder()
+
+			// test target
+			CatController(resp, req)
+
+			// assert db
+			cats, err := testingHelper.FindAllCats(context.Background(), db)
+			assert.NoError(t, err)
+			postingCats, err := testingHelper.FindAllPostingCats(context.Background(), db)
+			assert.NoError(t, err)
+			postings, err := testingHelper.FindAllPostings(context.Background(), db)
+			assert.NoError(t, err)
+			if tt.wantStatus == http.StatusOK {
+				assert.Equal(t, 1, len(cats))
+				assert.Equal(t, dummy.Cat2.ID, cats[0].ID)
+				assert.Equal(t, 0, len(postingCats))
+				// the postings stay
+				assert.Equal(t, 1, len(postings))
+			} else {
+				assert.Equal(t, 2, len(cats))
+				assert.Equal(t, 1, len(postingCats))
+			}
+
+			// assert http
+			assert.Equal(t, tt.wantStatus, resp.Code)
+			respBodyByte, err := ioutil.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			respBody := string(respBodyByte)
+			assert.JSONEq(t, tt.want, respBody)
+		})
+	}
+}
+
+var successRespGetCatPostings = `
+{
+  "postings": [
+    {
+      "posting_id": 1,
+      "user_name": "testUser1",
+      "uploaded_at": "2020-01-01T00:00:00+09:00",
+      "title": "This is a sample posting.",
+      "alt_text": "Cat, Whiskers, Tabby cat",
+      "image_url": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_full.jpg",
+      "image_urls": {
+        "thumb": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_thumb.jpg",
+        "feed": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_feed.jpg",
+        "full": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_full.jpg"
+      },
+      "images": [
+        {
+          "thumb": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_thumb.jpg",
+          "feed": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_feed.jpg",
+          "full": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_full.jpg"
+        }
+      ],
+      "liked_count": 0,
+      "liked": false
+    }
+  ]
+}
+`
+
+func TestGetCatPostings(t *testing.T) {
+	type args struct {
+		catID int64
+		limit string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		method     string
+		want       string
+		wantStatus int
+	}{
+		{
+			name:       "success",
+			args:       args{catID: dummy.Cat1.ID, limit: "50"},
+			method:     http.MethodGet,
+			want:       successRespGetCatPostings,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "success no postings",
+			args:       args{catID: dummy.Cat2.ID, limit: "50"},
+			method:     http.MethodGet,
+			want:       successRespGetPostingsEmpty,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "error empty limit",
+			args:       args{catID: dummy.Cat1.ID},
+			method:     http.MethodGet,
+			want:       errRespGetPostingsWithoutLimit,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "error not existing cat",
+			args:       args{catID: 100, limit: "50"},
+			method:     http.MethodGet,
+			want:       errRespCatNotExistingID,
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "not allowed method",
+			args:       args{catID: dummy.Cat1.ID},
+			method:     http.MethodPost,
+			want:       testingHelper.ErrNotAllowedMethod,
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// init
+			db := testingHelper.SetupDBTest()
+			defer testingHelper.TeardownDBTest(db)
+			testingHelper.SetTestTime()
+			defer testingHelper.ResetTime()
+
+			// insert dummy data
+			insertDummyCats(t, db)
+
+			// http request
+			req, err := http.NewRequest(tt.method, fmt.Sprintf("/cats/%v/postings?limit=%s", tt.args.catID, tt.args.limit), nil)
+			assert.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{"cat_id": strconv.Itoa(int(tt.args.catID))})
+			req = req.WithContext(httpContext.SetTokenUserName(req.Context(), dummy.User2.Name))
+			resp := httptest.NewRecorder()
+
+			// test target
+			CatController(resp, req)
+
+			// assert http
+			assert.Equal(t, tt.wantStatus, resp.Code)
+			respBodyByte, err := ioutil.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			respBody := string(respBodyByte)
+			assert.JSONEq(t, tt.want, testingHelper.StripURLSignatures(respBody))
+		})
+	}
+}
@@ -0,0 +1,605 @@
+package controller
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/gorilla/mux"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/http/context"
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/http/helper"
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/application/usecase"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+	modelHTTP "github.com/gold-kou/ToeBeans/backend/app/domain/model/http"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+)
+
+func CollectionController(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.URL.Path == "/collections":
+		switch r.Method {
+		case http.MethodPost:
+			err := registerCollection(r)
+			switch err := err.(type) {
+			case nil:
+				helper.ResponseSimpleSuccess(w)
+			case *helper.BadRequestError:
+				helper.ResponseBadRequest(w, err.Error())
+			case *helper.AuthorizationError:
+				helper.ResponseUnauthorized(w, err.Error())
+			case *helper.ForbiddenError:
+				helper.ResponseForbidden(w, err.Error())
+			case *helper.InternalServerError:
+				helper.ResponseInternalServerError(w, err.Error())
+			default:
+				helper.ResponseInternalServerError(w, err.Error())
+			}
+		default:
+			methods := []string{http.MethodPost}
+			helper.ResponseNotAllowedMethod(w, errMsgNotAllowedMethod, methods)
+		}
+	case strings.HasPrefix(r.URL.Path, "/collections/") && strings.Contains(r.URL.Path, "/postings/"):
+		switch r.Method {
+		case http.MethodDelete:
+			err := deleteCollectionPosting(r)
+			switch err := err.(type) {
+			case nil:
+				helper.ResponseSimpleSuccess(w)
+			case *helper.BadRequestError:
+				helper.ResponseBadRequest(w, err.Error())
+			case *helper.AuthorizationError:
+				helper.ResponseUnauthorized(w, err.Error())
+			case *helper.ForbiddenError:
+				helper.ResponseForbidden(w, err.Error())
+			case *helper.NotFoundError:
+				helper.ResponseNotFound(w, err.Error())
+			case *helper.InternalServerError:
+				helper.ResponseInternalServerError(w, err.Error())
+			default:
+				helper.ResponseInternalServerError(w, err.Error())
+			}
+		default:
+			methods := []string{http.MethodDelete}
+			helper.ResponseNotAllowedMethod(w, errMsgNotAllowedMethod, methods)
+		}
+	case strings.HasPrefix(r.URL.Path, "/collections/") && strings.HasSuffix(r.URL.Path, "/postings"):
+		switch r.Method {
+		case http.MethodPost:
+			err := addCollectionPosting(r)
+			switch err := err.(type) {
+			case nil:
+				helper.ResponseSimpleSuccess(w)
+			case *helper.BadRequestError:
+				helper.ResponseBadRequest(w, err.Error())
+			case *helper.AuthorizationError:
+				helper.ResponseUnauthorized(w, err.Error())
+			case *helper.ForbiddenError:
+				helper.ResponseForbidden(w, err.Error())
+			case *helper.NotFoundError:
+				helper.ResponseNotFound(w, err.Error())
+			case *helper.ConflictError:
+				helper.ResponseConflictError(w, err.Error())
+			case *helper.InternalServerError:
+				helper.ResponseInternalServerError(w, err.Error())
+			default:
+				helper.ResponseInternalServerError(w, err.Error())
+			}
+		case http.MethodPut:
+			err := reorderCollectionPostings(r)
+			switch err := err.(type) {
+			case nil:
+				helper.ResponseSimpleSuccess(w)
+			case *helper.BadRequestError:
+				helper.ResponseBadRequest(w, err.Error())
+			case *helper.AuthorizationError:
+				helper.ResponseUnauthorized(w, err.Error())
+			case *helper.ForbiddenError:
+				helper.ResponseForbidden(w, err.Error())
+			case *helper.NotFoundError:
+				helper.ResponseNotFound(w, err.Error())
+			case *helper.InternalServerError:
+				helper.ResponseInternalServerError(w, err.Error())
+			default:
+				helper.ResponseInternalServerError(w, err.Error())
+			}
+		default:
+			methods := []string{http.MethodPost, http.MethodPut}
+			helper.ResponseNotAllowedMethod(w, errMsgNotAllowedMethod, methods)
+		}
+	case strings.HasPrefix(r.URL.Path, "/collections/"):
+		switch r.Method {
+		case http.MethodGet:
+			collection, userName, postings, userNames, likedCounts, likes, err := getCollection(r)
+			switch err := err.(type) {
+			case nil:
+				resp := modelHTTP.ResponseGetCollectionDetail{
+					ResponseGetCollection: newResponseGetCollection(collection, userName),
+					Postings:              newResponseGetPostings(postings, userNames, likedCounts, likes).Postings,
+				}
+				w.Header().Set(helper.HeaderKeyContentType, helper.HeaderValueApplicationJSON)
+				w.WriteHeader(http.StatusOK)
+				if err := json.NewEncoder(w).Encode(resp); err != nil {
+					log.Println(err.Error())
+				}
+			case *helper.BadRequestError:
+				helper.ResponseBadRequest(w, err.Error())
+			case *helper.AuthorizationError:
+				helper.ResponseUnauthorized(w, err.Error())
+			case *helper.NotFoundError:
+				helper.ResponseNotFound(w, err.Error())
+			case *helper.InternalServerError:
+				helper.ResponseInternalServerError(w, err.Error())
+			default:
+				helper.ResponseInternalServerError(w, err.Error())
+			}
+		case http.MethodPut:
+			err := updateCollection(r)
+			switch err := err.(type) {
+			case nil:
+				helper.ResponseSimpleSuccess(w)
+			case *helper.BadRequestError:
+				helper.ResponseBadRequest(w, err.Error())
+			case *helper.AuthorizationError:
+				helper.ResponseUnauthorized(w, err.Error())
+			case *helper.ForbiddenError:
+				helper.ResponseForbidden(w, err.Error())
+			case *helper.NotFoundError:
+				helper.ResponseNotFound(w, err.Error())
+			case *helper.InternalServerError:
+				helper.ResponseInternalServerError(w, err.Error())
+			default:
+				helper.ResponseInternalServerError(w, err.Error())
+			}
+		case http.MethodDelete:
+			err := deleteCollection(r)
+			switch err := err.(type) {
+			case nil:
+				helper.ResponseSimpleSuccess(w)
+			case *helper.BadRequestError:
+				helper.ResponseBadRequest(w, err.Error())
+			case *helper.AuthorizationError:
+				helper.ResponseUnauthorized(w, err.Error())
+			case *helper.ForbiddenError:
+				helper.ResponseForbidden(w, err.Error())
+			case *helper.NotFoundError:
+				helper.ResponseNotFound(w, err.Error())
+			case *helper.InternalServerError:
+				helper.ResponseInternalServerError(w, err.Error())
+			default:
+				helper.ResponseInternalServerError(w, err.Error())
+			}
+		default:
+			methods := []string{http.MethodGet, http.MethodPut, http.MethodDelete}
+			helper.ResponseNotAllowedMethod(w, errMsgNotAllowedMethod, methods)
+		}
+	default:
+		helper.ResponseInternalServerError(w, errMsgControllerPath)
+	}
+}
+
+func newResponseGetCollection(c model.Collection, userName string) modelHTTP.ResponseGetCollection {
+	return modelHTTP.ResponseGetCollection{
+		CollectionId: c.ID,
+		UserName:     userName,
+		Name:         c.Name,
+		IsPublic:     c.IsPublic,
+		CreatedAt:    c.CreatedAt,
+	}
+}
+
+// newResponseGetCollections is for the collections of one user.
+func newResponseGetCollections(collections []model.Collection, userName string) []modelHTTP.ResponseGetCollection {
+	var httpCollections = []modelHTTP.ResponseGetCollection{}
+	for _, c := range collections {
+		httpCollections = append(httpCollections, newResponseGetCollection(c, userName))
+	}
+	return httpCollections
+}
+
+// getCollectionID returns the collection_id path parameter.
+func getCollectionID(r *http.Request) (int64, error) {
+	vars := mux.Vars(r)
+	paramCollectionID, _ := vars["collection_id"]
+	collectionID, err := strconv.ParseInt(paramCollectionID, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	if err = validation.Validate(collectionID, validation.Required); err != nil {
+		return 0, err
+	}
+	return collectionID, nil
+}
+
+// collectionUseCaseError converts the errors common to the usecases editing a collection.
+func collectionUseCaseError(err error) error {
+	switch err {
+	case usecase.ErrNotExistsData:
+		return helper.NewNotFoundError(err.Error())
+	case usecase.ErrNotCollectionOwner:
+		return helper.NewForbiddenError(err.Error())
+	case usecase.ErrTokenInvalidNotExistingUserName:
+		return helper.NewAuthorizationError(err.Error())
+	}
+	return helper.NewInternalServerError(err.Error())
+}
+
+func registerCollection(r *http.Request) error {
+	// not allowed to guest user
+	tokenUserName, err := context.GetTokenUserName(r.Context())
+	if err != nil {
+		log.Println(err)
+		return helper.NewInternalServerError(err.Error())
+	}
+	if tokenUserName == helper.GuestUserName {
+		log.Println(errMsgGuestUserForbidden)
+		return helper.NewForbiddenError(errMsgGuestUserForbidden)
+	}
+
+	// get request parameter
+	var reqRegisterCollection *modelHTTP.RequestRegisterCollection
+	b, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		log.Println(err)
+		return helper.NewBadRequestError(err.Error())
+	}
+	defer r.Body.Close()
+	if err = json.Unmarshal(b, &reqRegisterCollection); err != nil {
+		log.Println(err)
+		return helper.NewBadRequestError(err.Error())
+	}
+
+	// validation check
+	if err = reqRegisterCollection.ValidateParam(); err != nil {
+		log.Println(err)
+		return helper.NewBadRequestError(err.Error())
+	}
+
+	// db connect
+	db, err := mysql.NewDB()
+	if err != nil {
+		log.Println(err)
+		return helper.NewInternalServerError(err.Error())
+	}
+	defer db.Close()
+	tx := mysql.NewDBTransaction(db)
+
+	// repository
+	userRepo := repository.NewUserRepository(db)
+	collectionRepo := repository.NewCollectionRepository(db)
+
+	// UseCase
+	u := usecase.NewRegisterCollection(tx, tokenUserName, reqRegisterCollection, userRepo, collectionRepo)
+	if err = u.RegisterCollectionUseCase(r.Context()); err != nil {
+		log.Println(err)
+		if err == usecase.ErrTokenInvalidNotExistingUserName {
+			return helper.NewAuthorizationError(err.Error())
+		}
+		return helper.NewInternalServerError(err.Error())
+	}
+	return nil
+}
+
+func getCollection(r *http.Request) (collection model.Collection, userName string, postings []model.Posting, userNames []string, likedCounts []int64, likes []model.Like, err error) {
+	tokenUserName, err := context.GetTokenUserName(r.Context())
+	if err != nil {
+		log.Println(err)
+		err = helper.NewInternalServerError(err.Error())
+		return
+	}
+
+	// get request parameter and validation check
+	collectionID, err := getCollectionID(r)
+	if err != nil {
+		log.Println(err)
+		err = helper.NewBadRequestError(err.Error())
+		return
+	}
+
+	// db connect
+	db, err := mysql.NewDB()
+	if err != nil {
+		log.Println(err)
+		err = helper.NewInternalServerError(err.Error())
+		return
+	}
+	defer db.Close()
+	tx := mysql.NewDBTransaction(db)
+
+	// repository
+	userRepo := repository.NewUserRepository(db)
+	postingRepo := repository.NewPostingRepository(db)
+	likeRepo := repository.NewLikeRepository(db)
+	postingCounterRepo := repository.NewPostingCounterRepository(db)
+	postingImageRepo := repository.NewPostingImageRepository(db)
+	collectionRepo := repository.NewCollectionRepository(db)
+
+	// UseCase
+	u := usecase.NewGetCollection(tx, tokenUserName, collectionID, userRepo, postingRepo, likeRepo, postingCounterRepo, postingImageRepo, collectionRepo)
+	if collection, userName, postings, userNames, likedCounts, likes, err = u.GetCollectionUseCase(r.Context()); err != nil {
+		log.Println(err)
+		err = collectionUseCaseError(err)
+		return
+	}
+	return
+}
+
+func updateCollection(r *http.Request) error {
+	// not allowed to guest user
+	tokenUserName, err := context.GetTokenUserName(r.Context())
+	if err != nil {
+		log.Println(err)
+		return helper.NewInternalServerError(err.Error())
+	}
+	if tokenUserName == helper.GuestUserName {
+		log.Println(errMsgGuestUserForbidden)
+		return helper.NewForbiddenError(errMsgGuestUserForbidden)
+	}
+
+	// get request parameter
+	collectionID, err := getCollectionID(r)
+	if err != nil {
+		log.Println(err)
+		return helper.NewBadRequestError(err.Error())
+	}
+	var reqUpdateCollection *modelHTTP.RequestUpdateCollection
+	b, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		log.Println(err)
+		return helper.NewBadRequestError(err.Error())
+	}
+	defer r.Body.Close()
+	if err = json.Unmarshal(b, &reqUpdateCollection); err != nil {
+		log.Println(err)
+		return helper.NewBadRequestError(err.Error())
+	}
+
+	// validation check
+	if err = reqUpdateCollection.ValidateParam(); err != nil {
+		log.Println(err)
+		return helper.NewBadRequestError(err.Error())
+	}
+
+	// db connect
+	db, err := mysql.NewDB()
+	if err != nil {
+		log.Println(err)
+		return helper.NewInternalServerError(err.Error())
+	}
+	defer db.Close()
+	tx := mysql.NewDBTransaction(db)
+
+	// repository
+	userRepo := repository.NewUserRepository(db)
+	collectionRepo := repository.NewCollectionRepository(db)
+
+	// UseCase
+	u := usecase.NewUpdateCollection(tx, collectionID, tokenUserName, reqUpdateCollection, userRepo, collectionRepo)
+	if err = u.UpdateCollectionUseCase(r.Context()); err != nil {
+		log.Println(err)
+		return collectionUseCaseError(err)
+	}
+	return nil
+}
+
+func deleteCollection(r *http.Request) error {
+	// not allowed to guest user
+	tokenUserName, err := context.GetTokenUserName(r.Context())
+	if err != nil {
+		log.Println(err)
+		return helper.NewInternalServerError(err.Error())
+	}
+	if tokenUserName == helper.GuestUserName {
+		log.Println(errMsgGuestUserForbidden)
+		return helper.NewForbiddenError(errMsgGuestUserForbidden)
+	}
+
+	// get request parameter and validation check
+	collectionID, err := getCollectionID(r)
+	if err != nil {
+		log.Println(err)
+		return helper.NewBadRequestError(err.Error())
+	}
+
+	// db connect
+	db, err := mysql.NewDB()
+	if err != nil {
+		log.Println(err)
+		return helper.NewInternalServerError(err.Error())
+	}
+	defer db.Close()
+	tx := mysql.NewDBTransaction(db)
+
+	// repository
+	userRepo := repository.NewUserRepository(db)
+	collectionRepo := repository.NewCollectionRepository(db)
+	collectionPostingRepo := repository.NewCollectionPostingRepository(db)
+
+	// UseCase
+	u := usecase.NewDeleteCollection(tx, collectionID, tokenUserName, userRepo, collectionRepo, collectionPostingRepo)
+	if err = u.DeleteCollectionUseCase(r.Context()); err != nil {
+		log.Println(err)
+		return collectionUseCaseError(err)
+	}
+	return nil
+}
+
+func addCollectionPosting(r *http.Request) error {
+	// not allowed to guest user
+	tokenUserName, err := context.GetTokenUserName(r.Context())
+	if err != nil {
+		log.Println(err)
+		return helper.NewInternalServerError(err.Error())
+	}
+	if tokenUserName == helper.GuestUserName {
+		log.Println(errMsgGuestUserForbidden)
+		return helper.NewForbiddenError(errMsgGuestUserForbidden)
+	}
+
+	// get request parameter
+	collectionID, err := getCollectionID(r)
+	if err != nil {
+		log.Println(err)
+		return helper.NewBadRequestError(err.Error())
+	}
+	var reqAddCollectionPosting *modelHTTP.RequestAddCollectionPosting
+	b, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		log.Println(err)
+		return helper.NewBadRequestError(err.Error())
+	}
+	defer r.Body.Close()
+	if err = json.Unmarshal(b, &reqAddCollectionPosting); err != nil {
+		log.Println(err)
+		return helper.NewBadRequestError(err.Error())
+	}
+
+	// validation check
+	if err = reqAddCollectionPosting.ValidateParam(); err != nil {
+		log.Println(err)
+		return helper.NewBadRequestError(err.Error())
+	}
+
+	// db connect
+	db, err := mysql.NewDB()
+	if err != nil {
+		log.Println(err)
+		return helper.NewInternalServerError(err.Error())
+	}
+	defer db.Close()
+	tx := mysql.NewDBTransaction(db)
+
+	// repository
+	userRepo := repository.NewUserRepository(db)
+	postingRepo := repository.NewPostingRepository(db)
+	collectionRepo := repository.NewCollectionRepository(db)
+	collectionPostingRepo := repository.NewCollectionPostingRepository(db)
+
+	// UseCase
+	u := usecase.NewAddCollectionPosting(tx, collectionID, tokenUserName, reqAddCollectionPosting.PostingID, userRepo, postingRepo, collectionRepo, collectionPostingRepo)
+	if err = u.AddCollectionPostingUseCase(r.Context()); err != nil {
+		log.Println(err)
+		if err == usecase.ErrPostingNotExists || err == usecase.ErrCollectionFull {
+			return helper.NewBadRequestError(err.Error())
+		}
+		if err == usecase.ErrAlreadyInCollection {
+			return helper.NewConflictError(err.Error())
+		}
+		return collectionUseCaseError(err)
+	}
+	return nil
+}
+
+func reorderCollectionPostings(r *http.Request) error {
+	// not allowed to guest user
+	tokenUserName, err := context.GetTokenUserName(r.Context())
+	if err != nil {
+		log.Println(err)
+		return helper.NewInternalServerError(err.Error())
+	}
+	if tokenUserName == helper.GuestUserName {
+		log.Println(errMsgGuestUserForbidden)
+		return helper.NewForbiddenError(errMsgGuestUserForbidden)
+	}
+
+	// get request parameter
+	collectionID, err := getCollectionID(r)
+	if err != nil {
+		log.Println(err)
+		return helper.NewBadRequestError(err.Error())
+	}
+	var reqReorderCollectionPostings *modelHTTP.RequestReorderCollectionPostings
+	b, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		log.Println(err)
+		return helper.NewBadRequestError(err.Error())
+	}
+	defer r.Body.Close()
+	if err = json.Unmarshal(b, &reqReorderCollectionPostings); err != nil {
+		log.Println(err)
+		return helper.NewBadRequestError(err.Error())
+	}
+
+	// validation check
+	if err = reqReorderCollectionPostings.ValidateParam(); err != nil {
+		log.Println(err)
+		return helper.NewBadRequestError(err.Error())
+	}
+
+	// db connect
+	db, err := mysql.NewDB()
+	if err != nil {
+		log.Println(err)
+		return helper.NewInternalServerError(err.Error())
+	}
+	defer db.Close()
+	tx := mysql.NewDBTransaction(db)
+
+	// repository
+	userRepo := repository.NewUserRepository(db)
+	collectionRepo := repository.NewCollectionRepository(db)
+	collectionPostingRepo := repository.NewCollectionPostingRepository(db)
+
+	// UseCase
+	u := usecase.NewReorderCollectionPostings(tx, collectionID, tokenUserName, reqReorderCollectionPostings.PostingIDs, userRepo, collectionRepo, collectionPostingRepo)
+	if err = u.ReorderCollectionPostingsUseCase(r.Context()); err != nil {
+		log.Println(err)
+		if err == usecase.ErrInvalidCollectionOrder {
+			return helper.NewBadRequestError(err.Error())
+		}
+		return collectionUseCaseError(err)
+	}
+	return nil
+}
+
+func deleteCollectionPosting(r *http.Request) error {
+	// not allowed to guest user
+	tokenUserName, err := context.GetTokenUserName(r.Context())
+	if err != nil {
+		log.Println(err)
+		return helper.NewInternalServerError(err.Error())
+	}
+	if tokenUserName == helper.GuestUserName {
+		log.Println(errMsgGuestUserForbidden)
+		return helper.NewForbiddenError(errMsgGuestUserForbidden)
+	}
+
+	// get request parameter and validation check
+	collectionID, err := getCollectionID(r)
+	if err != nil {
+		log.Println(err)
+		return helper.NewBadRequestError(err.Error())
+	}
+	postingID, err := getPostingID(r)
+	if err != nil {
+		log.Println(err)
+		return helper.NewBadRequestError(err.Error())
+	}
+
+	// db connect
+	db, err := mysql.NewDB()
+	if err != nil {
+		log.Println(err)
+		return helper.NewInternalServerError(err.Error())
+	}
+	defer db.Close()
+	tx := mysql.NewDBTransaction(db)
+
+	// repository
+	userRepo := repository.NewUserRepository(db)
+	collectionRepo := repository.NewCollectionRepository(db)
+	collectionPostingRepo := repository.NewCollectionPostingRepository(db)
+
+	// UseCase
+	u := usecase.NewDeleteCollectionPosting(tx, collectionID, tokenUserName, postingID, userRepo, collectionRepo, collectionPostingRepo)
+	if err = u.DeleteCollectionPostingUseCase(r.Context()); err != nil {
+		log.Println(err)
+		return collectionUseCaseError(err)
+	}
+	return nil
+}
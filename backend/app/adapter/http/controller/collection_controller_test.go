@@ -0,0 +1,742 @@
+package controller
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	httpContext "github.com/gold-kou/ToeBeans/backend/app/adapter/http/context"
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/http/helper"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+	testingHelper "github.com/gold-kou/ToeBeans/backend/testing"
+	"github.com/gold-kou/ToeBeans/backend/testing/dummy"
+)
+
+var successReqRegisterCollection = `
+{
+  "name": "sleeping cats",
+  "is_public": true
+}
+`
+var errReqRegisterCollectionWithoutName = `
+{
+  "is_public": true
+}
+`
+var errRespRegisterCollectionWithoutName = `
+{
+  "status": 400,
+  "message": "name: cannot be blank."
+}
+`
+var errRespCollectionNotExisting = `
+{
+  "status": 404,
+  "message": "not exists data error"
+}
+`
+var errRespNotCollectionOwner = `
+{
+  "status": 403,
+  "message": "you can edit only your collection"
+}
+`
+
+// insertDummyCollections makes the public collection1 holding posting1 and posting2 in this order and the empty private collection2, both of user1.
+func insertDummyCollections(t *testing.T, db *sql.DB) {
+	userRepo := repository.NewUserRepository(db)
+	postingRepo := repository.NewPostingRepository(db)
+	collectionRepo := repository.NewCollectionRepository(db)
+	collectionPostingRepo := repository.NewCollectionPostingRepository(db)
+	err := userRepo.Create(context.Background(), &dummy.User1)
+	assert.NoError(t, err)
+	err = userRepo.Create(context.Background(), &dummy.User2)
+	assert.NoError(t, err)
+	err = postingRepo.Create(context.Background(), &dummy.Posting1)
+	assert.NoError(t, err)
+	err = postingRepo.Create(context.Background(), &dummy.Posting2)
+	assert.NoError(t, err)
+	err = testingHelper.UpdateNow(db, "postings")
+	assert.NoError(t, err)
+	err = collectionRepo.Create(context.Background(), &dummy.Collection1)
+	assert.NoError(t, err)
+	err = collectionRepo.Create(context.Background(), &dummy.Collection2)
+	assert.NoError(t, err)
+	err = testingHelper.UpdateNow(db, "collections")
+	assert.NoError(t, err)
+	err = collectionPostingRepo.Create(context.Background(), &dummy.CollectionPosting1)
+	assert.NoError(t, err)
+	err = collectionPostingRepo.Create(context.Background(), &dummy.CollectionPosting2)
+	assert.NoError(t, err)
+}
+
+func TestRegisterCollection(t *testing.T) {
+	type args struct {
+		reqBody string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		method     string
+		want       string
+		wantStatus int
+	}{
+		{
+			name:       "success",
+			args:       args{reqBody: successReqRegisterCollection},
+			method:     http.MethodPost,
+			want:       testingHelper.RespSimpleSuccess,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "error empty name",
+			args:       args{reqBody: errReqRegisterCollectionWithoutName},
+			method:     http.MethodPost,
+			want:       errRespRegisterCollectionWithoutName,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "error forbidden guest user",
+			args:       args{reqBody: successReqRegisterCollection},
+			method:     http.MethodPost,
+			want:       testingHelper.ErrForbidden,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "not allowed method",
+			args:       args{},
+			method:     http.MethodGet,
+			want:       testingHelper.ErrNotAllowedMethod,
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// init
+			db := testingHelper.SetupDBTest()
+			defer testingHelper.TeardownDBTest(db)
+
+			// insert dummy data
+			userRepo := repository.NewUserRepository(db)
+			err := userRepo.Create(context.Background(), &dummy.User1)
+			assert.NoError(t, err)
+
+			// http request
+			req, err := http.NewRequest(tt.method, "/collections", strings.NewReader(tt.args.reqBody))
+			assert.NoError(t, err)
+			if tt.name == "error forbidden guest user" {
+				req = req.WithContext(httpContext.SetTokenUserName(req.Context(), helper.GuestUserName))
+			} else {
+				req = req.WithContext(httpContext.SetTokenUserName(req.Context(), dummy.User1.Name))
+			}
+			resp := httptest.NewRecorder()
+
+			// test target
+			CollectionController(resp, req)
+
+			// assert db
+			if tt.wantStatus == http.StatusOK {
+				collections, err := testingHelper.FindAllCollections(context.Background(), db)
+				assert.NoError(t, err)
+				assert.Equal(t, 1, len(collections))
+				assert.Equal(t, dummy.User1.ID, collections[0].UserID)
+				assert.Equal(t, dummy.Collection1.Name, collections[0].Name)
+				assert.True(t, collections[0].IsPublic)
+			}
+
+			// assert http
+			assert.Equal(t, tt.wantStatus, resp.Code)
+			respBodyByte, err := ioutil.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			respBody := string(respBodyByte)
+			assert.JSONEq(t, tt.want, respBody)
+		})
+	}
+}
+
+var successRespGetCollection = `
+{
+  "collection_id": 1,
+  "user_name": "testUser1",
+  "name": "sleeping cats",
+  "is_public": true,
+  "created_at": "2020-01-01T00:00:00+09:00",
+  "postings": [
+    {
+      "posting_id": 1,
+      "user_name": "testUser1",
+      "uploaded_at": "2020-01-01T00:00:00+09:00",
+      "title": "This is a sample posting.",
+      "alt_text": "Cat, Whiskers, Tabby cat",
+      "image_url": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_full.jpg",
+      "image_urls": {
+        "thumb": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_thumb.jpg",
+        "feed": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_feed.jpg",
+        "full": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_full.jpg"
+      },
+      "images": [
+        {
+          "thumb": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_thumb.jpg",
+          "feed": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_feed.jpg",
+          "full": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_full.jpg"
+        }
+      ],
+      "liked_count": 0,
+      "liked": false
+    },
+    {
+      "posting_id": 2,
+      "user_name": "testUser2",
+      "uploaded_at": "2020-01-01T00:00:00+09:00",
+      "title": "test title",
+      "alt_text": "test alt text",
+      "image_url": "test url",
+      "image_urls": {
+        "thumb": "test url",
+        "feed": "test url",
+        "full": "test url"
+      },
+      "images": [
+        {
+          "thumb": "test url",
+          "feed": "test url",
+          "full": "test url"
+        }
+      ],
+      "liked_count": 0,
+      "liked": false
+    }
+  ]
+}
+`
+var successRespGetPrivateCollection = `
+{
+  "collection_id": 2,
+  "user_name": "testUser1",
+  "name": "secret",
+  "is_public": false,
+  "created_at": "2020-01-01T00:00:00+09:00",
+  "postings": []
+}
+`
+
+func TestGetCollection(t *testing.T) {
+	type args struct {
+		tokenUserName string
+		collectionID  int64
+	}
+	tests := []struct {
+		name       string
+		args       args
+		method     string
+		want       string
+		wantStatus int
+	}{
+		{
+			name:       "success public collection by others",
+			args:       args{tokenUserName: dummy.User2.Name, collectionID: dummy.Collection1.ID},
+			method:     http.MethodGet,
+			want:       successRespGetCollection,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "success private collection by the owner",
+			args:       args{tokenUserName: dummy.User1.Name, collectionID: dummy.Collection2.ID},
+			method:     http.MethodGet,
+			want:       successRespGetPrivateCollection,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "error private collection by others",
+			args:       args{tokenUserName: dummy.User2.Name, collectionID: dummy.Collection2.ID},
+			method:     http.MethodGet,
+			want:       errRespCollectionNotExisting,
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "error not existing collection",
+			args:       args{tokenUserName: dummy.User1.Name, collectionID: 100},
+			method:     http.MethodGet,
+			want:       errRespCollectionNotExisting,
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "not allowed method",
+			args:       args{tokenUserName: dummy.User1.Name, collectionID: dummy.Collection1.ID},
+			method:     http.MethodPost,
+			want:       testingHelper.ErrNotAllowedMethod,
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// init
+			db := testingHelper.SetupDBTest()
+			defer testingHelper.TeardownDBTest(db)
+			testingHelper.SetTestTime()
+			defer testingHelper.ResetTime()
+
+			// insert dummy data
+			insertDummyCollections(t, db)
+
+			// http request
+			req, err := http.NewRequest(tt.method, fmt.Sprintf("/collections/%d", tt.args.collectionID), nil)
+			assert.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{"collection_id": strconv.Itoa(int(tt.args.collectionID))})
+			req = req.WithContext(httpContext.SetTokenUserName(req.Context(), tt.args.tokenUserName))
+			resp := httptest.NewRecorder()
+
+			// test target
+			CollectionController(resp, req)
+
+			// assert http
+			assert.Equal(t, tt.wantStatus, resp.Code)
+			respBodyByte, err := ioutil.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			respBody := string(respBodyByte)
+			assert.JSONEq(t, tt.want, testingHelper.StripURLSignatures(respBody))
+		})
+	}
+}
+
+var successReqUpdateCollection = `
+{
+  "name": "naps",
+  "is_public": false
+}
+`
+
+func TestUpdateCollection(t *testing.T) {
+	type args struct {
+		tokenUserName string
+		collectionID  int64
+		reqBody       string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		method     string
+		want       string
+		wantStatus int
+	}{
+		{
+			name:       "success",
+			args:       args{tokenUserName: dummy.User1.Name, collectionID: dummy.Collection1.ID, reqBody: successReqUpdateCollection},
+			method:     http.MethodPut,
+			want:       testingHelper.RespSimpleSuccess,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "error empty name",
+			args:       args{tokenUserName: dummy.User1.Name, collectionID: dummy.Collection1.ID, reqBody: errReqRegisterCollectionWithoutName},
+			method:     http.MethodPut,
+			want:       errRespRegisterCollectionWithoutName,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "error not owner",
+			args:       args{tokenUserName: dummy.User2.Name, collectionID: dummy.Collection1.ID, reqBody: successReqUpdateCollection},
+			method:     http.MethodPut,
+			want:       errRespNotCollectionOwner,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "error private collection of others",
+			args:       args{tokenUserName: dummy.User2.Name, collectionID: dummy.Collection2.ID, reqBody: successReqUpdateCollection},
+			method:     http.MethodPut,
+			want:       errRespCollectionNotExisting,
+			wantStatus: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// init
+			db := testingHelper.SetupDBTest()
+			defer testingHelper.TeardownDBTest(db)
+
+			// insert dummy data
+			insertDummyCollections(t, db)
+
+			// http request
+			req, err := http.NewRequest(tt.method, fmt.Sprintf("/collections/%d", tt.args.collectionID), strings.NewReader(tt.args.reqBody))
+			assert.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{"collection_id": strconv.Itoa(int(tt.args.collectionID))})
+			req = req.WithContext(httpContext.SetTokenUserName(req.Context(), tt.args.tokenUserName))
+			resp := httptest.NewRecorder()
+
+			// test target
+			CollectionController(resp, req)
+
+			// assert db
+			collections, err := testingHelper.FindAllCollections(context.Background(), db)
+			assert.NoError(t, err)
+			if tt.wantStatus == http.StatusOK {
+				assert.Equal(t, "naps", collections[0].Name)
+				assert.False(t, collections[0].IsPublic)
+			} else {
+				assert.Equal(t, dummy.Collection1.Name, collections[0].Name)
+				assert.True(t, collections[0].IsPublic)
+			}
+
+			// assert http
+			assert.Equal(t, tt.wantStatus, resp.Code)
+			respBodyByte, err := ioutil.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			respBody := string(respBodyByte)
+			assert.JSONEq(t, tt.want, respBody)
+		})
+	}
+}
+
+func TestDeleteCollection(t *testing.T) {
+	type args struct {
+		tokenUserName string
+		collectionID  int64
+	}
+	tests := []struct {
+		name       string
+		args       args
+		method     string
+		want       string
+		wantStatus int
+	}{
+		{
+			name:       "success",
+			args:       args{tokenUserName: dummy.User1.Name, collectionID: dummy.Collection1.ID},
+			method:     http.MethodDelete,
+			want:       testingHelper.RespSimpleSuccess,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "error not owner",
+			args:       args{tokenUserName: dummy.User2.Name, collectionID: dummy.Collection1.ID},
+			method:     http.MethodDelete,
+			want:       errRespNotCollectionOwner,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "error forbidden guest user",
+			args:       args{tokenUserName: helper.GuestUserName, collectionID: dummy.Collection1.ID},
+			method:     http.MethodDelete,
+			want:       testingHelper.ErrForbidden,
+			wantStatus: http.StatusForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// init
+			db := testingHelper.SetupDBTest()
+			defer testingHelper.TeardownDBTest(db)
+
+			// insert dummy data
+			insertDummyCollections(t, db)
+
+			// http request
+			req, err := http.NewRequest(tt.method, fmt.Sprintf("/collections/%d", tt.args.collectionID), nil)
+			assert.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{"collection_id": strconv.Itoa(int(tt.args.collectionID))})
+			req = req.WithContext(httpContext.SetTokenUserName(req.Context(), tt.args.tokenUserName))
+			resp := httptest.NewRecorder()
+
+			// test target
+			CollectionController(resp, req)
+
+			// assert db
+			collections, err := testingHelper.FindAllCollections(context.Background(), db)
+			assert.NoError(t, err)
+			collectionPostings, err := testingHelper.FindAllCollectionPostings(context.Background(), db)
+			assert.NoError(t, err)
+			postings, err := testingHelper.FindAllPostings(context.Background(), db)
+			assert.NoError(t, err)
+			if tt.wantStatus == http.StatusOK {
+				assert.Equal(t, 1, len(collections))
+				assert.Equal(t, 0, len(collectionPostings))
+			} else {
+				assert.Equal(t, 2, len(collections))
+				assert.Equal(t, 2, len(collectionPostings))
+			}
+			// the postings in it are kept
+			assert.Equal(t, 2, len(postings))
+
+			// assert http
+			assert.Equal(t, tt.wantStatus, resp.Code)
+			respBodyByte, err := ioutil.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			respBody := string(respBodyByte)
+			assert.JSONEq(t, tt.want, respBody)
+		})
+	}
+}
+
+var errRespAddCollectionPostingNotExistingPosting = `
+{
+  "status": 400,
+  "message": "the posting doesn't exist"
+}
+`
+var errRespAddCollectionPostingDuplicate = `
+{
+  "status": 409,
+  "message": "the posting is already in the collection"
+}
+`
+var errRespReorderCollectionPostingsInvalid = `
+{
+  "status": 400,
+  "message": "posting_ids must list every posting in the collection exactly once"
+}
+`
+
+func TestAddCollectionPosting(t *testing.T) {
+	type args struct {
+		tokenUserName string
+		collectionID  int64
+		reqBody       string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		method     string
+		want       string
+		wantStatus int
+	}{
+		{
+			name:       "success",
+			args:       args{tokenUserName: dummy.User1.Name, collectionID: dummy.Collection2.ID, reqBody: `{"posting_id": 2}`},
+			method:     http.MethodPost,
+			want:       testingHelper.RespSimpleSuccess,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "error not existing posting",
+			args:       args{tokenUserName: dummy.User1.Name, collectionID: dummy.Collection2.ID, reqBody: `{"posting_id": 100}`},
+			method:     http.MethodPost,
+			want:       errRespAddCollectionPostingNotExistingPosting,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "error duplicate",
+			args:       args{tokenUserName: dummy.User1.Name, collectionID: dummy.Collection1.ID, reqBody: `{"posting_id": 1}`},
+			method:     http.MethodPost,
+			want:       errRespAddCollectionPostingDuplicate,
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name:       "error not owner",
+			args:       args{tokenUserName: dummy.User2.Name, collectionID: dummy.Collection1.ID, reqBody: `{"posting_id": 2}`},
+			method:     http.MethodPost,
+			want:       errRespNotCollectionOwner,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "not allowed method",
+			args:       args{tokenUserName: dummy.User1.Name, collectionID: dummy.Collection1.ID},
+			method:     http.MethodGet,
+			want:       testingHelper.ErrNotAllowedMethod,
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// init
+			db := testingHelper.SetupDBTest()
+			defer testingHelper.TeardownDBTest(db)
+
+			// insert dummy data
+			insertDummyCollections(t, db)
+
+			// http request
+			req, err := http.NewRequest(tt.method, fmt.Sprintf("/collections/%d/postings", tt.args.collectionID), strings.NewReader(tt.args.reqBody))
+			assert.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{"collection_id": strconv.Itoa(int(tt.args.collectionID))})
+			req = req.WithContext(httpContext.SetTokenUserName(req.Context(), tt.args.tokenUserName))
+			resp := httptest.NewRecorder()
+
+			// test target
+			CollectionController(resp, req)
+
+			// assert db
+			collectionPostings, err := testingHelper.FindAllCollectionPostings(context.Background(), db)
+			assert.NoError(t, err)
+			if tt.wantStatus == http.StatusOK {
+				assert.Equal(t, 3, len(collectionPostings))
+				assert.Equal(t, dummy.Collection2.ID, collectionPostings[2].CollectionID)
+				assert.Equal(t, dummy.Posting2.ID, collectionPostings[2].PostingID)
+				assert.Equal(t, 0, collectionPostings[2].Position)
+			} else {
+				assert.Equal(t, 2, len(collectionPostings))
+			}
+
+			// assert http
+			assert.Equal(t, tt.wantStatus, resp.Code)
+			respBodyByte, err := ioutil.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			respBody := string(respBodyByte)
+			assert.JSONEq(t, tt.want, respBody)
+		})
+	}
+}
+
+func TestReorderCollectionPostings(t *testing.T) {
+	type args struct {
+		collectionID int64
+		reqBody      string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		method     string
+		want       string
+		wantStatus int
+	}{
+		{
+			name:       "success",
+			args:       args{collectionID: dummy.Collection1.ID, reqBody: `{"posting_ids": [2, 1]}`},
+			method:     http.MethodPut,
+			want:       testingHelper.RespSimpleSuccess,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "error missing posting",
+			args:       args{collectionID: dummy.Collection1.ID, reqBody: `{"posting_ids": [2]}`},
+			method:     http.MethodPut,
+			want:       errRespReorderCollectionPostingsInvalid,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "error duplicate posting",
+			args:       args{collectionID: dummy.Collection1.ID, reqBody: `{"posting_ids": [1, 1]}`},
+			method:     http.MethodPut,
+			want:       errRespReorderCollectionPostingsInvalid,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "error not existing collection",
+			args:       args{collectionID: 100, reqBody: `{"posting_ids": [2, 1]}`},
+			method:     http.MethodPut,
+			want:       errRespCollectionNotExisting,
+			wantStatus: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// init
+			db := testingHelper.SetupDBTest()
+			defer testingHelper.TeardownDBTest(db)
+
+			// insert dummy data
+			insertDummyCollections(t, db)
+
+			// http request
+			req, err := http.NewRequest(tt.method, fmt.Sprintf("/collections/%d/postings", tt.args.collectionID), strings.NewReader(tt.args.reqBody))
+			assert.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{"collection_id": strconv.Itoa(int(tt.args.collectionID))})
+			req = req.WithContext(httpContext.SetTokenUserName(req.Context(), dummy.User1.Name))
+			resp := httptest.NewRecorder()
+
+			// test target
+			CollectionController(resp, req)
+
+			// assert db
+			collectionPostings, err := testingHelper.FindAllCollectionPostings(context.Background(), db)
+			assert.NoError(t, err)
+			assert.Equal(t, 2, len(collectionPostings))
+			if tt.wantStatus == http.StatusOK {
+				assert.Equal(t, dummy.Posting2.ID, collectionPostings[0].PostingID)
+				assert.Equal(t, dummy.Posting1.ID, collectionPostings[1].PostingID)
+			} else {
+				assert.Equal(t, dummy.Posting1.ID, collectionPostings[0].PostingID)
+				assert.Equal(t, dummy.Posting2.ID, collectionPostings[1].PostingID)
+			}
+
+			// assert http
+			assert.Equal(t, tt.wantStatus, resp.Code)
+			respBodyByte, err := ioutil.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			respBody := string(respBodyByte)
+			assert.JSONEq(t, tt.want, respBody)
+		})
+	}
+}
+
+func TestDeleteCollectionPosting(t *testing.T) {
+	type args struct {
+		collectionID int64
+		postingID    int64
+	}
+	tests := []struct {
+		name       string
+		args       args
+		method     string
+		want       string
+		wantStatus int
+	}{
+		{
+			name:       "success",
+			args:       args{collectionID: dummy.Collection1.ID, postingID: dummy.Posting1.ID},
+			method:     http.MethodDelete,
+			want:       testingHelper.RespSimpleSuccess,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "error not in the collection",
+			args:       args{collectionID: dummy.Collection2.ID, postingID: dummy.Posting1.ID},
+			method:     http.MethodDelete,
+			want:       errRespCollectionNotExisting,
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "not allowed method",
+			args:       args{collectionID: dummy.Collection1.ID, postingID: dummy.Posting1.ID},
+			method:     http.MethodGet,
+			want:       testingHelper.ErrNotAllowedMethod,
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// init
+			db := testingHelper.SetupDBTest()
+			defer testingHelper.TeardownDBTest(db)
+
+			// insert dummy data
+			insertDummyCollections(t, db)
+
+			// http request
+			req, err := http.NewRequest(tt.method, fmt.Sprintf("/collections/%d/postings/%d", tt.args.collectionID, tt.args.postingID), nil)
+			assert.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{"collection_id": strconv.Itoa(int(tt.args.collectionID)), "posting_id": strconv.Itoa(int(tt.args.postingID))})
+			req = req.WithContext(httpContext.SetTokenUserName(req.Context(), dummy.User1.Name))
+			resp := httptest.NewRecorder()
+
+			// test target
+			CollectionController(resp, req)
+
+			// assert db
+			collectionPostings, err := testingHelper.FindAllCollectionPostings(context.Background(), db)
+			assert.NoError(t, err)
+			if tt.wantStatus == http.StatusOK {
+				assert.Equal(t, 1, len(collectionPostings))
+				assert.Equal(t, dummy.Posting2.ID, collectionPostings[0].PostingID)
+			} else {
+				assert.Equal(t, 2, len(collectionPostings))
+			}
+
+			// assert http
+			assert.Equal(t, tt.wantStatus, resp.Code)
+			respBodyByte, err := ioutil.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			respBody := string(respBodyByte)
+			assert.JSONEq(t, tt.want, respBody)
+		})
+	}
+}
@@ -24,7 +24,7 @@ func CommentController(w http.ResponseWriter, r *http.Request) {
 	case r.URL.Path == "/comments":
 		switch r.Method {
 		case http.MethodGet:
-			comments, userNames, err := getComments(r)
+			comments, userNames, next, err := getComments(r)
 			switch err := err.(type) {
 			case nil:
 				var httpComments []modelHTTP.ResponseGetComment
@@ -40,8 +40,9 @@ func CommentController(w http.ResponseWriter, r *http.Request) {
 						httpComments = append(httpComments, httpComment)
 					}
 					resp = modelHTTP.ResponseGetComments{
-						PostingId: comments[0].PostingID,
-						Comments:  httpComments,
+						PostingId:  comments[0].PostingID,
+						Comments:   httpComments,
+						NextCursor: next,
 					}
 				}
 				w.Header().Set(helper.HeaderKeyContentType, helper.HeaderValueApplicationJSON)
@@ -161,6 +162,7 @@ func registerComment(r *http.Request) error {
 	userRepo := repository.NewUserRepository(db)
 	postingRepo := repository.NewPostingRepository(db)
 	commentRepo := repository.NewCommentRepository(db)
+	postingCounterRepo := repository.NewPostingCounterRepository(db)
 	notificationRepo := repository.NewNotificationRepository(db)
 
 	// UseCase
@@ -169,7 +171,7 @@ func registerComment(r *http.Request) error {
 		log.Println(err)
 		return helper.NewInternalServerError(err.Error())
 	}
-	u := usecase.NewRegisterComment(tx, tokenUserID, tokenUserName, postingID, reqRegisterComment, userRepo, postingRepo, commentRepo, notificationRepo)
+	u := usecase.NewRegisterComment(tx, tokenUserID, tokenUserName, postingID, reqRegisterComment, userRepo, postingRepo, commentRepo, postingCounterRepo, notificationRepo)
 	if err = u.RegisterCommentUseCase(r.Context()); err != nil {
 		log.Println(err)
 		if err == usecase.ErrNotExistsData {
@@ -183,7 +185,7 @@ func registerComment(r *http.Request) error {
 	return err
 }
 
-func getComments(r *http.Request) (comments []model.Comment, userNames []string, err error) {
+func getComments(r *http.Request) (comments []model.Comment, userNames []string, next string, err error) {
 	// get request parameter
 	postingID := r.URL.Query().Get("posting_id")
 	if postingID == "" {
@@ -197,6 +199,11 @@ func getComments(r *http.Request) (comments []model.Comment, userNames []string,
 		err = helper.NewBadRequestError(err.Error())
 		return
 	}
+	cursor, limit, err := getPagingParams(r, false)
+	if err != nil {
+		log.Println(err)
+		return
+	}
 
 	// db connect
 	db, err := mysql.NewDB()
@@ -220,7 +227,7 @@ func getComments(r *http.Request) (comments []model.Comment, userNames []string,
 		err = helper.NewInternalServerError(err.Error())
 		return
 	}
-	u := usecase.NewGetComments(tx, tokenUserName, int64(id), userRepo, postingRepo, commentRepo)
+	u := usecase.NewGetComments(tx, tokenUserName, int64(id), cursor, int8(limit), userRepo, postingRepo, commentRepo)
 	if comments, userNames, err = u.GetCommentsUseCase(r.Context()); err != nil {
 		log.Println(err)
 		if err == usecase.ErrNotExistsData {
@@ -230,6 +237,10 @@ func getComments(r *http.Request) (comments []model.Comment, userNames []string,
 		err = helper.NewInternalServerError(err.Error())
 		return
 	}
+	if limit > 0 && len(comments) == limit {
+		last := comments[len(comments)-1]
+		next = helper.EncodeCursor(model.Cursor{CreatedAt: last.CreatedAt, ID: last.ID})
+	}
 	return
 }
 
@@ -271,9 +282,10 @@ func deleteComment(r *http.Request) error {
 	// repository
 	userRepo := repository.NewUserRepository(db)
 	commentRepo := repository.NewCommentRepository(db)
+	postingCounterRepo := repository.NewPostingCounterRepository(db)
 
 	// UseCase
-	u := usecase.NewDeleteComment(tx, tokenUserName, int64(commentID), userRepo, commentRepo)
+	u := usecase.NewDeleteComment(tx, tokenUserName, int64(commentID), userRepo, commentRepo, postingCounterRepo)
 	if err = u.DeleteCommentUseCase(r.Context()); err != nil {
 		log.Println(err)
 		if err == usecase.ErrNotExistsData {
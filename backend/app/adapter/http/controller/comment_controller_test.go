@@ -171,10 +171,18 @@ var errRespGetCommentsWithoutPostingID = `
   "message": "posting_id: cannot be blank."
 }
 `
+var errRespGetCommentsInvalidCursor = `
+{
+  "status": 400,
+  "message": "cursor: is invalid."
+}
+`
 
 func TestGetComments(t *testing.T) {
 	type args struct {
 		postingID string
+		cursor    string
+		limit     string
 	}
 	tests := []struct {
 		name       string
@@ -190,6 +198,13 @@ func TestGetComments(t *testing.T) {
 			want:       successRespGetComments,
 			wantStatus: http.StatusOK,
 		},
+		{
+			name:       "success with limit",
+			args:       args{postingID: "1", limit: "50"},
+			method:     http.MethodGet,
+			want:       successRespGetComments,
+			wantStatus: http.StatusOK,
+		},
 		{
 			name:       "success no comments",
 			args:       args{postingID: "2"},
@@ -204,6 +219,13 @@ func TestGetComments(t *testing.T) {
 			want:       errRespGetCommentsWithoutPostingID,
 			wantStatus: http.StatusBadRequest,
 		},
+		{
+			name:       "error invalid cursor",
+			args:       args{postingID: "1", cursor: "invalid", limit: "50"},
+			method:     http.MethodGet,
+			want:       errRespGetCommentsInvalidCursor,
+			wantStatus: http.StatusBadRequest,
+		},
 		{
 			name:       "not allowed method",
 			args:       args{},
@@ -238,7 +260,7 @@ func TestGetComments(t *testing.T) {
 			assert.NoError(t, err)
 
 			// http request
-			req, err := http.NewRequest(tt.method, fmt.Sprintf("/comments?posting_id=%v", tt.args.postingID), nil)
+			req, err := http.NewRequest(tt.method, fmt.Sprintf("/comments?posting_id=%v&cursor=%s&limit=%s", tt.args.postingID, tt.args.cursor, tt.args.limit), nil)
 			assert.NoError(t, err)
 			resp := httptest.NewRecorder()
 			req = req.WithContext(httpContext.SetTokenUserName(req.Context(), dummy.User1.Name))
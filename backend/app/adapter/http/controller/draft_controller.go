@@ -0,0 +1,426 @@
+package controller
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/gorilla/mux"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/http/context"
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/http/helper"
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/application/usecase"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+	modelHTTP "github.com/gold-kou/ToeBeans/backend/app/domain/model/http"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+)
+
+func DraftController(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.URL.Path == "/drafts":
+		switch r.Method {
+		case http.MethodPost:
+			draft, err := registerDraft(r)
+			switch err := err.(type) {
+			case nil:
+				resp := newResponseGetDraft(draft)
+				w.Header().Set(helper.HeaderKeyContentType, helper.HeaderValueApplicationJSON)
+				w.WriteHeader(http.StatusOK)
+				if err := json.NewEncoder(w).Encode(resp); err != nil {
+					log.Println(err.Error())
+				}
+			case *helper.BadRequestError:
+				helper.ResponseBadRequest(w, err.Error())
+			case *helper.AuthorizationError:
+				helper.ResponseUnauthorized(w, err.Error())
+			case *helper.ForbiddenError:
+				helper.ResponseForbidden(w, err.Error())
+			case *helper.ConflictError:
+				helper.ResponseConflictError(w, err.Error())
+			case *helper.InternalServerError:
+				helper.ResponseInternalServerError(w, err.Error())
+			default:
+				helper.ResponseInternalServerError(w, err.Error())
+			}
+		case http.MethodGet:
+			drafts, err := getDrafts(r)
+			switch err := err.(type) {
+			case nil:
+				resp := modelHTTP.ResponseGetDrafts{Drafts: []modelHTTP.ResponseGetDraft{}}
+				for _, d := range drafts {
+					resp.Drafts = append(resp.Drafts, newResponseGetDraft(d))
+				}
+				w.Header().Set(helper.HeaderKeyContentType, helper.HeaderValueApplicationJSON)
+				w.WriteHeader(http.StatusOK)
+				if err := json.NewEncoder(w).Encode(resp); err != nil {
+					log.Println(err.Error())
+				}
+			case *helper.AuthorizationError:
+				helper.ResponseUnauthorized(w, err.Error())
+			case *helper.ForbiddenError:
+				helper.ResponseForbidden(w, err.Error())
+			case *helper.InternalServerError:
+				helper.ResponseInternalServerError(w, err.Error())
+			default:
+				helper.ResponseInternalServerError(w, err.Error())
+			}
+		default:
+			methods := []string{http.MethodPost, http.MethodGet}
+			helper.ResponseNotAllowedMethod(w, errMsgNotAllowedMethod, methods)
+		}
+	case strings.HasPrefix(r.URL.Path, "/drafts/") && strings.HasSuffix(r.URL.Path, "/publish"):
+		switch r.Method {
+		case http.MethodPost:
+			err := publishDraft(r)
+			switch err := err.(type) {
+			case nil:
+				helper.ResponseSimpleSuccess(w)
+			case *helper.BadRequestError:
+				helper.ResponseBadRequest(w, err.Error())
+			case *helper.AuthorizationError:
+				helper.ResponseUnauthorized(w, err.Error())
+			case *helper.ForbiddenError:
+				helper.ResponseForbidden(w, err.Error())
+			case *helper.NotFoundError:
+				helper.ResponseNotFound(w, err.Error())
+			case *helper.InternalServerError:
+				helper.ResponseInternalServerError(w, err.Error())
+			default:
+				helper.ResponseInternalServerError(w, err.Error())
+			}
+		default:
+			methods := []string{http.MethodPost}
+			helper.ResponseNotAllowedMethod(w, errMsgNotAllowedMethod, methods)
+		}
+	case strings.HasPrefix(r.URL.Path, "/drafts/"):
+		switch r.Method {
+		case http.MethodPut:
+			err := updateDraft(r)
+			switch err := err.(type) {
+			case nil:
+				helper.ResponseSimpleSuccess(w)
+			case *helper.BadRequestError:
+				helper.ResponseBadRequest(w, err.Error())
+			case *helper.AuthorizationError:
+				helper.ResponseUnauthorized(w, err.Error())
+			case *helper.ForbiddenError:
+				helper.ResponseForbidden(w, err.Error())
+			case *helper.NotFoundError:
+				helper.ResponseNotFound(w, err.Error())
+			case *helper.InternalServerError:
+				helper.ResponseInternalServerError(w, err.Error())
+			default:
+				helper.ResponseInternalServerError(w, err.Error())
+			}
+		case http.MethodDelete:
+			err := deleteDraft(r)
+			switch err := err.(type) {
+			case nil:
+				helper.ResponseSimpleSuccess(w)
+			case *helper.BadRequestError:
+				helper.ResponseBadRequest(w, err.Error())
+			case *helper.AuthorizationError:
+				helper.ResponseUnauthorized(w, err.Error())
+			case *helper.ForbiddenError:
+				helper.ResponseForbidden(w, err.Error())
+			case *helper.NotFoundError:
+				helper.ResponseNotFound(w, err.Error())
+			case *helper.InternalServerError:
+				helper.ResponseInternalServerError(w, err.Error())
+			default:
+				helper.ResponseInternalServerError(w, err.Error())
+			}
+		default:
+			methods := []string{http.MethodPut, http.MethodDelete}
+			helper.ResponseNotAllowedMethod(w, errMsgNotAllowedMethod, methods)
+		}
+	default:
+		helper.ResponseInternalServerError(w, errMsgControllerPath)
+	}
+}
+
+func newResponseGetDraft(d model.Draft) modelHTTP.ResponseGetDraft {
+	return modelHTTP.ResponseGetDraft{
+		DraftId:   d.ID,
+		Title:     d.Title,
+		AltText:   d.AltText,
+		ImageUrl:  usecase.SignDraftImageKey(d.ObjectKey),
+		CreatedAt: d.CreatedAt,
+		UpdatedAt: d.UpdatedAt,
+	}
+}
+
+// getDraftID returns the draft_id path parameter.
+func getDraftID(r *http.Request) (int64, error) {
+	vars := mux.Vars(r)
+	paramDraftID, _ := vars["draft_id"]
+	draftID, err := strconv.ParseInt(paramDraftID, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	if err = validation.Validate(draftID, validation.Required); err != nil {
+		return 0, err
+	}
+	return draftID, nil
+}
+
+// draftUseCaseError converts the errors common to the usecases of a draft.
+func draftUseCaseError(err error) error {
+	switch err {
+	case usecase.ErrNotExistsData:
+		return helper.NewNotFoundError(err.Error())
+	case usecase.ErrTokenInvalidNotExistingUserName:
+		return helper.NewAuthorizationError(err.Error())
+	}
+	return helper.NewInternalServerError(err.Error())
+}
+
+// getDraftTokenUserName returns the token user name. Guest users can't have drafts.
+func getDraftTokenUserName(r *http.Request) (string, error) {
+	tokenUserName, err := context.GetTokenUserName(r.Context())
+	if err != nil {
+		log.Println(err)
+		return "", helper.NewInternalServerError(err.Error())
+	}
+	if tokenUserName == helper.GuestUserName {
+		log.Println(errMsgGuestUserForbidden)
+		return "", helper.NewForbiddenError(errMsgGuestUserForbidden)
+	}
+	return tokenUserName, nil
+}
+
+func registerDraft(r *http.Request) (draft model.Draft, err error) {
+	// not allowed to guest user
+	tokenUserName, err := getDraftTokenUserName(r)
+	if err != nil {
+		return
+	}
+
+	// get request parameter
+	var reqRegisterDraft *modelHTTP.RequestRegisterDraft
+	b, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		log.Println(err)
+		err = helper.NewBadRequestError(err.Error())
+		return
+	}
+	defer r.Body.Close()
+	if err = json.Unmarshal(b, &reqRegisterDraft); err != nil {
+		log.Println(err)
+		err = helper.NewBadRequestError(err.Error())
+		return
+	}
+
+	// validation check
+	if err = reqRegisterDraft.ValidateParam(); err != nil {
+		log.Println(err)
+		err = helper.NewBadRequestError(err.Error())
+		return
+	}
+
+	// db connect
+	db, err := mysql.NewDB()
+	if err != nil {
+		log.Println(err)
+		err = helper.NewInternalServerError(err.Error())
+		return
+	}
+	defer db.Close()
+	tx := mysql.NewDBTransaction(db)
+
+	// repository
+	userRepo := repository.NewUserRepository(db)
+	draftRepo := repository.NewDraftRepository(db)
+
+	// UseCase
+	u := usecase.NewRegisterDraft(tx, tokenUserName, reqRegisterDraft, userRepo, draftRepo)
+	if draft, err = u.RegisterDraftUseCase(r.Context()); err != nil {
+		log.Println(err)
+		switch err {
+		case usecase.ErrUploadNotFound, usecase.ErrTooManyDrafts, helper.ErrImageTooLarge:
+			err = helper.NewBadRequestError(err.Error())
+		case usecase.ErrDraftAlreadyExists:
+			err = helper.NewConflictError(err.Error())
+		default:
+			err = draftUseCaseError(err)
+		}
+		return
+	}
+	return
+}
+
+func getDrafts(r *http.Request) (drafts []model.Draft, err error) {
+	// not allowed to guest user
+	tokenUserName, err := getDraftTokenUserName(r)
+	if err != nil {
+		return
+	}
+
+	// db connect
+	db, err := mysql.NewDB()
+	if err != nil {
+		log.Println(err)
+		err = helper.NewInternalServerError(err.Error())
+		return
+	}
+	defer db.Close()
+
+	// repository
+	userRepo := repository.NewUserRepository(db)
+	draftRepo := repository.NewDraftRepository(db)
+
+	// UseCase
+	u := usecase.NewGetDrafts(tokenUserName, userRepo, draftRepo)
+	if drafts, err = u.GetDraftsUseCase(r.Context()); err != nil {
+		log.Println(err)
+		err = draftUseCaseError(err)
+		return
+	}
+	return
+}
+
+func updateDraft(r *http.Request) error {
+	// not allowed to guest user
+	tokenUserName, err := getDraftTokenUserName(r)
+	if err != nil {
+		return err
+	}
+
+	// get request parameter
+	draftID, err := getDraftID(r)
+	if err != nil {
+		log.Println(err)
+		return helper.NewBadRequestError(err.Error())
+	}
+	var reqUpdateDraft *modelHTTP.RequestUpdateDraft
+	b, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		log.Println(err)
+		return helper.NewBadRequestError(err.Error())
+	}
+	defer r.Body.Close()
+	if err = json.Unmarshal(b, &reqUpdateDraft); err != nil {
+		log.Println(err)
+		return helper.NewBadRequestError(err.Error())
+	}
+
+	// validation check
+	if err = reqUpdateDraft.ValidateParam(); err != nil {
+		log.Println(err)
+		return helper.NewBadRequestError(err.Error())
+	}
+
+	// db connect
+	db, err := mysql.NewDB()
+	if err != nil {
+		log.Println(err)
+		return helper.NewInternalServerError(err.Error())
+	}
+	defer db.Close()
+	tx := mysql.NewDBTransaction(db)
+
+	// repository
+	userRepo := repository.NewUserRepository(db)
+	draftRepo := repository.NewDraftRepository(db)
+
+	// UseCase
+	u := usecase.NewUpdateDraft(tx, draftID, tokenUserName, reqUpdateDraft, userRepo, draftRepo)
+	if err = u.UpdateDraftUseCase(r.Context()); err != nil {
+		log.Println(err)
+		return draftUseCaseError(err)
+	}
+	return nil
+}
+
+func deleteDraft(r *http.Request) error {
+	// not allowed to guest user
+	tokenUserName, err := getDraftTokenUserName(r)
+	if err != nil {
+		return err
+	}
+
+	// get request parameter and validation check
+	draftID, err := getDraftID(r)
+	if err != nil {
+		log.Println(err)
+		return helper.NewBadRequestError(err.Error())
+	}
+
+	// db connect
+	db, err := mysql.NewDB()
+	if err != nil {
+		log.Println(err)
+		return helper.NewInternalServerError(err.Error())
+	}
+	defer db.Close()
+	tx := mysql.NewDBTransaction(db)
+
+	// repository
+	userRepo := repository.NewUserRepository(db)
+	draftRepo := repository.NewDraftRepository(db)
+	objectDeletionRepo := repository.NewObjectDeletionRepository(db)
+
+	// UseCase
+	u := usecase.NewDeleteDraft(tx, draftID, tokenUserName, userRepo, draftRepo, objectDeletionRepo)
+	if err = u.DeleteDraftUseCase(r.Context()); err != nil {
+		log.Println(err)
+		return draftUseCaseError(err)
+	}
+	return nil
+}
+
+func publishDraft(r *http.Request) error {
+	// not allowed to guest user
+	tokenUserName, err := getDraftTokenUserName(r)
+	if err != nil {
+		return err
+	}
+
+	// get request parameter and validation check
+	draftID, err := getDraftID(r)
+	if err != nil {
+		log.Println(err)
+		return helper.NewBadRequestError(err.Error())
+	}
+
+	// db connect
+	db, err := mysql.NewDB()
+	if err != nil {
+		log.Println(err)
+		return helper.NewInternalServerError(err.Error())
+	}
+	defer db.Close()
+	tx := mysql.NewDBTransaction(db)
+
+	// repository
+	userRepo := repository.NewUserRepository(db)
+	postingRepo := repository.NewPostingRepository(db)
+	postingImageRepo := repository.NewPostingImageRepository(db)
+	tagRepo := repository.NewTagRepository(db)
+	postingTagRepo := repository.NewPostingTagRepository(db)
+	catRepo := repository.NewCatRepository(db)
+	postingCatRepo := repository.NewPostingCatRepository(db)
+	draftRepo := repository.NewDraftRepository(db)
+	userCounterRepo := repository.NewUserCounterRepository(db)
+	objectDeletionRepo := repository.NewObjectDeletionRepository(db)
+
+	// UseCase
+	u := usecase.NewPublishDraft(tx, draftID, tokenUserName, newImageClassifier(), userRepo, postingRepo, postingImageRepo, tagRepo, postingTagRepo, catRepo, postingCatRepo, draftRepo, userCounterRepo, objectDeletionRepo)
+	if err = u.PublishDraftUseCase(r.Context()); err != nil {
+		log.Println(err)
+		// the title saved without the rules of a posting
+		if _, ok := err.(validation.Errors); ok {
+			return helper.NewBadRequestError(err.Error())
+		}
+		if err == usecase.ErrDecodeImage || err == usecase.ErrNotCatImage || err == usecase.ErrDuplicateImage || err == usecase.ErrUploadNotFound || err == helper.ErrImageTooLarge || err == helper.ErrUnsupportedImageType {
+			return helper.NewBadRequestError(err.Error())
+		}
+		return draftUseCaseError(err)
+	}
+	return nil
+}
@@ -0,0 +1,708 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/aws"
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/classifier"
+	httpContext "github.com/gold-kou/ToeBeans/backend/app/adapter/http/context"
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/http/helper"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+	modelHTTP "github.com/gold-kou/ToeBeans/backend/app/domain/model/http"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+	testingHelper "github.com/gold-kou/ToeBeans/backend/testing"
+	"github.com/gold-kou/ToeBeans/backend/testing/dummy"
+)
+
+var successReqRegisterDraft = `
+{
+  "upload_key": "uploads/1/0f8fad5b-d9cb-469f-a165-70867728950e",
+  "title": "This is a sample draft. #cat_nap"
+}
+`
+var successReqRegisterDraftWithoutTitle = `
+{
+  "upload_key": "uploads/1/0f8fad5b-d9cb-469f-a165-70867728950e"
+}
+`
+var errReqRegisterDraftWithoutUploadKey = `
+{
+  "title": "This is a sample draft."
+}
+`
+var errReqRegisterDraftUploadOfOtherUser = `
+{
+  "upload_key": "uploads/2/16fd2706-8baf-433b-82eb-8c7fada847da",
+  "title": "This is a sample draft."
+}
+`
+var errReqRegisterDraftUnderBarTitle = `
+{
+  "upload_key": "uploads/1/0f8fad5b-d9cb-469f-a165-70867728950e",
+  "title": "This_is_a_sample_draft."
+}
+`
+var errRespRegisterDraftWithoutUploadKey = `
+{
+  "status": 400,
+  "message": "upload_key: cannot be blank."
+}
+`
+var errRespRegisterDraftUnderBarTitle = `
+{
+  "status": 400,
+  "message": "title: must not contain _ outside hashtags."
+}
+`
+var errRespRegisterDraftAlreadyExists = `
+{
+  "status": 409,
+  "message": "the upload is already in a draft"
+}
+`
+var errRespDraftNotExisting = `
+{
+  "status": 404,
+  "message": "not exists data error"
+}
+`
+
+// putDraftUpload puts the test image to the key as a client does with the URL of POST /uploads.
+func putDraftUpload(t *testing.T, key string) {
+	img, err := base64.StdEncoding.DecodeString(testPNG)
+	assert.NoError(t, err)
+	_, err = aws.UploadObject(os.Getenv("S3_BUCKET_POSTINGS"), key, bytes.NewReader(img), "image/png", "")
+	assert.NoError(t, err)
+}
+
+// insertDummyDrafts makes draft1 and draft2 of user1 and draft3 of user2.
+func insertDummyDrafts(t *testing.T, db *sql.DB) {
+	userRepo := repository.NewUserRepository(db)
+	draftRepo := repository.NewDraftRepository(db)
+	err := userRepo.Create(context.Background(), &dummy.User1)
+	assert.NoError(t, err)
+	err = userRepo.Create(context.Background(), &dummy.User2)
+	assert.NoError(t, err)
+	err = draftRepo.Create(context.Background(), &dummy.Draft1)
+	assert.NoError(t, err)
+	err = draftRepo.Create(context.Background(), &dummy.Draft2)
+	assert.NoError(t, err)
+	err = draftRepo.Create(context.Background(), &dummy.Draft3)
+	assert.NoError(t, err)
+	err = testingHelper.UpdateNow(db, "drafts")
+	assert.NoError(t, err)
+}
+
+func TestRegisterDraft(t *testing.T) {
+	type args struct {
+		reqBody string
+		// put to the bucket in advance
+		uploadKey string
+		// registered by user1 in advance
+		draft *model.Draft
+	}
+	tests := []struct {
+		name       string
+		args       args
+		method     string
+		want       string
+		wantStatus int
+		wantTitle  string
+	}{
+		{
+			name:       "success",
+			args:       args{reqBody: successReqRegisterDraft, uploadKey: dummy.Draft1.ObjectKey},
+			method:     http.MethodPost,
+			wantStatus: http.StatusOK,
+			wantTitle:  dummy.Draft1.Title,
+		},
+		{
+			name:       "success without title",
+			args:       args{reqBody: successReqRegisterDraftWithoutTitle, uploadKey: dummy.Draft1.ObjectKey},
+			method:     http.MethodPost,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "error without upload_key",
+			args:       args{reqBody: errReqRegisterDraftWithoutUploadKey},
+			method:     http.MethodPost,
+			want:       errRespRegisterDraftWithoutUploadKey,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "error title contains _",
+			args:       args{reqBody: errReqRegisterDraftUnderBarTitle, uploadKey: dummy.Draft1.ObjectKey},
+			method:     http.MethodPost,
+			want:       errRespRegisterDraftUnderBarTitle,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "error not uploaded",
+			args:       args{reqBody: successReqRegisterDraft},
+			method:     http.MethodPost,
+			want:       errRespRegisterPostingUploadNotFound,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "error upload of other user",
+			args:       args{reqBody: errReqRegisterDraftUploadOfOtherUser, uploadKey: dummy.Draft3.ObjectKey},
+			method:     http.MethodPost,
+			want:       errRespRegisterPostingUploadNotFound,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "error upload already in draft",
+			args:       args{reqBody: successReqRegisterDraft, uploadKey: dummy.Draft1.ObjectKey, draft: &dummy.Draft1},
+			method:     http.MethodPost,
+			want:       errRespRegisterDraftAlreadyExists,
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name:       "error forbidden guest user",
+			args:       args{reqBody: successReqRegisterDraft},
+			method:     http.MethodPost,
+			want:       testingHelper.ErrForbidden,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "not allowed method",
+			args:       args{},
+			method:     http.MethodPut,
+			want:       testingHelper.ErrNotAllowedMethod,
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// init
+			db := testingHelper.SetupDBTest()
+			defer testingHelper.TeardownDBTest(db)
+
+			// insert dummy data
+			userRepo := repository.NewUserRepository(db)
+			err := userRepo.Create(context.Background(), &dummy.User1)
+			assert.NoError(t, err)
+			err = userRepo.Create(context.Background(), &dummy.User2)
+			assert.NoError(t, err)
+			if tt.args.uploadKey != "" {
+				putDraftUpload(t, tt.args.uploadKey)
+				defer func() { _ = aws.DeleteObject(os.Getenv("S3_BUCKET_POSTINGS"), tt.args.uploadKey) }()
+			}
+			if tt.args.draft != nil {
+				draftRepo := repository.NewDraftRepository(db)
+				err = draftRepo.Create(context.Background(), tt.args.draft)
+				assert.NoError(t, err)
+			}
+
+			// http request
+			req, err := http.NewRequest(tt.method, "/drafts", strings.NewReader(tt.args.reqBody))
+			assert.NoError(t, err)
+			if tt.name == "error forbidden guest user" {
+				req = req.WithContext(httpContext.SetTokenUserName(req.Context(), helper.GuestUserName))
+			} else {
+				req = req.WithContext(httpContext.SetTokenUserName(req.Context(), dummy.User1.Name))
+			}
+			resp := httptest.NewRecorder()
+
+			// test target
+			DraftController(resp, req)
+
+			// assert http
+			assert.Equal(t, tt.wantStatus, resp.Code)
+			respBodyByte, err := ioutil.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			if tt.wantStatus != http.StatusOK {
+				assert.JSONEq(t, tt.want, string(respBodyByte))
+				return
+			}
+
+			// assert db and the registered draft
+			drafts, err := testingHelper.FindAllDrafts(context.Background(), db)
+			assert.NoError(t, err)
+			assert.Equal(t, 1, len(drafts))
+			assert.Equal(t, dummy.User1.ID, drafts[0].UserID)
+			assert.Equal(t, tt.wantTitle, drafts[0].Title)
+			assert.Equal(t, dummy.Draft1.ObjectKey, drafts[0].ObjectKey)
+
+			var draft modelHTTP.ResponseGetDraft
+			err = json.Unmarshal(respBodyByte, &draft)
+			assert.NoError(t, err)
+			assert.Equal(t, drafts[0].ID, draft.DraftId)
+			assert.Equal(t, tt.wantTitle, draft.Title)
+			assert.Equal(t, "http://localhost:9000/toebeans-postings/"+dummy.Draft1.ObjectKey, testingHelper.StripURLSignatures(draft.ImageUrl))
+		})
+	}
+}
+
+var successRespGetDrafts = `
+{
+  "drafts": [
+    {
+      "draft_id": 2,
+      "title": "",
+      "alt_text": "test draft alt text",
+      "image_url": "http://localhost:9000/toebeans-postings/uploads/1/7c9e6679-7425-40de-944b-e07fc1f90ae7",
+      "created_at": "2020-01-01T00:00:00+09:00",
+      "updated_at": "2020-01-01T00:00:00+09:00"
+    },
+    {
+      "draft_id": 1,
+      "title": "This is a sample draft. #cat_nap",
+      "alt_text": "",
+      "image_url": "http://localhost:9000/toebeans-postings/uploads/1/0f8fad5b-d9cb-469f-a165-70867728950e",
+      "created_at": "2020-01-01T00:00:00+09:00",
+      "updated_at": "2020-01-01T00:00:00+09:00"
+    }
+  ]
+}
+`
+var successRespGetDraftsEmpty = `
+{
+  "drafts": []
+}
+`
+
+func TestGetDrafts(t *testing.T) {
+	type args struct {
+		tokenUserName string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		method     string
+		want       string
+		wantStatus int
+	}{
+		{
+			name:       "success",
+			args:       args{tokenUserName: dummy.User1.Name},
+			method:     http.MethodGet,
+			want:       successRespGetDrafts,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "success empty",
+			args:       args{tokenUserName: dummy.User3.Name},
+			method:     http.MethodGet,
+			want:       successRespGetDraftsEmpty,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "error forbidden guest user",
+			args:       args{tokenUserName: helper.GuestUserName},
+			method:     http.MethodGet,
+			want:       testingHelper.ErrForbidden,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "not allowed method",
+			args:       args{tokenUserName: dummy.User1.Name},
+			method:     http.MethodDelete,
+			want:       testingHelper.ErrNotAllowedMethod,
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// init
+			db := testingHelper.SetupDBTest()
+			defer testingHelper.TeardownDBTest(db)
+			testingHelper.SetTestTime()
+			defer testingHelper.ResetTime()
+
+			// insert dummy data
+			insertDummyDrafts(t, db)
+			userRepo := repository.NewUserRepository(db)
+			err := userRepo.Create(context.Background(), &dummy.User3)
+			assert.NoError(t, err)
+
+			// http request
+			req, err := http.NewRequest(tt.method, "/drafts", nil)
+			assert.NoError(t, err)
+			req = req.WithContext(httpContext.SetTokenUserName(req.Context(), tt.args.tokenUserName))
+			resp := httptest.NewRecorder()
+
+			// test target
+			DraftController(resp, req)
+
+			// assert http
+			assert.Equal(t, tt.wantStatus, resp.Code)
+			respBodyByte, err := ioutil.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			respBody := string(respBodyByte)
+			assert.JSONEq(t, tt.want, testingHelper.StripURLSignatures(respBody))
+		})
+	}
+}
+
+var successReqUpdateDraft = `
+{
+  "title": "This is an edited draft.",
+  "alt_text": "A tabby cat sleeping on the sofa"
+}
+`
+var errReqUpdateDraftUnderBarTitle = `
+{
+  "title": "This_is_an_edited_draft."
+}
+`
+
+func TestUpdateDraft(t *testing.T) {
+	type args struct {
+		draftID int64
+		reqBody string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		method     string
+		want       string
+		wantStatus int
+	}{
+		{
+			name:       "success",
+			args:       args{draftID: dummy.Draft1.ID, reqBody: successReqUpdateDraft},
+			method:     http.MethodPut,
+			want:       testingHelper.RespSimpleSuccess,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "error title contains _",
+			args:       args{draftID: dummy.Draft1.ID, reqBody: errReqUpdateDraftUnderBarTitle},
+			method:     http.MethodPut,
+			want:       errRespRegisterDraftUnderBarTitle,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "error draft of other user",
+			args:       args{draftID: dummy.Draft3.ID, reqBody: successReqUpdateDraft},
+			method:     http.MethodPut,
+			want:       errRespDraftNotExisting,
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "error not existing draft",
+			args:       args{draftID: 99999, reqBody: successReqUpdateDraft},
+			method:     http.MethodPut,
+			want:       errRespDraftNotExisting,
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "error forbidden guest user",
+			args:       args{draftID: dummy.Draft1.ID, reqBody: successReqUpdateDraft},
+			method:     http.MethodPut,
+			want:       testingHelper.ErrForbidden,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "not allowed method",
+			args:       args{draftID: dummy.Draft1.ID},
+			method:     http.MethodGet,
+			want:       testingHelper.ErrNotAllowedMethod,
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// init
+			db := testingHelper.SetupDBTest()
+			defer testingHelper.TeardownDBTest(db)
+
+			// insert dummy data
+			insertDummyDrafts(t, db)
+
+			// http request
+			req, err := http.NewRequest(tt.method, fmt.Sprintf("/drafts/%d", tt.args.draftID), strings.NewReader(tt.args.reqBody))
+			assert.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{"draft_id": strconv.FormatInt(tt.args.draftID, 10)})
+			if tt.name == "error forbidden guest user" {
+				req = req.WithContext(httpContext.SetTokenUserName(req.Context(), helper.GuestUserName))
+			} else {
+				req = req.WithContext(httpContext.SetTokenUserName(req.Context(), dummy.User1.Name))
+			}
+			resp := httptest.NewRecorder()
+
+			// test target
+			DraftController(resp, req)
+
+			// assert db
+			drafts, err := testingHelper.FindAllDrafts(context.Background(), db)
+			assert.NoError(t, err)
+			if tt.wantStatus == http.StatusOK {
+				assert.Equal(t, "This is an edited draft.", drafts[0].Title)
+				assert.Equal(t, "A tabby cat sleeping on the sofa", drafts[0].AltText)
+				assert.Equal(t, dummy.Draft1.ObjectKey, drafts[0].ObjectKey)
+			} else {
+				assert.Equal(t, dummy.Draft1.Title, drafts[0].Title)
+				assert.Equal(t, dummy.Draft3.Title, drafts[2].Title)
+			}
+
+			// assert http
+			assert.Equal(t, tt.wantStatus, resp.Code)
+			respBodyByte, err := ioutil.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			respBody := string(respBodyByte)
+			assert.JSONEq(t, tt.want, respBody)
+		})
+	}
+}
+
+func TestDeleteDraft(t *testing.T) {
+	type args struct {
+		draftID int64
+	}
+	tests := []struct {
+		name       string
+		args       args
+		method     string
+		want       string
+		wantStatus int
+	}{
+		{
+			name:       "success",
+			args:       args{draftID: dummy.Draft1.ID},
+			method:     http.MethodDelete,
+			want:       testingHelper.RespSimpleSuccess,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "error draft of other user",
+			args:       args{draftID: dummy.Draft3.ID},
+			method:     http.MethodDelete,
+			want:       errRespDraftNotExisting,
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "error not existing draft",
+			args:       args{draftID: 99999},
+			method:     http.MethodDelete,
+			want:       errRespDraftNotExisting,
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "error forbidden guest user",
+			args:       args{draftID: dummy.Draft1.ID},
+			method:     http.MethodDelete,
+			want:       testingHelper.ErrForbidden,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "not allowed method",
+			args:       args{draftID: dummy.Draft1.ID},
+			method:     http.MethodPost,
+			want:       testingHelper.ErrNotAllowedMethod,
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// init
+			db := testingHelper.SetupDBTest()
+			defer testingHelper.TeardownDBTest(db)
+
+			// insert dummy data
+			insertDummyDrafts(t, db)
+			putDraftUpload(t, dummy.Draft1.ObjectKey)
+
+			// http request
+			req, err := http.NewRequest(tt.method, fmt.Sprintf("/drafts/%d", tt.args.draftID), nil)
+			assert.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{"draft_id": strconv.FormatInt(tt.args.draftID, 10)})
+			if tt.name == "error forbidden guest user" {
+				req = req.WithContext(httpContext.SetTokenUserName(req.Context(), helper.GuestUserName))
+			} else {
+				req = req.WithContext(httpContext.SetTokenUserName(req.Context(), dummy.User1.Name))
+			}
+			resp := httptest.NewRecorder()
+
+			// test target
+			DraftController(resp, req)
+
+			// assert db and the bucket
+			drafts, err := testingHelper.FindAllDrafts(context.Background(), db)
+			assert.NoError(t, err)
+			_, headErr := aws.HeadObject(os.Getenv("S3_BUCKET_POSTINGS"), dummy.Draft1.ObjectKey)
+			if tt.wantStatus == http.StatusOK {
+				assert.Equal(t, 2, len(drafts))
+				assert.Equal(t, dummy.Draft2.ID, drafts[0].ID)
+				assert.Equal(t, aws.ErrObjectNotFound, headErr)
+				deletions, err := testingHelper.FindAllObjectDeletions(context.Background(), db)
+				assert.NoError(t, err)
+				assert.Equal(t, 0, len(deletions))
+			} else {
+				assert.Equal(t, 3, len(drafts))
+				assert.NoError(t, headErr)
+				_ = aws.DeleteObject(os.Getenv("S3_BUCKET_POSTINGS"), dummy.Draft1.ObjectKey)
+			}
+
+			// assert http
+			assert.Equal(t, tt.wantStatus, resp.Code)
+			respBodyByte, err := ioutil.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			respBody := string(respBodyByte)
+			assert.JSONEq(t, tt.want, respBody)
+		})
+	}
+}
+
+var errRespPublishDraftWithoutTitle = `
+{
+  "status": 400,
+  "message": "title: cannot be blank."
+}
+`
+
+func TestPublishDraft(t *testing.T) {
+	type args struct {
+		draftID int64
+		labels  []model.Label
+		// the image of the draft is put to the bucket unless this is set
+		notUploaded bool
+	}
+	tests := []struct {
+		name       string
+		args       args
+		method     string
+		want       string
+		wantStatus int
+	}{
+		{
+			name:       "success",
+			args:       args{draftID: dummy.Draft1.ID},
+			method:     http.MethodPost,
+			want:       testingHelper.RespSimpleSuccess,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "error without title",
+			args:       args{draftID: dummy.Draft2.ID},
+			method:     http.MethodPost,
+			want:       errRespPublishDraftWithoutTitle,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "error not cat image",
+			args:       args{draftID: dummy.Draft1.ID, labels: []model.Label{{Description: "Dog", Score: 0.98}}},
+			method:     http.MethodPost,
+			want:       errRespRegisterPostingNotCat,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "error upload is gone",
+			args:       args{draftID: dummy.Draft1.ID, notUploaded: true},
+			method:     http.MethodPost,
+			want:       errRespRegisterPostingUploadNotFound,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "error draft of other user",
+			args:       args{draftID: dummy.Draft3.ID},
+			method:     http.MethodPost,
+			want:       errRespDraftNotExisting,
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "error forbidden guest user",
+			args:       args{draftID: dummy.Draft1.ID},
+			method:     http.MethodPost,
+			want:       testingHelper.ErrForbidden,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "not allowed method",
+			args:       args{draftID: dummy.Draft1.ID},
+			method:     http.MethodGet,
+			want:       testingHelper.ErrNotAllowedMethod,
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// init
+			db := testingHelper.SetupDBTest()
+			defer testingHelper.TeardownDBTest(db)
+			testingHelper.SetTestTime()
+			defer testingHelper.ResetTime()
+
+			// insert dummy data
+			insertDummyDrafts(t, db)
+			if !tt.args.notUploaded {
+				putDraftUpload(t, dummy.Draft1.ObjectKey)
+				putDraftUpload(t, dummy.Draft2.ObjectKey)
+				defer func() {
+					_ = aws.DeleteObject(os.Getenv("S3_BUCKET_POSTINGS"), dummy.Draft1.ObjectKey)
+					_ = aws.DeleteObject(os.Getenv("S3_BUCKET_POSTINGS"), dummy.Draft2.ObjectKey)
+				}()
+			}
+			if tt.args.labels != nil {
+				defaultNewImageClassifier := newImageClassifier
+				newImageClassifier = func() classifier.ImageClassifier { return classifier.NewFakeClassifier(tt.args.labels) }
+				defer func() { newImageClassifier = defaultNewImageClassifier }()
+			}
+
+			// http request
+			req, err := http.NewRequest(tt.method, fmt.Sprintf("/drafts/%d/publish", tt.args.draftID), nil)
+			assert.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{"draft_id": strconv.FormatInt(tt.args.draftID, 10)})
+			if tt.name == "error forbidden guest user" {
+				req = req.WithContext(httpContext.SetTokenUserName(req.Context(), helper.GuestUserName))
+			} else {
+				req = req.WithContext(httpContext.SetTokenUserName(req.Context(), dummy.User1.Name))
+			}
+			resp := httptest.NewRecorder()
+
+			// test target
+			DraftController(resp, req)
+
+			// assert db
+			postings, err := testingHelper.FindAllPostings(context.Background(), db)
+			assert.NoError(t, err)
+			drafts, err := testingHelper.FindAllDrafts(context.Background(), db)
+			assert.NoError(t, err)
+			if tt.wantStatus == http.StatusOK {
+				assert.Equal(t, 1, len(postings))
+				assert.Equal(t, dummy.User1.ID, postings[0].UserID)
+				assert.Equal(t, dummy.Draft1.Title, postings[0].Title)
+				// built from the labels as the draft has no alt text
+				assert.Equal(t, "Cat, Whiskers, Tabby cat", postings[0].AltText)
+				images, err := testingHelper.FindAllPostingImages(context.Background(), db)
+				assert.NoError(t, err)
+				assert.Equal(t, 1, len(images))
+				tags, err := testingHelper.FindAllTags(context.Background(), db)
+				assert.NoError(t, err)
+				assert.Equal(t, 1, len(tags))
+				assert.Equal(t, "cat_nap", tags[0].Name)
+				assert.Equal(t, 2, len(drafts))
+				assert.Equal(t, dummy.Draft2.ID, drafts[0].ID)
+				// the upload has been copied as the variants of the posting
+				_, err = aws.HeadObject(os.Getenv("S3_BUCKET_POSTINGS"), dummy.Draft1.ObjectKey)
+				assert.Equal(t, aws.ErrObjectNotFound, err)
+			} else {
+				assert.Equal(t, 0, len(postings))
+				assert.Equal(t, 3, len(drafts))
+			}
+
+			// assert http
+			assert.Equal(t, tt.wantStatus, resp.Code)
+			respBodyByte, err := ioutil.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			respBody := string(respBodyByte)
+			assert.JSONEq(t, tt.want, respBody)
+		})
+	}
+}
@@ -1,7 +1,16 @@
 package controller
 
+import (
+	"fmt"
+
+	modelHTTP "github.com/gold-kou/ToeBeans/backend/app/domain/model/http"
+)
+
 var errMsgControllerPath = "controller path handling error"
 var errMsgNotAllowedMethod = "not allowed method error"
 var errMsgWrongUserNameOrPassword = "Wrong username or password"
 var errMsgGuestUserForbidden = "not allowed to guest user"
 var errMsgNotExists = "not exists"
+var errMsgImageBlank = "image: cannot be blank."
+var errMsgTooManyImages = fmt.Sprintf("image: the number of images must be no more than %d.", modelHTTP.MaxPostingImages)
+var errMsgInvalidCursor = "cursor: is invalid."
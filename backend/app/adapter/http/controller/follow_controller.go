@@ -120,6 +120,7 @@ func registerFollow(r *http.Request) error {
 	// repository
 	userRepo := repository.NewUserRepository(db)
 	followRepo := repository.NewFollowRepository(db)
+	userCounterRepo := repository.NewUserCounterRepository(db)
 	notificationRepo := repository.NewNotificationRepository(db)
 
 	// UseCase
@@ -128,7 +129,7 @@ func registerFollow(r *http.Request) error {
 		log.Println(err)
 		return helper.NewInternalServerError(err.Error())
 	}
-	u := usecase.NewRegisterFollow(tx, tokenUserID, tokenUserName, followedUserName, userRepo, followRepo, notificationRepo)
+	u := usecase.NewRegisterFollow(tx, tokenUserID, tokenUserName, followedUserName, userRepo, followRepo, userCounterRepo, notificationRepo)
 	if err = u.RegisterFollowUseCase(r.Context()); err != nil {
 		log.Println(err)
 		switch err {
@@ -225,9 +226,10 @@ func deleteFollow(r *http.Request) error {
 	// repository
 	userRepo := repository.NewUserRepository(db)
 	followRepo := repository.NewFollowRepository(db)
+	userCounterRepo := repository.NewUserCounterRepository(db)
 
 	// UseCase
-	u := usecase.NewDeleteFollow(tx, tokenUserName, followedUserName, userRepo, followRepo)
+	u := usecase.NewDeleteFollow(tx, tokenUserName, followedUserName, userRepo, followRepo, userCounterRepo)
 	if err = u.DeleteFollowUseCase(r.Context()); err != nil {
 		log.Println(err)
 		if err == usecase.ErrNotExitsUser {
@@ -1,6 +1,7 @@
 package controller
 
 import (
+	"encoding/json"
 	"log"
 	"net/http"
 	"strconv"
@@ -15,11 +16,38 @@ import (
 	"github.com/gold-kou/ToeBeans/backend/app/adapter/http/helper"
 	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
 	"github.com/gold-kou/ToeBeans/backend/app/application/usecase"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+	modelHTTP "github.com/gold-kou/ToeBeans/backend/app/domain/model/http"
 	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
 )
 
 func LikeController(w http.ResponseWriter, r *http.Request) {
 	switch {
+	case strings.HasPrefix(r.URL.Path, "/likes/") && strings.HasSuffix(r.URL.Path, "/users"):
+		switch r.Method {
+		case http.MethodGet:
+			postingID, likes, users, followed, next, err := getLikeUsers(r)
+			switch err := err.(type) {
+			case nil:
+				resp := newResponseGetLikeUsers(postingID, likes, users, followed, next)
+				w.Header().Set(helper.HeaderKeyContentType, helper.HeaderValueApplicationJSON)
+				w.WriteHeader(http.StatusOK)
+				if err := json.NewEncoder(w).Encode(resp); err != nil {
+					log.Println(err.Error())
+				}
+			case *helper.BadRequestError:
+				helper.ResponseBadRequest(w, err.Error())
+			case *helper.AuthorizationError:
+				helper.ResponseUnauthorized(w, err.Error())
+			case *helper.InternalServerError:
+				helper.ResponseInternalServerError(w, err.Error())
+			default:
+				helper.ResponseInternalServerError(w, err.Error())
+			}
+		default:
+			methods := []string{http.MethodGet}
+			helper.ResponseNotAllowedMethod(w, errMsgNotAllowedMethod, methods)
+		}
 	case strings.HasPrefix(r.URL.Path, "/likes/"):
 		switch r.Method {
 		case http.MethodPost:
@@ -97,6 +125,8 @@ func registerLike(r *http.Request) error {
 	userRepo := repository.NewUserRepository(db)
 	postingRepo := repository.NewPostingRepository(db)
 	likeRepo := repository.NewLikeRepository(db)
+	postingCounterRepo := repository.NewPostingCounterRepository(db)
+	userCounterRepo := repository.NewUserCounterRepository(db)
 	notificationRepo := repository.NewNotificationRepository(db)
 
 	// UseCase
@@ -105,10 +135,12 @@ func registerLike(r *http.Request) error {
 		log.Println(err)
 		return helper.NewInternalServerError(err.Error())
 	}
-	u := usecase.NewRegisterLike(tx, tokenUserID, tokenUserName, postingID, userRepo, postingRepo, likeRepo, notificationRepo)
+	u := usecase.NewRegisterLike(tx, tokenUserID, tokenUserName, postingID, userRepo, postingRepo, likeRepo, postingCounterRepo, userCounterRepo, notificationRepo)
 	if err = u.RegisterLikeUseCase(r.Context()); err != nil {
 		log.Println(err)
-		if err == usecase.ErrLikeYourPosting {
+		if err == usecase.ErrNotExistsData {
+			return helper.NewBadRequestError(err.Error())
+		} else if err == usecase.ErrLikeYourPosting {
 			return helper.NewConflictError(err.Error())
 		} else if err == usecase.ErrAlreadyLiked {
 			return helper.NewConflictError(err.Error())
@@ -152,9 +184,11 @@ func deleteLike(r *http.Request) error {
 	userRepo := repository.NewUserRepository(db)
 	postingRepo := repository.NewPostingRepository(db)
 	likeRepo := repository.NewLikeRepository(db)
+	postingCounterRepo := repository.NewPostingCounterRepository(db)
+	userCounterRepo := repository.NewUserCounterRepository(db)
 
 	// UseCase
-	u := usecase.NewDeleteLike(tx, tokenUserName, int64(postingID), userRepo, postingRepo, likeRepo)
+	u := usecase.NewDeleteLike(tx, tokenUserName, int64(postingID), userRepo, postingRepo, likeRepo, postingCounterRepo, userCounterRepo)
 	if err = u.DeleteLikeUseCase(r.Context()); err != nil {
 		log.Println(err)
 		if err == usecase.ErrDeleteNotExistsLike {
@@ -164,3 +198,88 @@ func deleteLike(r *http.Request) error {
 	}
 	return err
 }
+
+func getLikeUsers(r *http.Request) (postingID int64, likes []model.Like, users []model.User, followed map[int64]bool, next string, err error) {
+	tokenUserName, err := context.GetTokenUserName(r.Context())
+	if err != nil {
+		log.Println(err)
+		err = helper.NewInternalServerError(err.Error())
+		return
+	}
+
+	// get request parameter
+	vars := mux.Vars(r)
+	paramPostingID, _ := vars["posting_id"]
+	postingID, err = strconv.ParseInt(paramPostingID, 10, 64)
+	if err != nil {
+		log.Println(err)
+		err = helper.NewBadRequestError(err.Error())
+		return
+	}
+	cursor, limit, err := getPagingParams(r, true)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	// validation check
+	if err = validation.Validate(postingID, validation.Required); err != nil {
+		log.Println(err)
+		err = helper.NewBadRequestError(err.Error())
+		return
+	}
+
+	// db connect
+	db, err := mysql.NewDB()
+	if err != nil {
+		log.Println(err)
+		err = helper.NewInternalServerError(err.Error())
+		return
+	}
+	defer db.Close()
+	tx := mysql.NewDBTransaction(db)
+
+	// repository
+	userRepo := repository.NewUserRepository(db)
+	postingRepo := repository.NewPostingRepository(db)
+	likeRepo := repository.NewLikeRepository(db)
+	followRepo := repository.NewFollowRepository(db)
+
+	// UseCase
+	u := usecase.NewGetLikeUsers(tx, tokenUserName, postingID, cursor, int8(limit), userRepo, postingRepo, likeRepo, followRepo)
+	if likes, users, followed, err = u.GetLikeUsersUseCase(r.Context()); err != nil {
+		log.Println(err)
+		if err == usecase.ErrTokenInvalidNotExistingUserName {
+			err = helper.NewAuthorizationError(err.Error())
+			return
+		}
+		if err == usecase.ErrNotExistsData {
+			err = helper.NewBadRequestError(err.Error())
+			return
+		}
+		err = helper.NewInternalServerError(err.Error())
+		return
+	}
+	if len(likes) == limit {
+		last := likes[len(likes)-1]
+		next = helper.EncodeCursor(model.Cursor{CreatedAt: last.CreatedAt, ID: last.ID})
+	}
+	return
+}
+
+func newResponseGetLikeUsers(postingID int64, likes []model.Like, users []model.User, followed map[int64]bool, next string) (resp modelHTTP.ResponseGetLikeUsers) {
+	if len(likes) == 0 {
+		return
+	}
+	resp.PostingId = postingID
+	for i, l := range likes {
+		resp.Users = append(resp.Users, modelHTTP.ResponseGetLikeUser{
+			UserName: users[i].Name,
+			Icon:     usecase.SignIconURL(users[i].Icon),
+			IsFollow: followed[users[i].ID],
+			LikedAt:  l.CreatedAt,
+		})
+	}
+	resp.NextCursor = next
+	return
+}
@@ -2,6 +2,8 @@ package controller
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -13,6 +15,7 @@ import (
 
 	"github.com/gorilla/mux"
 
+	modelHTTP "github.com/gold-kou/ToeBeans/backend/app/domain/model/http"
 	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
 
 	"github.com/gold-kou/ToeBeans/backend/app/lib"
@@ -156,6 +159,17 @@ func TestRegisterLike(t *testing.T) {
 				likes[0].CreatedAt = lib.NowFunc()
 				likes[0].UpdatedAt = lib.NowFunc()
 				assert.Equal(t, dummy.Like1to2, likes[0])
+
+				postingCounters, err := testingHelper.FindAllPostingCounters(context.Background(), db)
+				assert.NoError(t, err)
+				assert.Equal(t, 1, len(postingCounters))
+				assert.Equal(t, dummy.Posting2.ID, postingCounters[0].PostingID)
+				assert.Equal(t, int64(1), postingCounters[0].LikedCount)
+				userCounters, err := testingHelper.FindAllUserCounters(context.Background(), db)
+				assert.NoError(t, err)
+				assert.Equal(t, 2, len(userCounters))
+				assert.Equal(t, int64(1), userCounters[0].LikeCount)
+				assert.Equal(t, int64(1), userCounters[1].LikedCount)
 			}
 
 			// assert http
@@ -280,3 +294,246 @@ func TestDeleteLike(t *testing.T) {
 		})
 	}
 }
+
+var successRespGetLikeUsers = `
+{
+  "posting_id": 1,
+  "users": [
+    {
+      "user_name": "testUser3",
+      "icon": "UNKNOWN",
+      "is_follow": false,
+      "liked_at": "2020-01-01T00:00:00+09:00"
+    },
+    {
+      "user_name": "testUser2",
+      "icon": "UNKNOWN",
+      "is_follow": true,
+      "liked_at": "2020-01-01T00:00:00+09:00"
+    }
+  ]
+}
+`
+var successRespGetLikeUsersEmpty = `
+{
+}
+`
+var errRespGetLikeUsersNotExistsPosting = `
+{
+  "status": 400,
+  "message": "not exists data error"
+}
+`
+var errRespGetLikeUsersInvalidCursor = `
+{
+  "status": 400,
+  "message": "cursor: is invalid."
+}
+`
+var errRespGetLikeUsersNoLimit = `
+{
+  "status": 400,
+  "message": "limit: cannot be blank."
+}
+`
+var errRespGetLikeUsersLimitTooLarge = `
+{
+  "status": 400,
+  "message": "limit: must be no greater than 127."
+}
+`
+var errRespGetLikeUsersNotExistingUser = `
+{
+  "status": 401,
+  "message": "the user name contained in token doesn't exist"
+}
+`
+
+func TestGetLikeUsers(t *testing.T) {
+	type args struct {
+		tokenUserName string
+		postingID     string
+		cursor        string
+		limit         string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		method     string
+		want       string
+		wantStatus int
+	}{
+		{
+			name:       "success",
+			args:       args{postingID: "1", limit: "50"},
+			method:     http.MethodGet,
+			want:       successRespGetLikeUsers,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "success no likes",
+			args:       args{postingID: "2", limit: "50"},
+			method:     http.MethodGet,
+			want:       successRespGetLikeUsersEmpty,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "error no limit",
+			args:       args{postingID: "1"},
+			method:     http.MethodGet,
+			want:       errRespGetLikeUsersNoLimit,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "error limit too large",
+			args:       args{postingID: "1", limit: "128"},
+			method:     http.MethodGet,
+			want:       errRespGetLikeUsersLimitTooLarge,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "error not existing user",
+			args:       args{tokenUserName: "testUser0", postingID: "1", limit: "50"},
+			method:     http.MethodGet,
+			want:       errRespGetLikeUsersNotExistingUser,
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "error not exists posting",
+			args:       args{postingID: "3", limit: "50"},
+			method:     http.MethodGet,
+			want:       errRespGetLikeUsersNotExistsPosting,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "error others scheduled posting",
+			args:       args{postingID: "2", limit: "50"},
+			method:     http.MethodGet,
+			want:       errRespGetLikeUsersNotExistsPosting,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "success own scheduled posting",
+			args:       args{tokenUserName: dummy.User2.Name, postingID: "2", limit: "50"},
+			method:     http.MethodGet,
+			want:       successRespGetLikeUsersEmpty,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "error invalid cursor",
+			args:       args{postingID: "1", cursor: "invalid", limit: "50"},
+			method:     http.MethodGet,
+			want:       errRespGetLikeUsersInvalidCursor,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "not allowed method",
+			args:       args{postingID: "1"},
+			method:     http.MethodHead,
+			want:       testingHelper.ErrNotAllowedMethod,
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// init
+			db := testingHelper.SetupDBTest()
+			defer testingHelper.TeardownDBTest(db)
+			testingHelper.SetTestTime()
+			defer testingHelper.ResetTime()
+
+			// insert dummy data
+			insertDummyLikeUsers(t, db)
+			if tt.name == "error others scheduled posting" || tt.name == "success own scheduled posting" {
+				_, err := db.Exec("UPDATE `postings` SET `publish_at` = ? WHERE `id` = ?", lib.NowFunc().AddDate(0, 0, 1), dummy.Posting2.ID)
+				assert.NoError(t, err)
+			}
+
+			// http request
+			req, err := http.NewRequest(tt.method, fmt.Sprintf("/likes/%s/users?cursor=%s&limit=%s", tt.args.postingID, tt.args.cursor, tt.args.limit), nil)
+			assert.NoError(t, err)
+			vars := map[string]string{"posting_id": tt.args.postingID}
+			req = mux.SetURLVars(req, vars)
+			tokenUserName := tt.args.tokenUserName
+			if tokenUserName == "" {
+				tokenUserName = dummy.User1.Name
+			}
+			req = req.WithContext(httpContext.SetTokenUserName(req.Context(), tokenUserName))
+			resp := httptest.NewRecorder()
+
+			// test target
+			LikeController(resp, req)
+			assert.NoError(t, err)
+
+			// assert http
+			assert.Equal(t, tt.wantStatus, resp.Code)
+			respBodyByte, err := ioutil.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			respBody := string(respBodyByte)
+			assert.JSONEq(t, tt.want, respBody)
+		})
+	}
+}
+
+func TestGetLikeUsersCursor(t *testing.T) {
+	// init
+	db := testingHelper.SetupDBTest()
+	defer testingHelper.TeardownDBTest(db)
+	testingHelper.SetTestTime()
+	defer testingHelper.ResetTime()
+
+	// insert dummy data which are created in the same second
+	insertDummyLikeUsers(t, db)
+
+	// follow next_cursor until the last page
+	var gotNames []string
+	cursor := ""
+	for i := 0; i < 3; i++ {
+		req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/likes/1/users?cursor=%s&limit=1", cursor), nil)
+		assert.NoError(t, err)
+		req = mux.SetURLVars(req, map[string]string{"posting_id": "1"})
+		req = req.WithContext(httpContext.SetTokenUserName(req.Context(), dummy.User1.Name))
+		resp := httptest.NewRecorder()
+
+		LikeController(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		var body modelHTTP.ResponseGetLikeUsers
+		err = json.NewDecoder(resp.Body).Decode(&body)
+		assert.NoError(t, err)
+		for _, u := range body.Users {
+			gotNames = append(gotNames, u.UserName)
+		}
+		if body.NextCursor == "" {
+			break
+		}
+		cursor = body.NextCursor
+	}
+	assert.Equal(t, []string{dummy.User3.Name, dummy.User2.Name}, gotNames)
+}
+
+// insertDummyLikeUsers makes User2 and User3 like Posting1 of User1 who follows only User2.
+func insertDummyLikeUsers(t *testing.T, db *sql.DB) {
+	userRepo := repository.NewUserRepository(db)
+	postingRepo := repository.NewPostingRepository(db)
+	likeRepo := repository.NewLikeRepository(db)
+	followRepo := repository.NewFollowRepository(db)
+	err := userRepo.Create(context.Background(), &dummy.User1)
+	assert.NoError(t, err)
+	err = userRepo.Create(context.Background(), &dummy.User2)
+	assert.NoError(t, err)
+	err = userRepo.Create(context.Background(), &dummy.User3)
+	assert.NoError(t, err)
+	err = postingRepo.Create(context.Background(), &dummy.Posting1)
+	assert.NoError(t, err)
+	err = postingRepo.Create(context.Background(), &dummy.Posting2)
+	assert.NoError(t, err)
+	err = likeRepo.Create(context.Background(), &dummy.Like2to1)
+	assert.NoError(t, err)
+	err = likeRepo.Create(context.Background(), &dummy.Like3to1)
+	assert.NoError(t, err)
+	err = testingHelper.UpdateNow(db, "likes")
+	assert.NoError(t, err)
+	err = followRepo.Create(context.Background(), &dummy.Follow1to2)
+	assert.NoError(t, err)
+}
@@ -21,7 +21,7 @@ import (
 func NotificationsController(w http.ResponseWriter, r *http.Request) {
 	switch r.Method {
 	case http.MethodGet:
-		notifications, visitorUserNames, visitedUserName, err := getNotifications(r)
+		notifications, visitorUserNames, visitedUserName, next, err := getNotifications(r)
 		switch err := err.(type) {
 		case nil:
 			var httpNotifications []modelHTTP.ResponseGetNotification
@@ -36,6 +36,7 @@ func NotificationsController(w http.ResponseWriter, r *http.Request) {
 			resp := modelHTTP.ResponseGetNotifications{
 				VisitedName: visitedUserName,
 				Actions:     httpNotifications,
+				NextCursor:  next,
 			}
 			w.Header().Set(helper.HeaderKeyContentType, helper.HeaderValueApplicationJSON)
 			w.WriteHeader(http.StatusOK)
@@ -57,7 +58,7 @@ func NotificationsController(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func getNotifications(r *http.Request) (notifications []model.Notification, visitorUserNames []string, visitedUserName string, err error) {
+func getNotifications(r *http.Request) (notifications []model.Notification, visitorUserNames []string, visitedUserName string, next string, err error) {
 	tokenUserName, err := context.GetTokenUserName(r.Context())
 	if err != nil {
 		log.Println(err)
@@ -74,6 +75,11 @@ func getNotifications(r *http.Request) (notifications []model.Notification, visi
 		err = helper.NewBadRequestError(err.Error())
 		return
 	}
+	cursor, limit, err := getPagingParams(r, false)
+	if err != nil {
+		log.Println(err)
+		return
+	}
 
 	// db connect
 	db, err := mysql.NewDB()
@@ -90,7 +96,7 @@ func getNotifications(r *http.Request) (notifications []model.Notification, visi
 	notificationRepo := repository.NewNotificationRepository(db)
 
 	// UseCase
-	u := usecase.NewGetNotifications(tx, tokenUserName, visitedUserName, userRepo, notificationRepo)
+	u := usecase.NewGetNotifications(tx, tokenUserName, visitedUserName, cursor, int8(limit), userRepo, notificationRepo)
 	if notifications, visitorUserNames, err = u.GetNotificationsUseCase(r.Context()); err != nil {
 		log.Println(err)
 		if err == usecase.ErrNotExistsData {
@@ -100,5 +106,9 @@ func getNotifications(r *http.Request) (notifications []model.Notification, visi
 		err = helper.NewInternalServerError(err.Error())
 		return
 	}
+	if limit > 0 && len(notifications) == limit {
+		last := notifications[len(notifications)-1]
+		next = helper.EncodeCursor(model.Cursor{CreatedAt: last.CreatedAt, ID: last.ID})
+	}
 	return
 }
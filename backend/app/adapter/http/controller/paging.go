@@ -0,0 +1,61 @@
+package controller
+
+import (
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/http/helper"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+)
+
+// getPagingParams reads cursor and limit which are common to the endpoints returning lists.
+// Without cursor the first page is returned. limit is 0 when it is optional and not given, which means no limit.
+func getPagingParams(r *http.Request, limitRequired bool) (cursor model.Cursor, limit int, err error) {
+	if paramCursor := r.URL.Query().Get("cursor"); paramCursor != "" {
+		cursor, err = helper.DecodeCursor(paramCursor)
+		if err != nil {
+			log.Println(err)
+			err = helper.NewBadRequestError(errMsgInvalidCursor)
+			return
+		}
+	} else if paramSinceAt := r.URL.Query().Get("since_at"); paramSinceAt != "" {
+		// Deprecated: since_at skips postings created in the same second as the last one. Use cursor instead.
+		jst, _ := time.LoadLocation("Asia/Tokyo")
+		// 例えば 2020-01-01T00:00:00+09:00 でリクエストされても 2020-01-01T00:00:00 09:00 と変換されてしまうため、それをreplaceしている。
+		var sinceAt time.Time
+		sinceAt, err = time.ParseInLocation("2006-01-02T15:04:05+09:00", strings.Replace(paramSinceAt, " ", "+", 1), jst)
+		if err != nil {
+			log.Println(err)
+			err = helper.NewBadRequestError(err.Error())
+			return
+		}
+		// no row has id 0, so only created_at is compared
+		cursor = model.Cursor{CreatedAt: sinceAt}
+	}
+
+	paramLimit := r.URL.Query().Get("limit")
+	if paramLimit == "" {
+		if limitRequired {
+			err = helper.NewBadRequestError("limit: cannot be blank.")
+		}
+		return
+	}
+	limit, err = strconv.Atoi(paramLimit)
+	if err != nil {
+		log.Println(err)
+		err = helper.NewBadRequestError(err.Error())
+		return
+	}
+	// the repositories take limit as int8, so a larger one would wrap around to a negative one meaning no limit
+	if err = validation.Validate(limit, validation.Min(1), validation.Max(math.MaxInt8)); err != nil {
+		log.Println(err)
+		err = helper.NewBadRequestError("limit: " + err.Error() + ".")
+		return
+	}
+	return
+}
@@ -1,10 +1,17 @@
 package controller
 
 import (
+	"bytes"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
+	"io"
 	"io/ioutil"
 	"log"
+	"math"
+	"mime"
 	"net/http"
+	"os"
 	"strconv"
 	"strings"
 	"time"
@@ -16,13 +23,21 @@ import (
 	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
 	"github.com/gorilla/mux"
 
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/classifier"
 	"github.com/gold-kou/ToeBeans/backend/app/adapter/http/helper"
 	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
 	"github.com/gold-kou/ToeBeans/backend/app/application/usecase"
 	modelHTTP "github.com/gold-kou/ToeBeans/backend/app/domain/model/http"
 	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+	"github.com/gold-kou/ToeBeans/backend/app/lib/imaging"
 )
 
+// title is limited to MaxVarcharLength characters, and a character is at most 4 bytes in UTF-8
+const maxMultipartFieldByte = modelHTTP.MaxVarcharLength * 4
+
+// replaced in tests to exercise the rejection path
+var newImageClassifier = classifier.NewImageClassifier
+
 func PostingController(w http.ResponseWriter, r *http.Request) {
 	switch {
 	case r.URL.Path == "/postings":
@@ -42,31 +57,61 @@ func PostingController(w http.ResponseWriter, r *http.Request) {
 				helper.ResponseInternalServerError(w, err.Error())
 			}
 		case http.MethodGet:
-			postings, userNames, likedCounts, likes, err := getPostings(r)
+			postings, userNames, likedCounts, likes, next, err := getPostings(r)
 			switch err := err.(type) {
 			case nil:
-				var httpPostings = []modelHTTP.ResponseGetPosting{}
-				var resp modelHTTP.ResponseGetPostings
-				for i, p := range postings {
-					httpPosting := modelHTTP.ResponseGetPosting{
-						PostingId:  p.ID,
-						UserName:   userNames[i],
-						UploadedAt: p.CreatedAt,
-						Title:      p.Title,
-						ImageUrl:   p.ImageURL,
-						LikedCount: likedCounts[i],
-						Liked:      false,
-					}
-					for _, l := range likes {
-						if p.ID == l.PostingID {
-							httpPosting.Liked = true
-						}
-					}
-					httpPostings = append(httpPostings, httpPosting)
+				resp := newResponseGetPostings(postings, userNames, likedCounts, likes)
+				resp.NextCursor = next
+				w.Header().Set(helper.HeaderKeyContentType, helper.HeaderValueApplicationJSON)
+				w.WriteHeader(http.StatusOK)
+				if err := json.NewEncoder(w).Encode(resp); err != nil {
+					log.Println(err.Error())
 				}
-				resp = modelHTTP.ResponseGetPostings{
-					Postings: httpPostings,
+			case *helper.BadRequestError:
+				helper.ResponseBadRequest(w, err.Error())
+			case *helper.AuthorizationError:
+				helper.ResponseUnauthorized(w, err.Error())
+			case *helper.InternalServerError:
+				helper.ResponseInternalServerError(w, err.Error())
+			default:
+				helper.ResponseInternalServerError(w, err.Error())
+			}
+		default:
+			methods := []string{http.MethodPost, http.MethodGet}
+			helper.ResponseNotAllowedMethod(w, errMsgNotAllowedMethod, methods)
+		}
+	case r.URL.Path == "/postings/scheduled":
+		switch r.Method {
+		case http.MethodGet:
+			postings, userNames, likedCounts, err := getScheduledPostings(r)
+			switch err := err.(type) {
+			case nil:
+				resp := newResponseGetPostings(postings, userNames, likedCounts, nil)
+				w.Header().Set(helper.HeaderKeyContentType, helper.HeaderValueApplicationJSON)
+				w.WriteHeader(http.StatusOK)
+				if err := json.NewEncoder(w).Encode(resp); err != nil {
+					log.Println(err.Error())
 				}
+			case *helper.BadRequestError:
+				helper.ResponseBadRequest(w, err.Error())
+			case *helper.AuthorizationError:
+				helper.ResponseUnauthorized(w, err.Error())
+			case *helper.InternalServerError:
+				helper.ResponseInternalServerError(w, err.Error())
+			default:
+				helper.ResponseInternalServerError(w, err.Error())
+			}
+		default:
+			methods := []string{http.MethodGet}
+			helper.ResponseNotAllowedMethod(w, errMsgNotAllowedMethod, methods)
+		}
+	case r.URL.Path == "/postings/popular":
+		switch r.Method {
+		case http.MethodGet:
+			postings, userNames, likedCounts, likes, err := getPopularPostings(r)
+			switch err := err.(type) {
+			case nil:
+				resp := newResponseGetPostings(postings, userNames, likedCounts, likes)
 				w.Header().Set(helper.HeaderKeyContentType, helper.HeaderValueApplicationJSON)
 				w.WriteHeader(http.StatusOK)
 				if err := json.NewEncoder(w).Encode(resp); err != nil {
@@ -82,11 +127,62 @@ func PostingController(w http.ResponseWriter, r *http.Request) {
 				helper.ResponseInternalServerError(w, err.Error())
 			}
 		default:
-			methods := []string{http.MethodPost, http.MethodGet}
+			methods := []string{http.MethodGet}
+			helper.ResponseNotAllowedMethod(w, errMsgNotAllowedMethod, methods)
+		}
+	case strings.HasPrefix(r.URL.Path, "/postings/") && strings.HasSuffix(r.URL.Path, "/stats"):
+		switch r.Method {
+		case http.MethodGet:
+			postingID, stats, err := getPostingStats(r)
+			switch err := err.(type) {
+			case nil:
+				resp := newResponseGetPostingStats(postingID, stats)
+				w.Header().Set(helper.HeaderKeyContentType, helper.HeaderValueApplicationJSON)
+				w.WriteHeader(http.StatusOK)
+				if err := json.NewEncoder(w).Encode(resp); err != nil {
+					log.Println(err.Error())
+				}
+			case *helper.BadRequestError:
+				helper.ResponseBadRequest(w, err.Error())
+			case *helper.AuthorizationError:
+				helper.ResponseUnauthorized(w, err.Error())
+			case *helper.ForbiddenError:
+				helper.ResponseForbidden(w, err.Error())
+			case *helper.NotFoundError:
+				helper.ResponseNotFound(w, err.Error())
+			case *helper.InternalServerError:
+				helper.ResponseInternalServerError(w, err.Error())
+			default:
+				helper.ResponseInternalServerError(w, err.Error())
+			}
+		default:
+			methods := []string{http.MethodGet}
 			helper.ResponseNotAllowedMethod(w, errMsgNotAllowedMethod, methods)
 		}
 	case strings.HasPrefix(r.URL.Path, "/postings/"):
 		switch r.Method {
+		case http.MethodGet:
+			posting, comments, commentUserNames, next, err := getPosting(r)
+			switch err := err.(type) {
+			case nil:
+				resp := newResponseGetPostingDetail(posting, comments, commentUserNames)
+				resp.CommentsNextCursor = next
+				w.Header().Set(helper.HeaderKeyContentType, helper.HeaderValueApplicationJSON)
+				w.WriteHeader(http.StatusOK)
+				if err := json.NewEncoder(w).Encode(resp); err != nil {
+					log.Println(err.Error())
+				}
+			case *helper.BadRequestError:
+				helper.ResponseBadRequest(w, err.Error())
+			case *helper.AuthorizationError:
+				helper.ResponseUnauthorized(w, err.Error())
+			case *helper.NotFoundError:
+				helper.ResponseNotFound(w, err.Error())
+			case *helper.InternalServerError:
+				helper.ResponseInternalServerError(w, err.Error())
+			default:
+				helper.ResponseInternalServerError(w, err.Error())
+			}
 		case http.MethodDelete:
 			err := deletePosting(r)
 			switch err := err.(type) {
@@ -103,8 +199,24 @@ func PostingController(w http.ResponseWriter, r *http.Request) {
 			default:
 				helper.ResponseInternalServerError(w, err.Error())
 			}
+		case http.MethodPut:
+			err := updatePosting(r)
+			switch err := err.(type) {
+			case nil:
+				helper.ResponseSimpleSuccess(w)
+			case *helper.BadRequestError:
+				helper.ResponseBadRequest(w, err.Error())
+			case *helper.AuthorizationError:
+				helper.ResponseUnauthorized(w, err.Error())
+			case *helper.ForbiddenError:
+				helper.ResponseForbidden(w, err.Error())
+			case *helper.InternalServerError:
+				helper.ResponseInternalServerError(w, err.Error())
+			default:
+				helper.ResponseInternalServerError(w, err.Error())
+			}
 		default:
-			methods := []string{http.MethodDelete}
+			methods := []string{http.MethodGet, http.MethodPut, http.MethodDelete}
 			helper.ResponseNotAllowedMethod(w, errMsgNotAllowedMethod, methods)
 		}
 	default:
@@ -121,25 +233,62 @@ func registerPosting(r *http.Request) error {
 
 	// get request parameter
 	var reqRegisterPosting *modelHTTP.RequestRegisterPosting
-	b, err := ioutil.ReadAll(r.Body)
-	if err != nil {
-		log.Println(err)
-		return helper.NewBadRequestError(err.Error())
-	}
-	defer r.Body.Close()
-	if err := json.Unmarshal(b, &reqRegisterPosting); err != nil {
-		log.Println(err)
-		return helper.NewBadRequestError(err.Error())
+	var imgs []io.Reader
+	if isMultipartRequest(r) {
+		var spooled []*os.File
+		reqRegisterPosting, spooled, err = parseMultipartRegisterPosting(r)
+		if err != nil {
+			log.Println(err)
+			return helper.NewBadRequestError(err.Error())
+		}
+		defer removeSpooledImages(spooled)
+		for _, f := range spooled {
+			imgs = append(imgs, f)
+		}
+	} else {
+		b, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			log.Println(err)
+			return helper.NewBadRequestError(err.Error())
+		}
+		defer r.Body.Close()
+		if err := json.Unmarshal(b, &reqRegisterPosting); err != nil {
+			log.Println(err)
+			return helper.NewBadRequestError(err.Error())
+		}
 	}
 
 	// validation check
-	err = reqRegisterPosting.ValidateParam()
+	if imgs != nil {
+		err = reqRegisterPosting.ValidateFields()
+	} else {
+		err = reqRegisterPosting.ValidateParam()
+	}
 	if err != nil {
 		log.Println(err)
 		return helper.NewBadRequestError(err.Error())
 	}
-	if strings.Contains(reqRegisterPosting.Title, "_") {
-		return helper.NewBadRequestError("title: must not contain _.")
+
+	// base64 decode (for clients still sending JSON)
+	// images uploaded with presigned URLs are read by the use case
+	if imgs == nil && len(reqRegisterPosting.UploadKeys) == 0 {
+		encodedImgs := reqRegisterPosting.Images
+		if len(encodedImgs) == 0 {
+			encodedImgs = []string{reqRegisterPosting.Image}
+		}
+		for _, encodedImg := range encodedImgs {
+			decodedImg, err := base64.StdEncoding.DecodeString(encodedImg)
+			if err != nil {
+				log.Println(err)
+				return helper.NewBadRequestError(usecase.ErrDecodeImage.Error())
+			}
+			imgReader, err := helper.NewImageReader(bytes.NewReader(decodedImg))
+			if err != nil {
+				log.Println(err)
+				return helper.NewBadRequestError(err.Error())
+			}
+			imgs = append(imgs, imgReader)
+		}
 	}
 
 	// db connect
@@ -154,6 +303,14 @@ func registerPosting(r *http.Request) error {
 	// repository
 	userRepo := repository.NewUserRepository(db)
 	postingRepo := repository.NewPostingRepository(db)
+	postingImageRepo := repository.NewPostingImageRepository(db)
+	tagRepo := repository.NewTagRepository(db)
+	postingTagRepo := repository.NewPostingTagRepository(db)
+	catRepo := repository.NewCatRepository(db)
+	postingCatRepo := repository.NewPostingCatRepository(db)
+	draftRepo := repository.NewDraftRepository(db)
+	userCounterRepo := repository.NewUserCounterRepository(db)
+	objectDeletionRepo := repository.NewObjectDeletionRepository(db)
 
 	// UseCase
 	tokenUserID, err := context.GetTokenUserID(r.Context())
@@ -161,10 +318,10 @@ func registerPosting(r *http.Request) error {
 		log.Println(err)
 		return helper.NewInternalServerError(err.Error())
 	}
-	u := usecase.NewRegisterPosting(tx, tokenUserID, tokenUserName, reqRegisterPosting, userRepo, postingRepo)
+	u := usecase.NewRegisterPosting(tx, tokenUserID, tokenUserName, reqRegisterPosting, imgs, newImageClassifier(), userRepo, postingRepo, postingImageRepo, tagRepo, postingTagRepo, catRepo, postingCatRepo, draftRepo, userCounterRepo, objectDeletionRepo)
 	if err = u.RegisterPostingUseCase(r.Context()); err != nil {
 		log.Println(err)
-		if err == usecase.ErrDecodeImage || err == usecase.ErrNotCatImage {
+		if err == usecase.ErrDecodeImage || err == usecase.ErrNotCatImage || err == usecase.ErrDuplicateImage || err == usecase.ErrUploadNotFound || err == usecase.ErrNotOwnedCat || err == helper.ErrImageTooLarge || err == helper.ErrUnsupportedImageType {
 			return helper.NewBadRequestError(err.Error())
 		}
 		return helper.NewInternalServerError(err.Error())
@@ -172,7 +329,212 @@ func registerPosting(r *http.Request) error {
 	return err
 }
 
-func getPostings(r *http.Request) (postings []model.Posting, userNames []string, likedCounts []int64, likes []model.Like, err error) {
+func isMultipartRequest(r *http.Request) bool {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get(helper.HeaderKeyContentType))
+	return err == nil && mediaType == helper.HeaderValueMultipartFormData
+}
+
+// parseMultipartRegisterPosting reads form parts in order. The title, alt_text, publish_at and cat_id parts must be sent before the image parts,
+// and the order of the image parts is the order of the images in the posting.
+// Parts can't be read in parallel, so each image is spooled to a temporary file up to ImageMaxByte before going to the next one.
+// The caller must remove the returned files with removeSpooledImages. On error they are already removed.
+func parseMultipartRegisterPosting(r *http.Request) (req *modelHTTP.RequestRegisterPosting, imgs []*os.File, err error) {
+	mr, err := r.MultipartReader()
+	if err != nil {
+		return nil, nil, err
+	}
+	// imgs is returned as it is on error so that this sees the files spooled so far
+	defer func() {
+		if err != nil {
+			removeSpooledImages(imgs)
+		}
+	}()
+	req = &modelHTTP.RequestRegisterPosting{}
+	for {
+		part, err := mr.NextPart()
+		if err == io.EOF {
+			if len(imgs) == 0 {
+				return nil, imgs, errors.New(errMsgImageBlank)
+			}
+			return req, imgs, nil
+		}
+		if err != nil {
+			return nil, imgs, err
+		}
+		switch part.FormName() {
+		case "title":
+			// a title after images is ignored
+			if len(imgs) > 0 {
+				continue
+			}
+			b, err := ioutil.ReadAll(io.LimitReader(part, maxMultipartFieldByte))
+			if err != nil {
+				return nil, imgs, err
+			}
+			req.Title = string(b)
+		case "alt_text":
+			// like the title, it must be sent before images
+			if len(imgs) > 0 {
+				continue
+			}
+			b, err := ioutil.ReadAll(io.LimitReader(part, maxMultipartFieldByte))
+			if err != nil {
+				return nil, imgs, err
+			}
+			req.AltText = string(b)
+		case "publish_at":
+			// like the title, it must be sent before images
+			if len(imgs) > 0 {
+				continue
+			}
+			b, err := ioutil.ReadAll(io.LimitReader(part, maxMultipartFieldByte))
+			if err != nil {
+				return nil, imgs, err
+			}
+			t, err := time.Parse(time.RFC3339, string(b))
+			if err != nil {
+				return nil, imgs, err
+			}
+			req.PublishAt = &t
+		case "cat_id":
+			// repeated for each cat, before images as well
+			if len(imgs) > 0 {
+				continue
+			}
+			b, err := ioutil.ReadAll(io.LimitReader(part, maxMultipartFieldByte))
+			if err != nil {
+				return nil, imgs, err
+			}
+			catID, err := strconv.ParseInt(string(b), 10, 64)
+			if err != nil {
+				return nil, imgs, err
+			}
+			req.CatIDs = append(req.CatIDs, catID)
+		case "image":
+			if len(imgs) == modelHTTP.MaxPostingImages {
+				return nil, imgs, errors.New(errMsgTooManyImages)
+			}
+			imgReader, err := helper.NewImageReader(part)
+			if err != nil {
+				return nil, imgs, err
+			}
+			f, err := spoolImage(imgReader)
+			if err != nil {
+				return nil, imgs, err
+			}
+			imgs = append(imgs, f)
+		}
+	}
+}
+
+// spoolImage copies the image to a temporary file and rewinds it, so that a request doesn't hold every image in memory.
+func spoolImage(r io.Reader) (*os.File, error) {
+	f, err := ioutil.TempFile("", "toebeans-image-")
+	if err != nil {
+		return nil, err
+	}
+	if _, err = io.Copy(f, r); err == nil {
+		_, err = f.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		removeSpooledImages([]*os.File{f})
+		return nil, err
+	}
+	return f, nil
+}
+
+func removeSpooledImages(files []*os.File) {
+	for _, f := range files {
+		f.Close()
+		if err := os.Remove(f.Name()); err != nil {
+			log.Println(err)
+		}
+	}
+}
+
+func newResponseGetPostings(postings []model.Posting, userNames []string, likedCounts []int64, likes []model.Like) modelHTTP.ResponseGetPostings {
+	var httpPostings = []modelHTTP.ResponseGetPosting{}
+	for i, p := range postings {
+		var liked bool
+		for _, l := range likes {
+			if p.ID == l.PostingID {
+				liked = true
+			}
+		}
+		httpPostings = append(httpPostings, newResponseGetPosting(p, userNames[i], likedCounts[i], liked))
+	}
+	return modelHTTP.ResponseGetPostings{
+		Postings: httpPostings,
+	}
+}
+
+func newResponseGetPosting(p model.Posting, userName string, likedCount int64, liked bool) modelHTTP.ResponseGetPosting {
+	return modelHTTP.ResponseGetPosting{
+		PostingId:  p.ID,
+		UserName:   userName,
+		UploadedAt: p.CreatedAt,
+		Title:      p.Title,
+		ImageUrl:   usecase.SignPostingImageURL(p.ImageURL),
+		ImageUrls:  imageUrls(p.ImageURL),
+		Images:     postingImageUrls(p),
+		AltText:    p.AltText,
+		EditedAt:   p.EditedAt,
+		PublishAt:  p.PublishAt,
+		LikedCount: likedCount,
+		Liked:      liked,
+	}
+}
+
+func newResponseGetPostingDetail(posting model.PostingDetail, comments []model.Comment, commentUserNames []string) modelHTTP.ResponseGetPostingDetail {
+	var httpComments = []modelHTTP.ResponseGetComment{}
+	for i, c := range comments {
+		httpComments = append(httpComments, modelHTTP.ResponseGetComment{
+			CommentId:   c.ID,
+			UserName:    commentUserNames[i],
+			CommentedAt: c.CreatedAt,
+			Comment:     c.Comment,
+		})
+	}
+	return modelHTTP.ResponseGetPostingDetail{
+		ResponseGetPosting: newResponseGetPosting(posting.Posting, posting.UserName, posting.LikedCount, posting.Liked),
+		UserIcon:           usecase.SignIconURL(posting.UserIcon),
+		CommentCount:       posting.CommentCount,
+		Comments:           httpComments,
+		Cats:               newResponseGetCats(posting.Cats, posting.UserName),
+	}
+}
+
+func imageUrls(fullURL string) modelHTTP.ResponseGetPostingImageUrls {
+	return modelHTTP.ResponseGetPostingImageUrls{
+		Thumb: usecase.SignPostingImageURL(imaging.VariantURL(fullURL, imaging.VariantThumb)),
+		Feed:  usecase.SignPostingImageURL(imaging.VariantURL(fullURL, imaging.VariantFeed)),
+		Full:  usecase.SignPostingImageURL(imaging.VariantURL(fullURL, imaging.VariantFull)),
+	}
+}
+
+// postingImageUrls returns the images of the posting in order.
+// A posting which has no posting_images yet is regarded as a single image posting.
+func postingImageUrls(p model.Posting) []modelHTTP.ResponseGetPostingImageUrls {
+	if len(p.Images) == 0 {
+		return []modelHTTP.ResponseGetPostingImageUrls{imageUrls(p.ImageURL)}
+	}
+	var urls []modelHTTP.ResponseGetPostingImageUrls
+	for _, i := range p.Images {
+		urls = append(urls, imageUrls(i.ImageURL))
+	}
+	return urls
+}
+
+// postingsNextCursor returns the cursor of the page following postings, or an empty string when postings is the last page.
+func postingsNextCursor(postings []model.Posting, limit int) string {
+	if limit <= 0 || len(postings) < limit {
+		return ""
+	}
+	last := postings[len(postings)-1]
+	return helper.EncodeCursor(model.Cursor{CreatedAt: last.CreatedAt, ID: last.ID})
+}
+
+func getPostings(r *http.Request) (postings []model.Posting, userNames []string, likedCounts []int64, likes []model.Like, next string, err error) {
 	tokenUserName, err := context.GetTokenUserName(r.Context())
 	if err != nil {
 		log.Println(err)
@@ -181,38 +543,76 @@ func getPostings(r *http.Request) (postings []model.Posting, userNames []string,
 	}
 
 	// get request parameter
-	sinceAt := r.URL.Query().Get("since_at")
-	if sinceAt == "" {
+	cursor, limitInt, err := getPagingParams(r, true)
+	if err != nil {
 		log.Println(err)
-		err = helper.NewBadRequestError("since_at: cannot be blank.")
 		return
 	}
 
-	jst, _ := time.LoadLocation("Asia/Tokyo")
-	// 例えば 2020-01-01T00:00:00+09:00 でリクエストされても 2020-01-01T00:00:00 09:00 と変換されてしまうため、それをreplaceしている。
-	sinceAtFormatted, err := time.ParseInLocation("2006-01-02T15:04:05+09:00", strings.Replace(sinceAt, " ", "+", 1), jst)
-	if err != nil {
+	// オプションパラメータの投稿を保持するユーザ
+	targetUserName := r.URL.Query().Get("user_name")
+	if err = validation.Validate(targetUserName, validation.Length(modelHTTP.MinVarcharLength, modelHTTP.MaxVarcharLength), is.Alphanumeric); err != nil {
 		log.Println(err)
 		err = helper.NewBadRequestError(err.Error())
 		return
 	}
 
-	limit := r.URL.Query().Get("limit")
-	if limit == "" {
+	// db connect
+	db, err := mysql.NewDB()
+	if err != nil {
 		log.Println(err)
-		err = helper.NewBadRequestError("limit: cannot be blank.")
+		err = helper.NewInternalServerError(err.Error())
 		return
 	}
-	limitInt, err := strconv.Atoi(limit)
+	defer db.Close()
+	tx := mysql.NewDBTransaction(db)
+
+	// repository
+	userRepo := repository.NewUserRepository(db)
+	postingRepo := repository.NewPostingRepository(db)
+	likeRepo := repository.NewLikeRepository(db)
+	postingCounterRepo := repository.NewPostingCounterRepository(db)
+	postingImageRepo := repository.NewPostingImageRepository(db)
+
+	// UseCase
+	u := usecase.NewGetPostings(tx, tokenUserName, cursor, int8(limitInt), targetUserName, userRepo, postingRepo, likeRepo, postingCounterRepo, postingImageRepo, repository.DefaultPostingViewBuffer)
+	if postings, userNames, likedCounts, likes, err = u.GetPostingsUseCase(r.Context()); err != nil {
+		log.Println(err)
+		if err == usecase.ErrDecodeImage {
+			err = helper.NewBadRequestError(err.Error())
+			return
+		}
+		if err == usecase.ErrNotExistsData {
+			err = helper.NewBadRequestError(err.Error())
+			return
+		}
+		err = helper.NewInternalServerError(err.Error())
+		return
+	}
+	next = postingsNextCursor(postings, limitInt)
+	return
+}
+
+func getPosting(r *http.Request) (posting model.PostingDetail, comments []model.Comment, commentUserNames []string, next string, err error) {
+	tokenUserName, err := context.GetTokenUserName(r.Context())
+	if err != nil {
+		log.Println(err)
+		err = helper.NewInternalServerError(err.Error())
+		return
+	}
+
+	// get request parameter
+	vars := mux.Vars(r)
+	paramPostingID, _ := vars["posting_id"]
+	postingID, err := strconv.Atoi(paramPostingID)
 	if err != nil {
 		log.Println(err)
 		err = helper.NewBadRequestError(err.Error())
 		return
 	}
 
-	// オプションパラメータの投稿を保持するユーザ
-	targetUserName := r.URL.Query().Get("user_name")
-	if err = validation.Validate(targetUserName, validation.Length(modelHTTP.MinVarcharLength, modelHTTP.MaxVarcharLength), is.Alphanumeric); err != nil {
+	// validation check
+	if err = validation.Validate(postingID, validation.Required); err != nil {
 		log.Println(err)
 		err = helper.NewBadRequestError(err.Error())
 		return
@@ -231,26 +631,308 @@ func getPostings(r *http.Request) (postings []model.Posting, userNames []string,
 	// repository
 	userRepo := repository.NewUserRepository(db)
 	postingRepo := repository.NewPostingRepository(db)
-	likeRepo := repository.NewLikeRepository(db)
+	postingImageRepo := repository.NewPostingImageRepository(db)
+	commentRepo := repository.NewCommentRepository(db)
+	catRepo := repository.NewCatRepository(db)
 
 	// UseCase
-	u := usecase.NewGetPostings(tx, tokenUserName, sinceAtFormatted, int8(limitInt), targetUserName, userRepo, postingRepo, likeRepo)
-	if postings, userNames, likedCounts, likes, err = u.GetPostingsUseCase(r.Context()); err != nil {
+	u := usecase.NewGetPosting(tx, tokenUserName, int64(postingID), userRepo, postingRepo, postingImageRepo, commentRepo, catRepo, repository.DefaultPostingViewBuffer)
+	if posting, comments, commentUserNames, err = u.GetPostingUseCase(r.Context()); err != nil {
 		log.Println(err)
-		if err == usecase.ErrDecodeImage {
+		if err == usecase.ErrNotExistsData {
+			err = helper.NewNotFoundError(err.Error())
+			return
+		}
+		err = helper.NewInternalServerError(err.Error())
+		return
+	}
+	if len(comments) == usecase.PostingCommentsLimit {
+		last := comments[len(comments)-1]
+		next = helper.EncodeCursor(model.Cursor{CreatedAt: last.CreatedAt, ID: last.ID})
+	}
+	return
+}
+
+func newResponseGetPostingStats(postingID int64, stats []model.PostingDailyStats) modelHTTP.ResponseGetPostingStats {
+	daily := make([]modelHTTP.ResponseGetPostingDailyStats, 0, len(stats))
+	for _, s := range stats {
+		daily = append(daily, modelHTTP.ResponseGetPostingDailyStats{
+			Date:        s.Date.Format("2006-01-02"),
+			Impressions: s.Impressions,
+			Views:       s.Views,
+			Likes:       s.Likes,
+			Comments:    s.Comments,
+		})
+	}
+	return modelHTTP.ResponseGetPostingStats{PostingId: postingID, Daily: daily}
+}
+
+func getPostingStats(r *http.Request) (postingID int64, stats []model.PostingDailyStats, err error) {
+	tokenUserName, err := context.GetTokenUserName(r.Context())
+	if err != nil {
+		log.Println(err)
+		err = helper.NewInternalServerError(err.Error())
+		return
+	}
+
+	// get request parameter
+	vars := mux.Vars(r)
+	paramPostingID, _ := vars["posting_id"]
+	postingID, err = strconv.ParseInt(paramPostingID, 10, 64)
+	if err != nil {
+		log.Println(err)
+		err = helper.NewBadRequestError(err.Error())
+		return
+	}
+	days := usecase.PostingStatsDaysDefault
+	if paramDays := r.URL.Query().Get("days"); paramDays != "" {
+		days, err = strconv.Atoi(paramDays)
+		if err != nil {
+			log.Println(err)
 			err = helper.NewBadRequestError(err.Error())
 			return
 		}
+	}
+
+	// validation check
+	if err = validation.Validate(postingID, validation.Required); err != nil {
+		log.Println(err)
+		err = helper.NewBadRequestError(err.Error())
+		return
+	}
+	if err = validation.Validate(days, validation.Min(1), validation.Max(usecase.PostingStatsDaysMax)); err != nil {
+		log.Println(err)
+		err = helper.NewBadRequestError("days: " + err.Error())
+		return
+	}
+
+	// db connect
+	db, err := mysql.NewDB()
+	if err != nil {
+		log.Println(err)
+		err = helper.NewInternalServerError(err.Error())
+		return
+	}
+	defer db.Close()
+	tx := mysql.NewDBTransaction(db)
+
+	// repository
+	userRepo := repository.NewUserRepository(db)
+	postingRepo := repository.NewPostingRepository(db)
+	postingViewRepo := repository.NewPostingViewRepository(db)
+
+	// UseCase
+	u := usecase.NewGetPostingStats(tx, tokenUserName, postingID, days, userRepo, postingRepo, postingViewRepo)
+	if stats, err = u.GetPostingStatsUseCase(r.Context()); err != nil {
+		log.Println(err)
 		if err == usecase.ErrNotExistsData {
+			err = helper.NewNotFoundError(err.Error())
+			return
+		}
+		if err == usecase.ErrNotPostingStatsOwner {
+			err = helper.NewForbiddenError(err.Error())
+			return
+		}
+		if err == usecase.ErrTokenInvalidNotExistingUserName {
+			err = helper.NewAuthorizationError(err.Error())
+			return
+		}
+		err = helper.NewInternalServerError(err.Error())
+		return
+	}
+	return
+}
+
+func getScheduledPostings(r *http.Request) (postings []model.Posting, userNames []string, likedCounts []int64, err error) {
+	tokenUserName, err := context.GetTokenUserName(r.Context())
+	if err != nil {
+		log.Println(err)
+		err = helper.NewInternalServerError(err.Error())
+		return
+	}
+
+	// db connect
+	db, err := mysql.NewDB()
+	if err != nil {
+		log.Println(err)
+		err = helper.NewInternalServerError(err.Error())
+		return
+	}
+	defer db.Close()
+	tx := mysql.NewDBTransaction(db)
+
+	// repository
+	userRepo := repository.NewUserRepository(db)
+	postingRepo := repository.NewPostingRepository(db)
+	postingCounterRepo := repository.NewPostingCounterRepository(db)
+	postingImageRepo := repository.NewPostingImageRepository(db)
+
+	// UseCase
+	u := usecase.NewGetScheduledPostings(tx, tokenUserName, userRepo, postingRepo, postingCounterRepo, postingImageRepo)
+	if postings, userNames, likedCounts, err = u.GetScheduledPostingsUseCase(r.Context()); err != nil {
+		log.Println(err)
+		err = helper.NewInternalServerError(err.Error())
+		return
+	}
+	return
+}
+
+func getPopularPostings(r *http.Request) (postings []model.Posting, userNames []string, likedCounts []int64, likes []model.Like, err error) {
+	tokenUserName, err := context.GetTokenUserName(r.Context())
+	if err != nil {
+		log.Println(err)
+		err = helper.NewInternalServerError(err.Error())
+		return
+	}
+
+	// get request parameter
+	period := r.URL.Query().Get("period")
+	if period == "" {
+		period = model.PopularPeriodDay
+	}
+	limit := r.URL.Query().Get("limit")
+	if limit == "" {
+		err = helper.NewBadRequestError("limit: cannot be blank.")
+		return
+	}
+	limitInt, err := strconv.Atoi(limit)
+	if err != nil {
+		log.Println(err)
+		err = helper.NewBadRequestError(err.Error())
+		return
+	}
+	var offsetInt int
+	if offset := r.URL.Query().Get("offset"); offset != "" {
+		offsetInt, err = strconv.Atoi(offset)
+		if err != nil {
+			log.Println(err)
 			err = helper.NewBadRequestError(err.Error())
 			return
 		}
+	}
+
+	// validation check
+	if err = validation.Validate(period, validation.In(model.PopularPeriodDay, model.PopularPeriodWeek, model.PopularPeriodAll)); err != nil {
+		log.Println(err)
+		err = helper.NewBadRequestError("period: " + err.Error())
+		return
+	}
+	// the repositories take limit as int8, so a larger one would wrap around
+	if err = validation.Validate(limitInt, validation.Min(1), validation.Max(math.MaxInt8)); err != nil {
+		log.Println(err)
+		err = helper.NewBadRequestError("limit: " + err.Error())
+		return
+	}
+	if err = validation.Validate(offsetInt, validation.Min(0)); err != nil {
+		log.Println(err)
+		err = helper.NewBadRequestError("offset: " + err.Error())
+		return
+	}
+
+	// db connect
+	db, err := mysql.NewDB()
+	if err != nil {
+		log.Println(err)
+		err = helper.NewInternalServerError(err.Error())
+		return
+	}
+	defer db.Close()
+	tx := mysql.NewDBTransaction(db)
+
+	// repository
+	userRepo := repository.NewUserRepository(db)
+	postingRepo := repository.NewPostingRepository(db)
+	likeRepo := repository.NewLikeRepository(db)
+	postingCounterRepo := repository.NewPostingCounterRepository(db)
+	postingImageRepo := repository.NewPostingImageRepository(db)
+
+	// UseCase
+	u := usecase.NewGetPopularPostings(tx, tokenUserName, period, int8(limitInt), offsetInt, userRepo, postingRepo, likeRepo, postingCounterRepo, postingImageRepo, repository.DefaultPostingViewBuffer)
+	if postings, userNames, likedCounts, likes, err = u.GetPopularPostingsUseCase(r.Context()); err != nil {
+		log.Println(err)
 		err = helper.NewInternalServerError(err.Error())
 		return
 	}
 	return
 }
 
+func updatePosting(r *http.Request) error {
+	// not allowed to guest user
+	tokenUserName, err := context.GetTokenUserName(r.Context())
+	if err != nil {
+		log.Println(err)
+		return helper.NewInternalServerError(err.Error())
+	}
+	if tokenUserName == helper.GuestUserName {
+		log.Println(errMsgGuestUserForbidden)
+		return helper.NewForbiddenError(errMsgGuestUserForbidden)
+	}
+
+	// get request parameter
+	vars := mux.Vars(r)
+	paramPostingID, _ := vars["posting_id"]
+	postingID, err := strconv.Atoi(paramPostingID)
+	if err != nil {
+		return helper.NewInternalServerError(err.Error())
+	}
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		log.Println(err)
+		return helper.NewBadRequestError(err.Error())
+	}
+	defer r.Body.Close()
+	var reqUpdatePosting *modelHTTP.RequestUpdatePosting
+	if err := json.Unmarshal(body, &reqUpdatePosting); err != nil {
+		log.Println(err)
+		return helper.NewBadRequestError(err.Error())
+	}
+
+	// validation check
+	if err = validation.Validate(postingID, validation.Required); err != nil {
+		log.Println(err)
+		return helper.NewBadRequestError(err.Error())
+	}
+	if err = reqUpdatePosting.ValidateParam(); err != nil {
+		log.Println(err)
+		return helper.NewBadRequestError(err.Error())
+	}
+
+	// db connect
+	db, err := mysql.NewDB()
+	if err != nil {
+		log.Println(err)
+		return helper.NewInternalServerError(err.Error())
+	}
+	defer db.Close()
+	tx := mysql.NewDBTransaction(db)
+
+	// repository
+	userRepo := repository.NewUserRepository(db)
+	postingRepo := repository.NewPostingRepository(db)
+	postingTitleHistoryRepo := repository.NewPostingTitleHistoryRepository(db)
+	tagRepo := repository.NewTagRepository(db)
+	postingTagRepo := repository.NewPostingTagRepository(db)
+	catRepo := repository.NewCatRepository(db)
+	postingCatRepo := repository.NewPostingCatRepository(db)
+
+	// UseCase
+	u := usecase.NewUpdatePosting(tx, int64(postingID), tokenUserName, reqUpdatePosting, newImageClassifier(), userRepo, postingRepo, postingTitleHistoryRepo, tagRepo, postingTagRepo, catRepo, postingCatRepo)
+	if err = u.UpdatePostingUseCase(r.Context()); err != nil {
+		log.Println(err)
+		if err == usecase.ErrNotExistsData {
+			return helper.NewBadRequestError(err.Error())
+		}
+		if err == usecase.ErrNotPostingOwner {
+			return helper.NewForbiddenError(err.Error())
+		}
+		if err == usecase.ErrAlreadyPublished || err == usecase.ErrNotOwnedCat {
+			return helper.NewBadRequestError(err.Error())
+		}
+		return helper.NewInternalServerError(err.Error())
+	}
+	return err
+}
+
 func deletePosting(r *http.Request) error {
 	// not allowed to guest user
 	tokenUserName, err := context.GetTokenUserName(r.Context())
@@ -289,9 +971,18 @@ func deletePosting(r *http.Request) error {
 	// repository
 	userRepo := repository.NewUserRepository(db)
 	postingRepo := repository.NewPostingRepository(db)
+	postingImageRepo := repository.NewPostingImageRepository(db)
+	postingTagRepo := repository.NewPostingTagRepository(db)
+	postingCatRepo := repository.NewPostingCatRepository(db)
+	bookmarkRepo := repository.NewBookmarkRepository(db)
+	collectionPostingRepo := repository.NewCollectionPostingRepository(db)
+	postingViewRepo := repository.NewPostingViewRepository(db)
+	postingCounterRepo := repository.NewPostingCounterRepository(db)
+	userCounterRepo := repository.NewUserCounterRepository(db)
+	objectDeletionRepo := repository.NewObjectDeletionRepository(db)
 
 	// UseCase
-	u := usecase.NewDeletePosting(tx, int64(postingID), tokenUserName, userRepo, postingRepo)
+	u := usecase.NewDeletePosting(tx, int64(postingID), tokenUserName, userRepo, postingRepo, postingImageRepo, postingTagRepo, postingCatRepo, bookmarkRepo, collectionPostingRepo, postingViewRepo, postingCounterRepo, userCounterRepo, objectDeletionRepo)
 	if err = u.DeletePostingUseCase(r.Context()); err != nil {
 		log.Println(err)
 		if err == usecase.ErrNotExistsData {
@@ -1,23 +1,36 @@
 package controller
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
+	"os"
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/aws"
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/classifier"
 	httpContext "github.com/gold-kou/ToeBeans/backend/app/adapter/http/context"
 	"github.com/gold-kou/ToeBeans/backend/app/adapter/http/helper"
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/application/usecase"
 
 	"github.com/gorilla/mux"
 
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+	modelHTTP "github.com/gold-kou/ToeBeans/backend/app/domain/model/http"
 	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
 
 	"github.com/gold-kou/ToeBeans/backend/app/lib"
+	"github.com/gold-kou/ToeBeans/backend/app/lib/imaging"
 	"github.com/gold-kou/ToeBeans/backend/testing/dummy"
 
 	testingHelper "github.com/gold-kou/ToeBeans/backend/testing"
@@ -27,7 +40,14 @@ import (
 var successReqRegisterPosting = `
 {
   "title": "This is a sample posting.",
-  "image": "UEsDBBQACAAIAP2aWVEAAAAAAAAAAAAAAAAKABAAZ29waGVyLnBuZ1VYDAB1fJVfPVKVX/UBFACNWmVYVFG3HsARJAdkRKREpIYSkG4YQAaBGRgapEOQ7u5OQaRhQIEhpEEcOlW6U1q6pFvu+N374/78zo8T+1l7nbX2fs9a7zp7R8FVFUkInxACAAASpVdQdex1GwDA4SS4j70bUOfRwF5wXNQVZQHlg7RbAMBTgBJUBun5Zm/ex9FgIpv1z8E3v+u8r2X4r45KvXRC/1R6arYFy7Ao8w2CnqF/Jpra3C9IUrFgkTO1IhcjprZOU5HhhDxaTVSyq6Z5P75frdEYpsTiVD0je/Rk5nmXzLN7lYzufY+Sqph17SSu3Yb+jtgwKs/7XTntN2+430ne3Rtv1Rreut47bthYIALJEjCG/lenVz/N8nHzw3NCVumobtq659q87La3iYntnl4m8NK1K9q/JS3kYSlBoSBLxZ2r/hL3pV5+Yjp8WH/Sao0HT74yHCXBS7svB8rCF6l9yg8aN4uRa6w34uPy5uelAk6YSuB/F1tasQPMti3jOzYucUEIcB6vtIHawgMvhjv3aMKhDs+O4HCV2qI7qN2vnb0XwqI6+R6usmwuToAlvNgqRm1hcWmJCi82dNIePa2dmXG2cueUKVHhjxdt5+3D9zEOtvQgcPIuAs48LSE8e9/akHjzXqUu0uiZrPH090A/MjvbGVYISzoCfgxd7n8QVi9NOU67uyWFoDm9EKW+UAXoy58+LwDhX6082SnQkJv5hLJc/udkarsHH4poMpubHYgvrLPYuTfgl9+hrigzYLyFhFEJ04MmkoJjAU1qCVDvt2vCAoQ2hP/8RB0CYA4Tk/aMgefqxlP5qPJE4br802BcGyHCXQglktNueVVcWs5gLRSwMDffIvkUwunOzDCe036OB5h7oPdcfYbAbFq3t8gdJohWPh6WHBaBs4qAxnS02XMm2b+h9v+Zfex5DfBVQCoju3PZ6pYdlYCn6XJm2VmfyF72c93vpFXQLjbo1qCQmR8LyBdcPz0bNUXwQuIW6jZDMK69FgzW3Hr90f77JVGEWe/OlzypQs5E2T5377vPL8kN0T7ZoQSLaiLO0C/HuSy6RSi5MSjuL9ukgeX27BY8W+8dtXn3bCAlKO4BRXzpUsy9x/Fvy2p01r/I8yv6rQ/rsIZ9KKvUFLqAr+AGbHBEo/bpaQ9WaHmCZWHcyE4USdk31mo8EUl0VIrhXzkEHbuuwLDn3X77ITcir5dWQrEgMcnAsXJI7NSe4iPQTe0uajZtX5/f6Md5FSGlelKCbyfm5mWIZhmGq6RYxm/7T4ORUCx3oJtM05bxY2I5QDjISi79CWpjeFrVcVfpXJQx3Zxwxzkin5oOYIu3FHGBXHzx+OrEsWGoTEO2EKg56pbTCQgGGFUdZrjJ/nIMjXxu/6gl1u0pOQwRkaQUGbLXX/T9ES8zWiKi58paG8SuSzzk4WC7RZGyJntVwhP24YN4PL47WfxpdK8zY51iwTcmRgZT4vMfGL/YWhTLo/gLEGFmMoX6a4WTVw0qPbjJtXkVg14ASnVZrVnaY0/36OjqvBjC6qp/gI9lKrLFYS3aOPxexR6rMsB9V1ZQTpFaN57XUi/eKfaAdxH/YY3n9bju66EwHIB9KlGcgIUXbW4ZkbQ0/Plzi83MnfWQRTq67z9r8morFt1PC2lpQkBekmlp3cseCmMtC7NNpAzNuyu1FRUsZuUQi82+tpp9lcWrVXxqOUKAxpgLtRFr1+sDtUFz39vpC5EszwCI2QDsvmIEWgEKgwft/pVbp/okLOivigzHzBIiHjAWvi0jT1hfEBVhh+j2VPcrslQHZ0w8G+0wRSKDSwXHxypTp23MNEj0tiH8k9BVp1DLTmpbLTZP1uwyFAyx72YW04pAKkRuldvaBc2t9epo7LtY+RqyKIfoGenHOztcSe33xpG8NWLqgtkbytm62M1O0ZT2LU9+iUkouyvqL5XjeNf8/NQmfW6to6ewTGE4VMfUT+MK5W+uoZrdW4mWhmrB8aFAJSohpKnG6qy04OSHAjRLl+SapJANR5Otf8tpUoyznuKyyG8mOnYaeuPKN9biOxHchgHy/L4Y93ZCR0EOO3fbsyfWJ12I1XVHfrwJe7z9Av4RIqfwS2E5tvUO3ywT0RITcc4dn2FqcWL0+fNZdEc3Yp0Pvr/yL1pJf8Db+PTk0cqTQrMng6ECrxUm7D/FlPSlCkkA2khShTbEj2Jsy5ZIrtQFxQHSQ0vTAGdfdQ9nj9GZYVz7ZsuHNpApf5+ZLzO2bUaPOrtbpp2xOiULTXxHws6pDHsZPlyiPWj+pH4fWYr6pCcEXbOFivxpz483c8Ek7SX/uhlW147iEBRY94+FYX5zJcjx1JnOfWgteBbPAXnW1xxEjHUimUh0Id2N6dnBlF45bMP6S2TeDE3yrGQyOUnRo3lPD+5jm9IQ4p33jhGxKpejwYSU30kqhS5XGur17KU1qA2HD9fLaPh5ixmCiktc40s5Pk3DHz1MefTb93UnzYaEaq5oqHAsqW5C2Af/D8ICZZqi31UETMZ9nBlawCubZfqX9JOdgbYlnxpqDZpM5AtHgHSKsvQFcyxx5KSfNLRe4TwMJuQkCzmX6Ygn5nh31O3kZydcIYL+Z3XnRhNscGTXPpMN399vcOPzwmkg/QOP3sf75eY2xzzzS7tOiOxse3RVS9GrjQFqsoWclBoQD16diqqsMYGrgy27Z5gQWCMEqz3sGqVzsG3S9sUt5iOZm7wClSttzllk72sPZbuuk2/W5ci3mZSvAxKrt2RU9DC56gDCfkMJ4umFyrSprXsc037SJY9HnzfOWYLH7MkwGRZ4d/t2XM28cXU4nkpIW5YJ7PdE02vaUN+bw1jUywiksHNRtoMiLUVJxUsVO/w5ZAl1pj+Vn/TnVakOjuDTeE/NbT+zPirxeVp+suPyJsugdj9rJSWF18DQIoCg2sRsSBQ3xE33lC3meZ/3eAr97GS1Q7EHl2eNM7c9OkqU5TPA0c/usSZGYINlez7cTJbwufckF9gJkf6u0/v5L0u3VziZwYSAhF+jtONMy6QApnw9asHLld4yY8u+gcB6G9u/mQ5IY+H00vrtF9vzH1VMPu/x9UbYyQADDed40teNcRCGeeKcI2IiiGShcMuKb/irH6NvzrZKlj66mXiWpbVRvysyZAS+I4UAdHip76YxkXasuxYN1aO/FvajCV0CWtN7DWindF5sV5o1NCbt/W+4RmpEWlYso73eQk7pO2vfuCnL/zrxG7BsrBW3mTFY4K7HF5joNLHA5PWkyy1M3LBk881s8rcKVXeosAQMNuIrTomHzsGwQodtOi6Ucg7vXavU/OGlruW/Bst800WwjMyyYXQxtRsX5pDD+SHdpSKPqcKE5WT5S77g1e0fI2+vcA/KngEKjim+oVLBdE9jVt0Z1VTtrhf0glu/bPLlqEHIdIUgVDUpQl4yozn8ZOFkqe+OiqelgayiRTL28jJMoEJofGp5NqF2pbTRPcP5haA3yU8ZgQ3xz+js3r4+x2M3/YKvGtjBgszrTl1YKgehMuTWs7g9byTEr8gW2xjim1Or7E2dCXnIWqJT8aUixGYdeqQkL59Pfintu8gxGN90AhztM2de+B2Zz6keHxMV1mnYyhMryh7NbsqVdW2gE1vTfpsk1hu5bf6wbbirkOIRv6i/EFrBJLwJ4vobBwhMxTfqtrpbcXkseqTXc74mu+472vnUrasKk631VVG0eq54pgnsr7+Bhtxzq0E/1c7sWzuhlFN807nov7fDHuGgpH9Dcxjgf6Zxs34q9OdoqfRiYMdhKYzhFpSytpaWltXxT26UVBYwqrrfR3zb617CyaM2R7/1410RH9VX569JFkiCEleS/Y4Q7/1fH158Bvz55bLYrTJn37xklXvjykq/twB8yttPJH6oVryQQaWL48Iv0ry17X7Vfp6jPEMvdbHgUrzQwjmjujFocfeh1vUmqQWtfRhD2I/8IJPiBFnNpfd5/XgU66ktae9nngNf629UD+Lj8zakJNKrB7nrkryzU/Zqu1vJse/aZnX0PxrLtrYpU7vUU3d0JZby3Bejtq0eWcKm81FF/29sGJTWVtPSXnD76sHyWru2vAV1hAuAOy23+SLGSGmLC1mNyYbujfxi8ycOjXV33m5Ze/2OxGao/ZAUoVJunkVzU3F3eZ1PAWjbvfHtmTIK2Nk8khoa4jG+U+axRLvnTOy99Hd/pmLR0diuQW2ET7F3IhVww4RIT0zPZNg1toUPwAvBf3GaDyfnsDqi8A5SpVRuu86LGAJcB4bu/13kYIdk27qJZUuKRVHl3PGw2+b63yCWqfRHD8IJW1/rTg6/ActTIamPk5c209DmM1StN2DfivN0Iv+zk9WEFf27m3mBuu9qza3rVqofS7Ixd8tVZXNM2ULAoo5qRtuq12D5xmVGo/bVb3TPa46PPX9HOaxkSvhUdrceBi/v2zxMDhtoNo8+wmU9evt8bvU9a0vUjqS3fM/ZK8XayNS4Lqp6ahqGXYSrhE1eEY8qMCQ/6YPlxsaxKJsLmH13SF/Qrn2g5Yt1grrJ36K1F1/nDw+gzPVJbkJ+ukGsk4nHxVTpR84u9CW+mrYmNMNUK0fhpXy4Dlpil0K1kTCY6nKuFjkG0yZd4JLeWumynpfWxGEV2KUxRmiiJWZ4PcIhz8xcVKkGk+Hzzo6XUmqeQ8PP/c+G5Jmpw/LD7uw4snkqtQVKs/dvurLzk5I0plkLUF4PZJpbd4hGI9y0697E19PYU6RYuAo++6qYHfno7Hr/4Ar3yfaaQc5HV82dNfXJB/lNDaR7K1e2AM6utRulgPNuboSSastNAU80jC/a+VXAydLbB60OG4u+SsikZNk5dqWzzU77sAB14LBZzCj9ScZLw6Umjq2/5TxL8WeZ8ha6l30+B9wNqWmxP6TWJB6aAYc0DjDnQwV1Y/O5F57tex/Mu7dYOWauptKc5MzSGYax4cC8nSPRo3Yxdt9BnAXyUJSO+11axuDPsI5WQEvzuRgw4hSr+PD32CLWjz2DICPpEvby1vtRyybI0f0qt53tygNWqXas8gb0y4LWpnN7Xx+H5VQ7eZE/+1kiMnQprmSFDge38Xt7H1wlC8HzfsnR3GjAn3bT5eYumJrkLdeu3/pW2VDImwlvkauWOx51b3WAcJ3xglZD+uPOrcqss+rG7CSW5zwYrqrf1Epb03/rxviFWYrvrodZjGgZ9K0GSdIj4pvCjfYWE/KEFjFLG+A1i4YWO46/J+WNnxd0yQD+TvX94/T5M7ZLkxh5hK5q0t7J/uHDNP0qj6l+d5i3Vwerhdx3CD5mTl8UKm/5/lizJC05httgCPEH+Lu4dt5Ro71I15kXg8p8ov6U1zAt1hp4kPzm57Y7TJRhrJ+geA95EUPYevEibNVlnYTqy8l6mUDA97kalvmGWdPvG1/e8pQWg5BbHK2AP4Nl+rP5SYbxg3oe/FuHlSx25TapYcL8diMD9pB7E5Tk+7tv3BgH/8TLN78dHfayCvhGl83vgGzD2dq4cUHUYpOkkOUv9bO/s8uvfsREduelR/HemPRwQDRGzaH8dUIWS4WxN9UWPvCBv4WiFM4LQR6uk1x+jcb1C9kuJsgOFgIO7Ixk4EltGEy7vCwzPhOa8NTpRMREJIY9xlKsntjmu+aIIgmgA1ftKINl08eBtb2VLLVPAIf+Bl5qxJ0aneGwk+dsk5cW9xWrbzzBzmaw/XaiUBSv5fYPNeitmmySduG45B8kIXuiTuxO7h/2W6AQ9QinLT+9VWH8DXQ3N/bxLODl3wZ0GfwnnoNPZ76zh3qAS0/IV6vjO7ZfG2pMvpb3XJIZDP4cZ5ymdmjOB1q7aWR4WD5abutLlvwFf9hdsIZAfCsY/P6yiW/tYQo47iaBFF+dqwxBenOVxVMRxiTxqOcbU2tXQo4fYfv7/jm4w13ynNNxdq7Ccb2U3Lh2cpVgChOjELa/gSZY0OKrdjkLU1Si3jcI/Rg55UTazzPLAxHhBZy485ht86MXS2Y7z6z5DnQzZIs6RbHkL3mqvTCuJaqZ7M7HRFrD6/dPh7z91QBmiHIxbKOhmIXTrdSkPH6oUcUb7mQ5v8dVb8l8XuH8s7/B0+B5R1CIBn2TyGrVzb2AfKrTlSWkPyOXz8HTv51+uT84sBlr3OyOPEQt4AGViqEnQkbQ0pSYR+3qnZ2QmGwJrn4rc1J/QzCLvtYpxUhBtvpBP8aF1Pq2n6CkMKHTY/85ve+Vj1eEa0Hr1S+jpRYx+46uq0zH+npNZEt4BI7QOPSzxIxE8n8o76VflRLFtr1D1WnUKrOAogrzjPJLHxIDXer39NO+eNt8z6yFPSjdihYDOcSJAI//GsvAXuEsfee54bC3a6TI7Li1/tMSXqVcz4TMuZ3nfCbu1FJnMxmH5eIN01TJkgHZsdaePpPJU7Rv+PoU/UqLKcZNAPry8pZPyGwfk13DDwSH9cg5LtrE6CadRHd7d2iNsC5wi172Oksn+4s8PX/ryHMzV/Hnj59HBzinu8fDP9ot7otwXQudE9e1Bj0/b3jnGL1nGn5m36r1UQ9RxjVPF3VPp2j8QHqAV24ymgKpP4T48pJaJSfzGDVjixp5qBjCGu9MpZjO4ZbaALJKS+B/DTVIi7cRpnNfG/dh+RZLcMN6jRAEGxzr3RO9MhKpPVMBbgasgVqOZujQwxTtJeI+xKlDasbjAfjrTem5pC16oaz1DC1iG8ERXsO/4z6N2KIUEj7N2n2+HRVjGHvBMAWK5mtq3VQ/1VOfS1DTDC75DOUpbY8uCsa+iTvgnLlhQ13q0I77o+JRzYtvIDn57oi7/ZzM4LtNNVDTyn5WZwblclTMuRo3xlh+Lj1hTvJE8/dODFkkJDr7zjxgNhG3TVSC9G594K1RalT3sz8Mtw8gHcn56qbk8sF6s9tWCuqkHtrXO9f74JTfXa1e/JPahTlyrJ3YKWU34mGm1YMjzQQJS3C3B809hDL0BbVW8/DvFJAfU3z2ryhzLq+olle2cocHTckNvFeo3hrxgPsrPbQpUL20E/m3LSVH4T3yzH2SC97k9eK3wm8uvhqt1TW4DhnmduQueD8U1AQEerpN0jxRHLjRUS2yj/S1uVcXOtGfEAWbnEbiiPsuZl/0WnksNdfwP6aWCpn55nVzERbQjLK//jJXadJ53+CXTkIc1US70U4Ia4xj+aTj+jnBpbIQ2n03R18lCtXjeg88zXQgDNYj1ufh7pyMeRjz+vxrzH3mbxGUAN8Nlzs+dhZLcW9mbVg/guFbgQhbCeOZCa8evDqTQobL4uW5xgIOIgjMhiYkmHoZA3vXfbr7lZLCBU05nohG6WS/bUEgTUu3Cm2hzF3mEODEWYpAY2iv/p/2gjl5i/pIrfFl1866fD5TvpRgvdHByXoI1WSYqNTC3FF6Vnkh4zp85YRYSVh/LjrdNqPq0DxANfMpoE3bkMiu24AP8yJis8Mg3OMz1bnWSn+hPq+uxcCC6TMFGc3+/EbCgzfuQjbr3yvtj9ggg8BUtYSLEltEzTKfZ+nOkrPPUmn5w5v60O5jjcjBIVP/o5eyn76TJxS0fFqSzWYYO2XIC4REBpeg4V7KK+MDPV2Ft9Hp5yO1LTg7GYKRC5jwCgxpy7YoUsBOVs6WZrDplOZ1Qo50bT4pkeYOr3Yd/Rh44GWXZhpMptkQCS9hKRU0q7bhL7czAMCn3Yq5v+IP6Dt0O/Zlu+ID8fO6n+MreURE+RqYtqv8jBSnHKeYWFIK8KJBkAEnGLfnXSeCVW7ycTngcDrZkUz/FFzmj20pFyfnJ7mVp+s9BWyUfbeChV2SsHsChdezUIF76gw0IvWvZNwovHLFeed/AkWsxliYMgg9cj2IwQq+J29FfjBsnAT7TLDhvyHykrcwB2JbnVvyiboELrbGG3VC8VrFdzo/Rgv3vsQTsCcV4l+aTJw4fqMW4Uac7TGmgI+v/gxNHG70Hr9PRHbFS3BrI+3UHi8HTHdgDu3bIaeB0sHupfyQL3J+7nIoCMDL5+MfYYVFEmzUpn1oE4WCQz/wyQfDIjseH8fA+hVkmKGhc62L2imo0Tp0qDkKBn+ASDdCGgsSQhhN641kzHzgPqTxHpE2BOxu9fgpIFbP/xSaZixzIb0GZ7mvWaDQhBkxtToJdJbLXMXrp9D/SCk6zszlTdMjSRXkjPIlM8+vZ+mR96evVOQjH2JbZbOCZmbz0CCkfgRlqPnPyVVkGMoeDAapbyMJIIyqcIycEdi+89WrjzZQoGW1ABryK/w9iLVSAB0qHJV97ceOtfPn4G3L2f2JdTvdUOGwZpk6L/1HjKomDvAHThQDm6CUtO5J1Gi4r2y1WR+e0ZtfD9GnW5sC4i3X+RZ1LcUx9abVvWdzo6Ck0e7kVsW9LrHhzhrGRzH7+rEmGxKNuGP55z/NveDnBw8u8swIlqiypHPs3sk7rp65OjB1PfqrcYsW93k3oTbzx5SYTit84iqvIr5lghEY2FvzHS7Fn7RCEjptdpu+WEjADvjzRZLqSr3J+IlyCOYuF88QoOZTsxej2pK0zyzLucb3DC1xe30CjA2wvFuQVrzC/cZJAnmMm/7GPcxqRDh7myYWZCuQJUb4KMace2U6/Zs0fV/wVc0Grh2AqvyxplouKAVkipmN8oAyA1ZzvZzU2pL4qT4CNfmH2UtwAIuXvP/+JTpTkzdsFEItAIBcjsZVDPwjECwdgQYAdDhxjVwVkAqRbyFUrFBmBtMZuqmwaj607sHOqTo8Ks6XKDk/KQULempO4u1ZDb4tz2c1+RSyrz9978xPAo/mdif+TEKtiy6fQQdjYATs7B/vTRRZSe0yh5ZZKME9xB8/Bk2A8gk9dJoFKJSxaEt+zF2BNgda/mzRJgrpDZcHK8VYxP3T9/BMKwom2cX7HPK+vRrlbCyNTH5pOMIJYex6WZPChTXhLIrQvJITzRn+BkGS2PCLq9wLahGaZjmon+CoIpgNJOLqieFEi7kDg2l3Z14LGJwvuY7FpcMIqrqmw90RD9q+NAb+qkbhthXRE+/emz4ufrOKwW/n2O/JF4u4xGFkST+eXA1R5p//EShRtVoa1EIMVNf1j8AHTLcKDZMfa/71+6V/qQSI9C5xxGwpELIjVOU9KeKsFgLFkoQtVgmHbj383NdH6Bpx2/sPPvR9ROFK/ytirZuMkIqRMCzLfvcztkDOSPaVPxsWuwBgCggfhaJYAdQDNRO49KpPoxNNjfXhHnatXUkPu0Mp8eawI4UXhgV9HooiSWKu0JYSKUvbto+iB4FBsrtkEMbBdhBlSlpYMOxVvTBZNKzf1AV+GYgOBpn6YJFPSsrFVsIb80Wm7piM9bl2P9uISEl2gDDjtxW9X/XzrvX3JwalshINJH615L0UEp++jHiYxDRXT4YriO8WxezxhPWzdL07BHJPgA3ytMtd0N4dQEkA+XqZGZ1YNt0wgwN/iu5fd4DIVpta4+VT8M/QI7uoT9FQL975BdLuM9Qol27kQ3FnZRf4f2IDxlX5kd4lM6Tm1vk19inUWzOfE/xIHPwA8rWZkUBJ0WxGam6K0+bexDpYQJYviu+aStzOa0kFIhFiZoCUiyRRwA7eTf6zmuKRTyzTkias5iTA8OZAdZmCRgnQqePgfaiAFpwV/VKNmTDa1uYE3duPwEFZOb57Ih2HjgfFEHt8/VobFV2GUk0gBBBfQ5lDdcLk4hYo4Zzokn58AqUXV1CwFgKC3v/xDOsCmAkyHwQtRnHvXfspYSduRng8I5wdjAQsGcMEQOya28Q+ON1afsnWp6nTr6PmX3GwT33CRU0YmfJgMRk18YEYshHuTCIPi6AnPY+yKSs0h6dqzVkf8FTRdx4rx2TAEHxoQFv7FVQg9BWV9nGDhjMve7GVIyeBcQ3WViVS169fXSOjd1HvsLbScj4tYYQ+kZtfVdmiB6PepOgozGDNblIEorWZHyEahIC0w59wx0spxtjnM3FJNxE4gby7lBOgJB5SucvVPnIZP2v9PslhL03T++EbmhgYC53iPTDA9MkOBVbmUyI9KccithuhzcoMw80DBB3jjCmxIWRfFtZNHqPEcUocT4IzDSlhgi4V7J99VEW8h9COfWJxemwIkM0XleUj2HrvmIp+xRxPtP1vSbENBJogT4pPDm9SiieCAFwdbLeA89Vhv4Pwhriz5R0pFe+DAR/DuTuxXbWE/zj6xDBpeWm7vPsHd3PRwirVYd8UcyudhFC6Gs/aDLMQwueqM46P6VCVSf2ytOGr6wUcIqJoaVOnqBARZ2E5TvKVF6JZlddsD79nK6pY/VtVw7vLQ8d/+JBPwA6HygDZyn7jyj9UR/0IJoTrPAh8RsQOD8WWkVakcW7O8ZLDKk3KiJEwSrZRbCjYbx8RvG8HoJxU56Md07FawY1fWbnQXHSlt1988Vmxw3+4MFL9MIn+gQel2augZ/sI7VkJ4cm+wIqo7Uj+hlgCbLwEAHhTqVwWMKyYuSl8vta+GdgzbJkv9cZ3i6YGvHOXw4/EsivoGlQmbuw7XMIP10KjXbOiktzxnruvwHDqip183mYIJsDhKcCYtY0nrZR7/JLXaUQANVxSYelPzMV6tUw4RAiZORajJ3Z9EE1bbB1ytZIj/UXtu2TgYk4xt8FYLP+AkLox+HfMoPHtdYT6CSqd0konxp2XPTMRN1DoZXzcwQ0lNk85UpMjRKp+XPG3dls43FXQyhECboJyA1jhrvqwmGrF28sFaR68Ia/DyB36rJnx+Jq6L1iqnZRxyfsy+0mojz0MmYFDxBhejw1vMogCjQYoULUmwAEVEnQXg+YGW3YhvLgjscKhHE9AE33yDoxJF/n80y/cCeJYyTrBhqCUmAtUldud3V3gKjdIrWjTe/zWBw9vhtirhnfK8fIwmcbeNtI2xwbB9XPxn4WYEab86iyunmmMjzuQ1uftHExeQPF2xiVIUBebW6OjzrmbndEJAb+n7/z9t7QKXB86fqOe2AUAcKb/7QX4piAYy+A/EIv1X00xE93DHg1BZwTpIVT2PeMkxQ7bJlqv/0p7+CgrevgQrb4ox+vkfiFONnv5IcaEVLyvXZJhbJoZW8z+na1aDNA+KfTl6oG/vPZzQwQlP35SKCCHTGGQ6zwC2bztPAfit//DT9xb/0EvmrZZwAUCc85A+n+L7WlBxud2A3jFhB7Yef6KHSu8JbLP3hIOWX4tOuHN0k6DiOvPAZvArEyffyQqoZ2MygFvo06ZsRw4fN/K6AVIdqXP5smSHK1eIjXBdlV3nq+9V5K9GWx/GgcQREd2/z40RVXdFYvq3AMSVz4U1RJQsuR9mLDckkYA1SZYc91W5AcARU2HDTY21NxpRkqZHlYm96RBgDbQvQ0ZgHEsyaFwZvwWQUbdod9dTiCDKAbgunY2U0UOwAsPX5C6hqGswxs5wBbwZAo5iCvOZv9eZkacY/iCI6UfhDTXG8sCyy6XnROy31RPNS5Z6fnqddsKBZwZoOKcXAM7W7eVOd4CaGU6w/JMSTZg8nTjKdW3S+TfpXmz2Vj/uw0S//+kenSHgxtl9zoruCQTgD2U5FWh5bLGwf8DUEsHCET0D3PEIQAAKiIAAFBLAwQKAAAAAAAZs1lRAAAAAAAAAAAAAAAACQAQAF9fTUFDT1NYL1VYDAChfJVfoXyVX/UBFABQSwMEFAAIAAgA/ZpZUQAAAAAAAAAAAAAAABUAEABfX01BQ09TWC8uX2dvcGhlci5wbmdVWAwAdXyVXz1SlV/1ARQAjZHNihNBEMdrJoi7eNmLgrcgJqDQPT3T3ZmZjYMM2Q2ILIJm0VuoTHomo5nvDll8AY++gvhWPoGv4NHOJkFcPFh0VXUXv4J/VcO9R/fBBrjCpP/mXf9D/2C7Gpwa9wDsa5PN2/4C/2XxbPZ2f7vt+AVgfb2D9PZ1eA7wNKkKinW9VrRQGpeo8Xz+6erilVbFdafaGWbdrsV6YvwZwOAf/AF/v1KtmrZVsWuwX5vwAuDhH77ZYIulzksFi3qdd5qxbyd7QZZ1UGbdUXp6JL9b9vzsx0rrujt3nCbPNZKN0UeSqtSq1B3Niyy/oaXSzi014PHAm5qzZ/MCM0U6XbWKdpxigZ+rErcdNfoMxIy7IpSeyVxwEWKKJHCDkHCejAgLfUaYJ9B3U8UTTGldZi/zm3W+iNoFcalHvSFudBWlVVugHmZ5SppoxIZN5MthF6kEA84FU6EvJAtEwlGO3CCQbLlMRqGYnz0+zrbdbmlWVZnZWFLRj7UDJ/AAfh6+9Lig3t97snqNw1jgjmUaSmmEjicr8xNqLOLLqe/HgrjCBBHzCYldM8zlVMp4wieue8HgN1BLBwiDtX90sQEAAIcCAABQSwECFQMUAAgACAD9mllRRPQPc8QhAAAqIgAACgAMAAAAAAAAAABApIEAAAAAZ29waGVyLnBuZ1VYCAB1fJVfPVKVX1BLAQIVAwoAAAAAABmzWVEAAAAAAAAAAAAAAAAJAAwAAAAAAAAAAED9QQwiAABfX01BQ09TWC9VWAgAoXyVX6F8lV9QSwECFQMUAAgACAD9mllRg7V/dLEBAACHAgAAFQAMAAAAAAAAAABApIFDIgAAX19NQUNPU1gvLl9nb3BoZXIucG5nVVgIAHV8lV89UpVfUEsFBgAAAAADAAMA1gAAAEckAAAAAA=="
+  "image": "iVBORw0KGgoAAAANSUhEUgAAAAIAAAACCAYAAABytg0kAAAAH0lEQVR4nAASAO3/Av8AAP8AAAAAAAAAAAAAAAAAAwAfFwIBJnyuSgAAAABJRU5ErkJggg=="
+}
+`
+var successReqRegisterPostingAltText = `
+{
+  "title": "This is a sample posting.",
+  "alt_text": "A tabby cat sleeping on the sofa",
+  "image": "iVBORw0KGgoAAAANSUhEUgAAAAIAAAACCAYAAABytg0kAAAAH0lEQVR4nAASAO3/Av8AAP8AAAAAAAAAAAAAAAAAAwAfFwIBJnyuSgAAAABJRU5ErkJggg=="
 }
 `
 var errReqRegisterPostingWithoutImage = `
@@ -35,21 +55,41 @@ var errReqRegisterPostingWithoutImage = `
   "title": "This is a sample posting."
 }
 `
+var errReqRegisterPostingUploadOfOtherUser = `
+{
+  "title": "This is a sample posting.",
+  "upload_keys": ["uploads/2/2b6e4a3c-1f55-4c0e-9a3c-6c1d4b8f0e21"]
+}
+`
+var errReqRegisterPostingUploadKeysWithImage = `
+{
+  "title": "This is a sample posting.",
+  "image": "iVBORw0KGgoAAAANSUhEUgAAAAIAAAACCAYAAABytg0kAAAAH0lEQVR4nAASAO3/Av8AAP8AAAAAAAAAAAAAAAAAAwAfFwIBJnyuSgAAAABJRU5ErkJggg==",
+  "upload_keys": ["uploads/1/2b6e4a3c-1f55-4c0e-9a3c-6c1d4b8f0e21"]
+}
+`
 var errReqRegisterPostingWithoutTitle = `
 {
-  "image": "UEsDBBQACAAIAP2aWVEAAAAAAAAAAAAAAAAKABAAZ29waGVyLnBuZ1VYDAB1fJVfPVKVX/UBFACNWmVYVFG3HsARJAdkRKREpIYSkG4YQAaBGRgapEOQ7u5OQaRhQIEhpEEcOlW6U1q6pFvu+N374/78zo8T+1l7nbX2fs9a7zp7R8FVFUkInxACAAASpVdQdex1GwDA4SS4j70bUOfRwF5wXNQVZQHlg7RbAMBTgBJUBun5Zm/ex9FgIpv1z8E3v+u8r2X4r45KvXRC/1R6arYFy7Ao8w2CnqF/Jpra3C9IUrFgkTO1IhcjprZOU5HhhDxaTVSyq6Z5P75frdEYpsTiVD0je/Rk5nmXzLN7lYzufY+Sqph17SSu3Yb+jtgwKs/7XTntN2+430ne3Rtv1Rreut47bthYIALJEjCG/lenVz/N8nHzw3NCVumobtq659q87La3iYntnl4m8NK1K9q/JS3kYSlBoSBLxZ2r/hL3pV5+Yjp8WH/Sao0HT74yHCXBS7svB8rCF6l9yg8aN4uRa6w34uPy5uelAk6YSuB/F1tasQPMti3jOzYucUEIcB6vtIHawgMvhjv3aMKhDs+O4HCV2qI7qN2vnb0XwqI6+R6usmwuToAlvNgqRm1hcWmJCi82dNIePa2dmXG2cueUKVHhjxdt5+3D9zEOtvQgcPIuAs48LSE8e9/akHjzXqUu0uiZrPH090A/MjvbGVYISzoCfgxd7n8QVi9NOU67uyWFoDm9EKW+UAXoy58+LwDhX6082SnQkJv5hLJc/udkarsHH4poMpubHYgvrLPYuTfgl9+hrigzYLyFhFEJ04MmkoJjAU1qCVDvt2vCAoQ2hP/8RB0CYA4Tk/aMgefqxlP5qPJE4br802BcGyHCXQglktNueVVcWs5gLRSwMDffIvkUwunOzDCe036OB5h7oPdcfYbAbFq3t8gdJohWPh6WHBaBs4qAxnS02XMm2b+h9v+Zfex5DfBVQCoju3PZ6pYdlYCn6XJm2VmfyF72c93vpFXQLjbo1qCQmR8LyBdcPz0bNUXwQuIW6jZDMK69FgzW3Hr90f77JVGEWe/OlzypQs5E2T5377vPL8kN0T7ZoQSLaiLO0C/HuSy6RSi5MSjuL9ukgeX27BY8W+8dtXn3bCAlKO4BRXzpUsy9x/Fvy2p01r/I8yv6rQ/rsIZ9KKvUFLqAr+AGbHBEo/bpaQ9WaHmCZWHcyE4USdk31mo8EUl0VIrhXzkEHbuuwLDn3X77ITcir5dWQrEgMcnAsXJI7NSe4iPQTe0uajZtX5/f6Md5FSGlelKCbyfm5mWIZhmGq6RYxm/7T4ORUCx3oJtM05bxY2I5QDjISi79CWpjeFrVcVfpXJQx3Zxwxzkin5oOYIu3FHGBXHzx+OrEsWGoTEO2EKg56pbTCQgGGFUdZrjJ/nIMjXxu/6gl1u0pOQwRkaQUGbLXX/T9ES8zWiKi58paG8SuSzzk4WC7RZGyJntVwhP24YN4PL47WfxpdK8zY51iwTcmRgZT4vMfGL/YWhTLo/gLEGFmMoX6a4WTVw0qPbjJtXkVg14ASnVZrVnaY0/36OjqvBjC6qp/gI9lKrLFYS3aOPxexR6rMsB9V1ZQTpFaN57XUi/eKfaAdxH/YY3n9bju66EwHIB9KlGcgIUXbW4ZkbQ0/Plzi83MnfWQRTq67z9r8morFt1PC2lpQkBekmlp3cseCmMtC7NNpAzNuyu1FRUsZuUQi82+tpp9lcWrVXxqOUKAxpgLtRFr1+sDtUFz39vpC5EszwCI2QDsvmIEWgEKgwft/pVbp/okLOivigzHzBIiHjAWvi0jT1hfEBVhh+j2VPcrslQHZ0w8G+0wRSKDSwXHxypTp23MNEj0tiH8k9BVp1DLTmpbLTZP1uwyFAyx72YW04pAKkRuldvaBc2t9epo7LtY+RqyKIfoGenHOztcSe33xpG8NWLqgtkbytm62M1O0ZT2LU9+iUkouyvqL5XjeNf8/NQmfW6to6ewTGE4VMfUT+MK5W+uoZrdW4mWhmrB8aFAJSohpKnG6qy04OSHAjRLl+SapJANR5Otf8tpUoyznuKyyG8mOnYaeuPKN9biOxHchgHy/L4Y93ZCR0EOO3fbsyfWJ12I1XVHfrwJe7z9Av4RIqfwS2E5tvUO3ywT0RITcc4dn2FqcWL0+fNZdEc3Yp0Pvr/yL1pJf8Db+PTk0cqTQrMng6ECrxUm7D/FlPSlCkkA2khShTbEj2Jsy5ZIrtQFxQHSQ0vTAGdfdQ9nj9GZYVz7ZsuHNpApf5+ZLzO2bUaPOrtbpp2xOiULTXxHws6pDHsZPlyiPWj+pH4fWYr6pCcEXbOFivxpz483c8Ek7SX/uhlW147iEBRY94+FYX5zJcjx1JnOfWgteBbPAXnW1xxEjHUimUh0Id2N6dnBlF45bMP6S2TeDE3yrGQyOUnRo3lPD+5jm9IQ4p33jhGxKpejwYSU30kqhS5XGur17KU1qA2HD9fLaPh5ixmCiktc40s5Pk3DHz1MefTb93UnzYaEaq5oqHAsqW5C2Af/D8ICZZqi31UETMZ9nBlawCubZfqX9JOdgbYlnxpqDZpM5AtHgHSKsvQFcyxx5KSfNLRe4TwMJuQkCzmX6Ygn5nh31O3kZydcIYL+Z3XnRhNscGTXPpMN399vcOPzwmkg/QOP3sf75eY2xzzzS7tOiOxse3RVS9GrjQFqsoWclBoQD16diqqsMYGrgy27Z5gQWCMEqz3sGqVzsG3S9sUt5iOZm7wClSttzllk72sPZbuuk2/W5ci3mZSvAxKrt2RU9DC56gDCfkMJ4umFyrSprXsc037SJY9HnzfOWYLH7MkwGRZ4d/t2XM28cXU4nkpIW5YJ7PdE02vaUN+bw1jUywiksHNRtoMiLUVJxUsVO/w5ZAl1pj+Vn/TnVakOjuDTeE/NbT+zPirxeVp+suPyJsugdj9rJSWF18DQIoCg2sRsSBQ3xE33lC3meZ/3eAr97GS1Q7EHl2eNM7c9OkqU5TPA0c/usSZGYINlez7cTJbwufckF9gJkf6u0/v5L0u3VziZwYSAhF+jtONMy6QApnw9asHLld4yY8u+gcB6G9u/mQ5IY+H00vrtF9vzH1VMPu/x9UbYyQADDed40teNcRCGeeKcI2IiiGShcMuKb/irH6NvzrZKlj66mXiWpbVRvysyZAS+I4UAdHip76YxkXasuxYN1aO/FvajCV0CWtN7DWindF5sV5o1NCbt/W+4RmpEWlYso73eQk7pO2vfuCnL/zrxG7BsrBW3mTFY4K7HF5joNLHA5PWkyy1M3LBk881s8rcKVXeosAQMNuIrTomHzsGwQodtOi6Ucg7vXavU/OGlruW/Bst800WwjMyyYXQxtRsX5pDD+SHdpSKPqcKE5WT5S77g1e0fI2+vcA/KngEKjim+oVLBdE9jVt0Z1VTtrhf0glu/bPLlqEHIdIUgVDUpQl4yozn8ZOFkqe+OiqelgayiRTL28jJMoEJofGp5NqF2pbTRPcP5haA3yU8ZgQ3xz+js3r4+x2M3/YKvGtjBgszrTl1YKgehMuTWs7g9byTEr8gW2xjim1Or7E2dCXnIWqJT8aUixGYdeqQkL59Pfintu8gxGN90AhztM2de+B2Zz6keHxMV1mnYyhMryh7NbsqVdW2gE1vTfpsk1hu5bf6wbbirkOIRv6i/EFrBJLwJ4vobBwhMxTfqtrpbcXkseqTXc74mu+472vnUrasKk631VVG0eq54pgnsr7+Bhtxzq0E/1c7sWzuhlFN807nov7fDHuGgpH9Dcxjgf6Zxs34q9OdoqfRiYMdhKYzhFpSytpaWltXxT26UVBYwqrrfR3zb617CyaM2R7/1410RH9VX569JFkiCEleS/Y4Q7/1fH158Bvz55bLYrTJn37xklXvjykq/twB8yttPJH6oVryQQaWL48Iv0ry17X7Vfp6jPEMvdbHgUrzQwjmjujFocfeh1vUmqQWtfRhD2I/8IJPiBFnNpfd5/XgU66ktae9nngNf629UD+Lj8zakJNKrB7nrkryzU/Zqu1vJse/aZnX0PxrLtrYpU7vUU3d0JZby3Bejtq0eWcKm81FF/29sGJTWVtPSXnD76sHyWru2vAV1hAuAOy23+SLGSGmLC1mNyYbujfxi8ycOjXV33m5Ze/2OxGao/ZAUoVJunkVzU3F3eZ1PAWjbvfHtmTIK2Nk8khoa4jG+U+axRLvnTOy99Hd/pmLR0diuQW2ET7F3IhVww4RIT0zPZNg1toUPwAvBf3GaDyfnsDqi8A5SpVRuu86LGAJcB4bu/13kYIdk27qJZUuKRVHl3PGw2+b63yCWqfRHD8IJW1/rTg6/ActTIamPk5c209DmM1StN2DfivN0Iv+zk9WEFf27m3mBuu9qza3rVqofS7Ixd8tVZXNM2ULAoo5qRtuq12D5xmVGo/bVb3TPa46PPX9HOaxkSvhUdrceBi/v2zxMDhtoNo8+wmU9evt8bvU9a0vUjqS3fM/ZK8XayNS4Lqp6ahqGXYSrhE1eEY8qMCQ/6YPlxsaxKJsLmH13SF/Qrn2g5Yt1grrJ36K1F1/nDw+gzPVJbkJ+ukGsk4nHxVTpR84u9CW+mrYmNMNUK0fhpXy4Dlpil0K1kTCY6nKuFjkG0yZd4JLeWumynpfWxGEV2KUxRmiiJWZ4PcIhz8xcVKkGk+Hzzo6XUmqeQ8PP/c+G5Jmpw/LD7uw4snkqtQVKs/dvurLzk5I0plkLUF4PZJpbd4hGI9y0697E19PYU6RYuAo++6qYHfno7Hr/4Ar3yfaaQc5HV82dNfXJB/lNDaR7K1e2AM6utRulgPNuboSSastNAU80jC/a+VXAydLbB60OG4u+SsikZNk5dqWzzU77sAB14LBZzCj9ScZLw6Umjq2/5TxL8WeZ8ha6l30+B9wNqWmxP6TWJB6aAYc0DjDnQwV1Y/O5F57tex/Mu7dYOWauptKc5MzSGYax4cC8nSPRo3Yxdt9BnAXyUJSO+11axuDPsI5WQEvzuRgw4hSr+PD32CLWjz2DICPpEvby1vtRyybI0f0qt53tygNWqXas8gb0y4LWpnN7Xx+H5VQ7eZE/+1kiMnQprmSFDge38Xt7H1wlC8HzfsnR3GjAn3bT5eYumJrkLdeu3/pW2VDImwlvkauWOx51b3WAcJ3xglZD+uPOrcqss+rG7CSW5zwYrqrf1Epb03/rxviFWYrvrodZjGgZ9K0GSdIj4pvCjfYWE/KEFjFLG+A1i4YWO46/J+WNnxd0yQD+TvX94/T5M7ZLkxh5hK5q0t7J/uHDNP0qj6l+d5i3Vwerhdx3CD5mTl8UKm/5/lizJC05httgCPEH+Lu4dt5Ro71I15kXg8p8ov6U1zAt1hp4kPzm57Y7TJRhrJ+geA95EUPYevEibNVlnYTqy8l6mUDA97kalvmGWdPvG1/e8pQWg5BbHK2AP4Nl+rP5SYbxg3oe/FuHlSx25TapYcL8diMD9pB7E5Tk+7tv3BgH/8TLN78dHfayCvhGl83vgGzD2dq4cUHUYpOkkOUv9bO/s8uvfsREduelR/HemPRwQDRGzaH8dUIWS4WxN9UWPvCBv4WiFM4LQR6uk1x+jcb1C9kuJsgOFgIO7Ixk4EltGEy7vCwzPhOa8NTpRMREJIY9xlKsntjmu+aIIgmgA1ftKINl08eBtb2VLLVPAIf+Bl5qxJ0aneGwk+dsk5cW9xWrbzzBzmaw/XaiUBSv5fYPNeitmmySduG45B8kIXuiTuxO7h/2W6AQ9QinLT+9VWH8DXQ3N/bxLODl3wZ0GfwnnoNPZ76zh3qAS0/IV6vjO7ZfG2pMvpb3XJIZDP4cZ5ymdmjOB1q7aWR4WD5abutLlvwFf9hdsIZAfCsY/P6yiW/tYQo47iaBFF+dqwxBenOVxVMRxiTxqOcbU2tXQo4fYfv7/jm4w13ynNNxdq7Ccb2U3Lh2cpVgChOjELa/gSZY0OKrdjkLU1Si3jcI/Rg55UTazzPLAxHhBZy485ht86MXS2Y7z6z5DnQzZIs6RbHkL3mqvTCuJaqZ7M7HRFrD6/dPh7z91QBmiHIxbKOhmIXTrdSkPH6oUcUb7mQ5v8dVb8l8XuH8s7/B0+B5R1CIBn2TyGrVzb2AfKrTlSWkPyOXz8HTv51+uT84sBlr3OyOPEQt4AGViqEnQkbQ0pSYR+3qnZ2QmGwJrn4rc1J/QzCLvtYpxUhBtvpBP8aF1Pq2n6CkMKHTY/85ve+Vj1eEa0Hr1S+jpRYx+46uq0zH+npNZEt4BI7QOPSzxIxE8n8o76VflRLFtr1D1WnUKrOAogrzjPJLHxIDXer39NO+eNt8z6yFPSjdihYDOcSJAI//GsvAXuEsfee54bC3a6TI7Li1/tMSXqVcz4TMuZ3nfCbu1FJnMxmH5eIN01TJkgHZsdaePpPJU7Rv+PoU/UqLKcZNAPry8pZPyGwfk13DDwSH9cg5LtrE6CadRHd7d2iNsC5wi172Oksn+4s8PX/ryHMzV/Hnj59HBzinu8fDP9ot7otwXQudE9e1Bj0/b3jnGL1nGn5m36r1UQ9RxjVPF3VPp2j8QHqAV24ymgKpP4T48pJaJSfzGDVjixp5qBjCGu9MpZjO4ZbaALJKS+B/DTVIi7cRpnNfG/dh+RZLcMN6jRAEGxzr3RO9MhKpPVMBbgasgVqOZujQwxTtJeI+xKlDasbjAfjrTem5pC16oaz1DC1iG8ERXsO/4z6N2KIUEj7N2n2+HRVjGHvBMAWK5mtq3VQ/1VOfS1DTDC75DOUpbY8uCsa+iTvgnLlhQ13q0I77o+JRzYtvIDn57oi7/ZzM4LtNNVDTyn5WZwblclTMuRo3xlh+Lj1hTvJE8/dODFkkJDr7zjxgNhG3TVSC9G594K1RalT3sz8Mtw8gHcn56qbk8sF6s9tWCuqkHtrXO9f74JTfXa1e/JPahTlyrJ3YKWU34mGm1YMjzQQJS3C3B809hDL0BbVW8/DvFJAfU3z2ryhzLq+olle2cocHTckNvFeo3hrxgPsrPbQpUL20E/m3LSVH4T3yzH2SC97k9eK3wm8uvhqt1TW4DhnmduQueD8U1AQEerpN0jxRHLjRUS2yj/S1uVcXOtGfEAWbnEbiiPsuZl/0WnksNdfwP6aWCpn55nVzERbQjLK//jJXadJ53+CXTkIc1US70U4Ia4xj+aTj+jnBpbIQ2n03R18lCtXjeg88zXQgDNYj1ufh7pyMeRjz+vxrzH3mbxGUAN8Nlzs+dhZLcW9mbVg/guFbgQhbCeOZCa8evDqTQobL4uW5xgIOIgjMhiYkmHoZA3vXfbr7lZLCBU05nohG6WS/bUEgTUu3Cm2hzF3mEODEWYpAY2iv/p/2gjl5i/pIrfFl1866fD5TvpRgvdHByXoI1WSYqNTC3FF6Vnkh4zp85YRYSVh/LjrdNqPq0DxANfMpoE3bkMiu24AP8yJis8Mg3OMz1bnWSn+hPq+uxcCC6TMFGc3+/EbCgzfuQjbr3yvtj9ggg8BUtYSLEltEzTKfZ+nOkrPPUmn5w5v60O5jjcjBIVP/o5eyn76TJxS0fFqSzWYYO2XIC4REBpeg4V7KK+MDPV2Ft9Hp5yO1LTg7GYKRC5jwCgxpy7YoUsBOVs6WZrDplOZ1Qo50bT4pkeYOr3Yd/Rh44GWXZhpMptkQCS9hKRU0q7bhL7czAMCn3Yq5v+IP6Dt0O/Zlu+ID8fO6n+MreURE+RqYtqv8jBSnHKeYWFIK8KJBkAEnGLfnXSeCVW7ycTngcDrZkUz/FFzmj20pFyfnJ7mVp+s9BWyUfbeChV2SsHsChdezUIF76gw0IvWvZNwovHLFeed/AkWsxliYMgg9cj2IwQq+J29FfjBsnAT7TLDhvyHykrcwB2JbnVvyiboELrbGG3VC8VrFdzo/Rgv3vsQTsCcV4l+aTJw4fqMW4Uac7TGmgI+v/gxNHG70Hr9PRHbFS3BrI+3UHi8HTHdgDu3bIaeB0sHupfyQL3J+7nIoCMDL5+MfYYVFEmzUpn1oE4WCQz/wyQfDIjseH8fA+hVkmKGhc62L2imo0Tp0qDkKBn+ASDdCGgsSQhhN641kzHzgPqTxHpE2BOxu9fgpIFbP/xSaZixzIb0GZ7mvWaDQhBkxtToJdJbLXMXrp9D/SCk6zszlTdMjSRXkjPIlM8+vZ+mR96evVOQjH2JbZbOCZmbz0CCkfgRlqPnPyVVkGMoeDAapbyMJIIyqcIycEdi+89WrjzZQoGW1ABryK/w9iLVSAB0qHJV97ceOtfPn4G3L2f2JdTvdUOGwZpk6L/1HjKomDvAHThQDm6CUtO5J1Gi4r2y1WR+e0ZtfD9GnW5sC4i3X+RZ1LcUx9abVvWdzo6Ck0e7kVsW9LrHhzhrGRzH7+rEmGxKNuGP55z/NveDnBw8u8swIlqiypHPs3sk7rp65OjB1PfqrcYsW93k3oTbzx5SYTit84iqvIr5lghEY2FvzHS7Fn7RCEjptdpu+WEjADvjzRZLqSr3J+IlyCOYuF88QoOZTsxej2pK0zyzLucb3DC1xe30CjA2wvFuQVrzC/cZJAnmMm/7GPcxqRDh7myYWZCuQJUb4KMace2U6/Zs0fV/wVc0Grh2AqvyxplouKAVkipmN8oAyA1ZzvZzU2pL4qT4CNfmH2UtwAIuXvP/+JTpTkzdsFEItAIBcjsZVDPwjECwdgQYAdDhxjVwVkAqRbyFUrFBmBtMZuqmwaj607sHOqTo8Ks6XKDk/KQULempO4u1ZDb4tz2c1+RSyrz9978xPAo/mdif+TEKtiy6fQQdjYATs7B/vTRRZSe0yh5ZZKME9xB8/Bk2A8gk9dJoFKJSxaEt+zF2BNgda/mzRJgrpDZcHK8VYxP3T9/BMKwom2cX7HPK+vRrlbCyNTH5pOMIJYex6WZPChTXhLIrQvJITzRn+BkGS2PCLq9wLahGaZjmon+CoIpgNJOLqieFEi7kDg2l3Z14LGJwvuY7FpcMIqrqmw90RD9q+NAb+qkbhthXRE+/emz4ufrOKwW/n2O/JF4u4xGFkST+eXA1R5p//EShRtVoa1EIMVNf1j8AHTLcKDZMfa/71+6V/qQSI9C5xxGwpELIjVOU9KeKsFgLFkoQtVgmHbj383NdH6Bpx2/sPPvR9ROFK/ytirZuMkIqRMCzLfvcztkDOSPaVPxsWuwBgCggfhaJYAdQDNRO49KpPoxNNjfXhHnatXUkPu0Mp8eawI4UXhgV9HooiSWKu0JYSKUvbto+iB4FBsrtkEMbBdhBlSlpYMOxVvTBZNKzf1AV+GYgOBpn6YJFPSsrFVsIb80Wm7piM9bl2P9uISEl2gDDjtxW9X/XzrvX3JwalshINJH615L0UEp++jHiYxDRXT4YriO8WxezxhPWzdL07BHJPgA3ytMtd0N4dQEkA+XqZGZ1YNt0wgwN/iu5fd4DIVpta4+VT8M/QI7uoT9FQL975BdLuM9Qol27kQ3FnZRf4f2IDxlX5kd4lM6Tm1vk19inUWzOfE/xIHPwA8rWZkUBJ0WxGam6K0+bexDpYQJYviu+aStzOa0kFIhFiZoCUiyRRwA7eTf6zmuKRTyzTkias5iTA8OZAdZmCRgnQqePgfaiAFpwV/VKNmTDa1uYE3duPwEFZOb57Ih2HjgfFEHt8/VobFV2GUk0gBBBfQ5lDdcLk4hYo4Zzokn58AqUXV1CwFgKC3v/xDOsCmAkyHwQtRnHvXfspYSduRng8I5wdjAQsGcMEQOya28Q+ON1afsnWp6nTr6PmX3GwT33CRU0YmfJgMRk18YEYshHuTCIPi6AnPY+yKSs0h6dqzVkf8FTRdx4rx2TAEHxoQFv7FVQg9BWV9nGDhjMve7GVIyeBcQ3WViVS169fXSOjd1HvsLbScj4tYYQ+kZtfVdmiB6PepOgozGDNblIEorWZHyEahIC0w59wx0spxtjnM3FJNxE4gby7lBOgJB5SucvVPnIZP2v9PslhL03T++EbmhgYC53iPTDA9MkOBVbmUyI9KccithuhzcoMw80DBB3jjCmxIWRfFtZNHqPEcUocT4IzDSlhgi4V7J99VEW8h9COfWJxemwIkM0XleUj2HrvmIp+xRxPtP1vSbENBJogT4pPDm9SiieCAFwdbLeA89Vhv4Pwhriz5R0pFe+DAR/DuTuxXbWE/zj6xDBpeWm7vPsHd3PRwirVYd8UcyudhFC6Gs/aDLMQwueqM46P6VCVSf2ytOGr6wUcIqJoaVOnqBARZ2E5TvKVF6JZlddsD79nK6pY/VtVw7vLQ8d/+JBPwA6HygDZyn7jyj9UR/0IJoTrPAh8RsQOD8WWkVakcW7O8ZLDKk3KiJEwSrZRbCjYbx8RvG8HoJxU56Md07FawY1fWbnQXHSlt1988Vmxw3+4MFL9MIn+gQel2augZ/sI7VkJ4cm+wIqo7Uj+hlgCbLwEAHhTqVwWMKyYuSl8vta+GdgzbJkv9cZ3i6YGvHOXw4/EsivoGlQmbuw7XMIP10KjXbOiktzxnruvwHDqip183mYIJsDhKcCYtY0nrZR7/JLXaUQANVxSYelPzMV6tUw4RAiZORajJ3Z9EE1bbB1ytZIj/UXtu2TgYk4xt8FYLP+AkLox+HfMoPHtdYT6CSqd0konxp2XPTMRN1DoZXzcwQ0lNk85UpMjRKp+XPG3dls43FXQyhECboJyA1jhrvqwmGrF28sFaR68Ia/DyB36rJnx+Jq6L1iqnZRxyfsy+0mojz0MmYFDxBhejw1vMogCjQYoULUmwAEVEnQXg+YGW3YhvLgjscKhHE9AE33yDoxJF/n80y/cCeJYyTrBhqCUmAtUldud3V3gKjdIrWjTe/zWBw9vhtirhnfK8fIwmcbeNtI2xwbB9XPxn4WYEab86iyunmmMjzuQ1uftHExeQPF2xiVIUBebW6OjzrmbndEJAb+n7/z9t7QKXB86fqOe2AUAcKb/7QX4piAYy+A/EIv1X00xE93DHg1BZwTpIVT2PeMkxQ7bJlqv/0p7+CgrevgQrb4ox+vkfiFONnv5IcaEVLyvXZJhbJoZW8z+na1aDNA+KfTl6oG/vPZzQwQlP35SKCCHTGGQ6zwC2bztPAfit//DT9xb/0EvmrZZwAUCc85A+n+L7WlBxud2A3jFhB7Yef6KHSu8JbLP3hIOWX4tOuHN0k6DiOvPAZvArEyffyQqoZ2MygFvo06ZsRw4fN/K6AVIdqXP5smSHK1eIjXBdlV3nq+9V5K9GWx/GgcQREd2/z40RVXdFYvq3AMSVz4U1RJQsuR9mLDckkYA1SZYc91W5AcARU2HDTY21NxpRkqZHlYm96RBgDbQvQ0ZgHEsyaFwZvwWQUbdod9dTiCDKAbgunY2U0UOwAsPX5C6hqGswxs5wBbwZAo5iCvOZv9eZkacY/iCI6UfhDTXG8sCyy6XnROy31RPNS5Z6fnqddsKBZwZoOKcXAM7W7eVOd4CaGU6w/JMSTZg8nTjKdW3S+TfpXmz2Vj/uw0S//+kenSHgxtl9zoruCQTgD2U5FWh5bLGwf8DUEsHCET0D3PEIQAAKiIAAFBLAwQKAAAAAAAZs1lRAAAAAAAAAAAAAAAACQAQAF9fTUFDT1NYL1VYDAChfJVfoXyVX/UBFABQSwMEFAAIAAgA/ZpZUQAAAAAAAAAAAAAAABUAEABfX01BQ09TWC8uX2dvcGhlci5wbmdVWAwAdXyVXz1SlV/1ARQAjZHNihNBEMdrJoi7eNmLgrcgJqDQPT3T3ZmZjYMM2Q2ILIJm0VuoTHomo5nvDll8AY++gvhWPoGv4NHOJkFcPFh0VXUXv4J/VcO9R/fBBrjCpP/mXf9D/2C7Gpwa9wDsa5PN2/4C/2XxbPZ2f7vt+AVgfb2D9PZ1eA7wNKkKinW9VrRQGpeo8Xz+6erilVbFdafaGWbdrsV6YvwZwOAf/AF/v1KtmrZVsWuwX5vwAuDhH77ZYIulzksFi3qdd5qxbyd7QZZ1UGbdUXp6JL9b9vzsx0rrujt3nCbPNZKN0UeSqtSq1B3Niyy/oaXSzi014PHAm5qzZ/MCM0U6XbWKdpxigZ+rErcdNfoMxIy7IpSeyVxwEWKKJHCDkHCejAgLfUaYJ9B3U8UTTGldZi/zm3W+iNoFcalHvSFudBWlVVugHmZ5SppoxIZN5MthF6kEA84FU6EvJAtEwlGO3CCQbLlMRqGYnz0+zrbdbmlWVZnZWFLRj7UDJ/AAfh6+9Lig3t97snqNw1jgjmUaSmmEjicr8xNqLOLLqe/HgrjCBBHzCYldM8zlVMp4wieue8HgN1BLBwiDtX90sQEAAIcCAABQSwECFQMUAAgACAD9mllRRPQPc8QhAAAqIgAACgAMAAAAAAAAAABApIEAAAAAZ29waGVyLnBuZ1VYCAB1fJVfPVKVX1BLAQIVAwoAAAAAABmzWVEAAAAAAAAAAAAAAAAJAAwAAAAAAAAAAED9QQwiAABfX01BQ09TWC9VWAgAoXyVX6F8lV9QSwECFQMUAAgACAD9mllRg7V/dLEBAACHAgAAFQAMAAAAAAAAAABApIFDIgAAX19NQUNPU1gvLl9nb3BoZXIucG5nVVgIAHV8lV89UpVfUEsFBgAAAAADAAMA1gAAAEckAAAAAA=="
+  "image": "iVBORw0KGgoAAAANSUhEUgAAAAIAAAACCAYAAABytg0kAAAAH0lEQVR4nAASAO3/Av8AAP8AAAAAAAAAAAAAAAAAAwAfFwIBJnyuSgAAAABJRU5ErkJggg=="
 }
 `
 var errReqRegisterPostingUnderBarTitle = `
 {
   "title": "This_is_a_sample_posting.",
-  "image": "UEsDBBQACAAIAP2aWVEAAAAAAAAAAAAAAAAKABAAZ29waGVyLnBuZ1VYDAB1fJVfPVKVX/UBFACNWmVYVFG3HsARJAdkRKREpIYSkG4YQAaBGRgapEOQ7u5OQaRhQIEhpEEcOlW6U1q6pFvu+N374/78zo8T+1l7nbX2fs9a7zp7R8FVFUkInxACAAASpVdQdex1GwDA4SS4j70bUOfRwF5wXNQVZQHlg7RbAMBTgBJUBun5Zm/ex9FgIpv1z8E3v+u8r2X4r45KvXRC/1R6arYFy7Ao8w2CnqF/Jpra3C9IUrFgkTO1IhcjprZOU5HhhDxaTVSyq6Z5P75frdEYpsTiVD0je/Rk5nmXzLN7lYzufY+Sqph17SSu3Yb+jtgwKs/7XTntN2+430ne3Rtv1Rreut47bthYIALJEjCG/lenVz/N8nHzw3NCVumobtq659q87La3iYntnl4m8NK1K9q/JS3kYSlBoSBLxZ2r/hL3pV5+Yjp8WH/Sao0HT74yHCXBS7svB8rCF6l9yg8aN4uRa6w34uPy5uelAk6YSuB/F1tasQPMti3jOzYucUEIcB6vtIHawgMvhjv3aMKhDs+O4HCV2qI7qN2vnb0XwqI6+R6usmwuToAlvNgqRm1hcWmJCi82dNIePa2dmXG2cueUKVHhjxdt5+3D9zEOtvQgcPIuAs48LSE8e9/akHjzXqUu0uiZrPH090A/MjvbGVYISzoCfgxd7n8QVi9NOU67uyWFoDm9EKW+UAXoy58+LwDhX6082SnQkJv5hLJc/udkarsHH4poMpubHYgvrLPYuTfgl9+hrigzYLyFhFEJ04MmkoJjAU1qCVDvt2vCAoQ2hP/8RB0CYA4Tk/aMgefqxlP5qPJE4br802BcGyHCXQglktNueVVcWs5gLRSwMDffIvkUwunOzDCe036OB5h7oPdcfYbAbFq3t8gdJohWPh6WHBaBs4qAxnS02XMm2b+h9v+Zfex5DfBVQCoju3PZ6pYdlYCn6XJm2VmfyF72c93vpFXQLjbo1qCQmR8LyBdcPz0bNUXwQuIW6jZDMK69FgzW3Hr90f77JVGEWe/OlzypQs5E2T5377vPL8kN0T7ZoQSLaiLO0C/HuSy6RSi5MSjuL9ukgeX27BY8W+8dtXn3bCAlKO4BRXzpUsy9x/Fvy2p01r/I8yv6rQ/rsIZ9KKvUFLqAr+AGbHBEo/bpaQ9WaHmCZWHcyE4USdk31mo8EUl0VIrhXzkEHbuuwLDn3X77ITcir5dWQrEgMcnAsXJI7NSe4iPQTe0uajZtX5/f6Md5FSGlelKCbyfm5mWIZhmGq6RYxm/7T4ORUCx3oJtM05bxY2I5QDjISi79CWpjeFrVcVfpXJQx3Zxwxzkin5oOYIu3FHGBXHzx+OrEsWGoTEO2EKg56pbTCQgGGFUdZrjJ/nIMjXxu/6gl1u0pOQwRkaQUGbLXX/T9ES8zWiKi58paG8SuSzzk4WC7RZGyJntVwhP24YN4PL47WfxpdK8zY51iwTcmRgZT4vMfGL/YWhTLo/gLEGFmMoX6a4WTVw0qPbjJtXkVg14ASnVZrVnaY0/36OjqvBjC6qp/gI9lKrLFYS3aOPxexR6rMsB9V1ZQTpFaN57XUi/eKfaAdxH/YY3n9bju66EwHIB9KlGcgIUXbW4ZkbQ0/Plzi83MnfWQRTq67z9r8morFt1PC2lpQkBekmlp3cseCmMtC7NNpAzNuyu1FRUsZuUQi82+tpp9lcWrVXxqOUKAxpgLtRFr1+sDtUFz39vpC5EszwCI2QDsvmIEWgEKgwft/pVbp/okLOivigzHzBIiHjAWvi0jT1hfEBVhh+j2VPcrslQHZ0w8G+0wRSKDSwXHxypTp23MNEj0tiH8k9BVp1DLTmpbLTZP1uwyFAyx72YW04pAKkRuldvaBc2t9epo7LtY+RqyKIfoGenHOztcSe33xpG8NWLqgtkbytm62M1O0ZT2LU9+iUkouyvqL5XjeNf8/NQmfW6to6ewTGE4VMfUT+MK5W+uoZrdW4mWhmrB8aFAJSohpKnG6qy04OSHAjRLl+SapJANR5Otf8tpUoyznuKyyG8mOnYaeuPKN9biOxHchgHy/L4Y93ZCR0EOO3fbsyfWJ12I1XVHfrwJe7z9Av4RIqfwS2E5tvUO3ywT0RITcc4dn2FqcWL0+fNZdEc3Yp0Pvr/yL1pJf8Db+PTk0cqTQrMng6ECrxUm7D/FlPSlCkkA2khShTbEj2Jsy5ZIrtQFxQHSQ0vTAGdfdQ9nj9GZYVz7ZsuHNpApf5+ZLzO2bUaPOrtbpp2xOiULTXxHws6pDHsZPlyiPWj+pH4fWYr6pCcEXbOFivxpz483c8Ek7SX/uhlW147iEBRY94+FYX5zJcjx1JnOfWgteBbPAXnW1xxEjHUimUh0Id2N6dnBlF45bMP6S2TeDE3yrGQyOUnRo3lPD+5jm9IQ4p33jhGxKpejwYSU30kqhS5XGur17KU1qA2HD9fLaPh5ixmCiktc40s5Pk3DHz1MefTb93UnzYaEaq5oqHAsqW5C2Af/D8ICZZqi31UETMZ9nBlawCubZfqX9JOdgbYlnxpqDZpM5AtHgHSKsvQFcyxx5KSfNLRe4TwMJuQkCzmX6Ygn5nh31O3kZydcIYL+Z3XnRhNscGTXPpMN399vcOPzwmkg/QOP3sf75eY2xzzzS7tOiOxse3RVS9GrjQFqsoWclBoQD16diqqsMYGrgy27Z5gQWCMEqz3sGqVzsG3S9sUt5iOZm7wClSttzllk72sPZbuuk2/W5ci3mZSvAxKrt2RU9DC56gDCfkMJ4umFyrSprXsc037SJY9HnzfOWYLH7MkwGRZ4d/t2XM28cXU4nkpIW5YJ7PdE02vaUN+bw1jUywiksHNRtoMiLUVJxUsVO/w5ZAl1pj+Vn/TnVakOjuDTeE/NbT+zPirxeVp+suPyJsugdj9rJSWF18DQIoCg2sRsSBQ3xE33lC3meZ/3eAr97GS1Q7EHl2eNM7c9OkqU5TPA0c/usSZGYINlez7cTJbwufckF9gJkf6u0/v5L0u3VziZwYSAhF+jtONMy6QApnw9asHLld4yY8u+gcB6G9u/mQ5IY+H00vrtF9vzH1VMPu/x9UbYyQADDed40teNcRCGeeKcI2IiiGShcMuKb/irH6NvzrZKlj66mXiWpbVRvysyZAS+I4UAdHip76YxkXasuxYN1aO/FvajCV0CWtN7DWindF5sV5o1NCbt/W+4RmpEWlYso73eQk7pO2vfuCnL/zrxG7BsrBW3mTFY4K7HF5joNLHA5PWkyy1M3LBk881s8rcKVXeosAQMNuIrTomHzsGwQodtOi6Ucg7vXavU/OGlruW/Bst800WwjMyyYXQxtRsX5pDD+SHdpSKPqcKE5WT5S77g1e0fI2+vcA/KngEKjim+oVLBdE9jVt0Z1VTtrhf0glu/bPLlqEHIdIUgVDUpQl4yozn8ZOFkqe+OiqelgayiRTL28jJMoEJofGp5NqF2pbTRPcP5haA3yU8ZgQ3xz+js3r4+x2M3/YKvGtjBgszrTl1YKgehMuTWs7g9byTEr8gW2xjim1Or7E2dCXnIWqJT8aUixGYdeqQkL59Pfintu8gxGN90AhztM2de+B2Zz6keHxMV1mnYyhMryh7NbsqVdW2gE1vTfpsk1hu5bf6wbbirkOIRv6i/EFrBJLwJ4vobBwhMxTfqtrpbcXkseqTXc74mu+472vnUrasKk631VVG0eq54pgnsr7+Bhtxzq0E/1c7sWzuhlFN807nov7fDHuGgpH9Dcxjgf6Zxs34q9OdoqfRiYMdhKYzhFpSytpaWltXxT26UVBYwqrrfR3zb617CyaM2R7/1410RH9VX569JFkiCEleS/Y4Q7/1fH158Bvz55bLYrTJn37xklXvjykq/twB8yttPJH6oVryQQaWL48Iv0ry17X7Vfp6jPEMvdbHgUrzQwjmjujFocfeh1vUmqQWtfRhD2I/8IJPiBFnNpfd5/XgU66ktae9nngNf629UD+Lj8zakJNKrB7nrkryzU/Zqu1vJse/aZnX0PxrLtrYpU7vUU3d0JZby3Bejtq0eWcKm81FF/29sGJTWVtPSXnD76sHyWru2vAV1hAuAOy23+SLGSGmLC1mNyYbujfxi8ycOjXV33m5Ze/2OxGao/ZAUoVJunkVzU3F3eZ1PAWjbvfHtmTIK2Nk8khoa4jG+U+axRLvnTOy99Hd/pmLR0diuQW2ET7F3IhVww4RIT0zPZNg1toUPwAvBf3GaDyfnsDqi8A5SpVRuu86LGAJcB4bu/13kYIdk27qJZUuKRVHl3PGw2+b63yCWqfRHD8IJW1/rTg6/ActTIamPk5c209DmM1StN2DfivN0Iv+zk9WEFf27m3mBuu9qza3rVqofS7Ixd8tVZXNM2ULAoo5qRtuq12D5xmVGo/bVb3TPa46PPX9HOaxkSvhUdrceBi/v2zxMDhtoNo8+wmU9evt8bvU9a0vUjqS3fM/ZK8XayNS4Lqp6ahqGXYSrhE1eEY8qMCQ/6YPlxsaxKJsLmH13SF/Qrn2g5Yt1grrJ36K1F1/nDw+gzPVJbkJ+ukGsk4nHxVTpR84u9CW+mrYmNMNUK0fhpXy4Dlpil0K1kTCY6nKuFjkG0yZd4JLeWumynpfWxGEV2KUxRmiiJWZ4PcIhz8xcVKkGk+Hzzo6XUmqeQ8PP/c+G5Jmpw/LD7uw4snkqtQVKs/dvurLzk5I0plkLUF4PZJpbd4hGI9y0697E19PYU6RYuAo++6qYHfno7Hr/4Ar3yfaaQc5HV82dNfXJB/lNDaR7K1e2AM6utRulgPNuboSSastNAU80jC/a+VXAydLbB60OG4u+SsikZNk5dqWzzU77sAB14LBZzCj9ScZLw6Umjq2/5TxL8WeZ8ha6l30+B9wNqWmxP6TWJB6aAYc0DjDnQwV1Y/O5F57tex/Mu7dYOWauptKc5MzSGYax4cC8nSPRo3Yxdt9BnAXyUJSO+11axuDPsI5WQEvzuRgw4hSr+PD32CLWjz2DICPpEvby1vtRyybI0f0qt53tygNWqXas8gb0y4LWpnN7Xx+H5VQ7eZE/+1kiMnQprmSFDge38Xt7H1wlC8HzfsnR3GjAn3bT5eYumJrkLdeu3/pW2VDImwlvkauWOx51b3WAcJ3xglZD+uPOrcqss+rG7CSW5zwYrqrf1Epb03/rxviFWYrvrodZjGgZ9K0GSdIj4pvCjfYWE/KEFjFLG+A1i4YWO46/J+WNnxd0yQD+TvX94/T5M7ZLkxh5hK5q0t7J/uHDNP0qj6l+d5i3Vwerhdx3CD5mTl8UKm/5/lizJC05httgCPEH+Lu4dt5Ro71I15kXg8p8ov6U1zAt1hp4kPzm57Y7TJRhrJ+geA95EUPYevEibNVlnYTqy8l6mUDA97kalvmGWdPvG1/e8pQWg5BbHK2AP4Nl+rP5SYbxg3oe/FuHlSx25TapYcL8diMD9pB7E5Tk+7tv3BgH/8TLN78dHfayCvhGl83vgGzD2dq4cUHUYpOkkOUv9bO/s8uvfsREduelR/HemPRwQDRGzaH8dUIWS4WxN9UWPvCBv4WiFM4LQR6uk1x+jcb1C9kuJsgOFgIO7Ixk4EltGEy7vCwzPhOa8NTpRMREJIY9xlKsntjmu+aIIgmgA1ftKINl08eBtb2VLLVPAIf+Bl5qxJ0aneGwk+dsk5cW9xWrbzzBzmaw/XaiUBSv5fYPNeitmmySduG45B8kIXuiTuxO7h/2W6AQ9QinLT+9VWH8DXQ3N/bxLODl3wZ0GfwnnoNPZ76zh3qAS0/IV6vjO7ZfG2pMvpb3XJIZDP4cZ5ymdmjOB1q7aWR4WD5abutLlvwFf9hdsIZAfCsY/P6yiW/tYQo47iaBFF+dqwxBenOVxVMRxiTxqOcbU2tXQo4fYfv7/jm4w13ynNNxdq7Ccb2U3Lh2cpVgChOjELa/gSZY0OKrdjkLU1Si3jcI/Rg55UTazzPLAxHhBZy485ht86MXS2Y7z6z5DnQzZIs6RbHkL3mqvTCuJaqZ7M7HRFrD6/dPh7z91QBmiHIxbKOhmIXTrdSkPH6oUcUb7mQ5v8dVb8l8XuH8s7/B0+B5R1CIBn2TyGrVzb2AfKrTlSWkPyOXz8HTv51+uT84sBlr3OyOPEQt4AGViqEnQkbQ0pSYR+3qnZ2QmGwJrn4rc1J/QzCLvtYpxUhBtvpBP8aF1Pq2n6CkMKHTY/85ve+Vj1eEa0Hr1S+jpRYx+46uq0zH+npNZEt4BI7QOPSzxIxE8n8o76VflRLFtr1D1WnUKrOAogrzjPJLHxIDXer39NO+eNt8z6yFPSjdihYDOcSJAI//GsvAXuEsfee54bC3a6TI7Li1/tMSXqVcz4TMuZ3nfCbu1FJnMxmH5eIN01TJkgHZsdaePpPJU7Rv+PoU/UqLKcZNAPry8pZPyGwfk13DDwSH9cg5LtrE6CadRHd7d2iNsC5wi172Oksn+4s8PX/ryHMzV/Hnj59HBzinu8fDP9ot7otwXQudE9e1Bj0/b3jnGL1nGn5m36r1UQ9RxjVPF3VPp2j8QHqAV24ymgKpP4T48pJaJSfzGDVjixp5qBjCGu9MpZjO4ZbaALJKS+B/DTVIi7cRpnNfG/dh+RZLcMN6jRAEGxzr3RO9MhKpPVMBbgasgVqOZujQwxTtJeI+xKlDasbjAfjrTem5pC16oaz1DC1iG8ERXsO/4z6N2KIUEj7N2n2+HRVjGHvBMAWK5mtq3VQ/1VOfS1DTDC75DOUpbY8uCsa+iTvgnLlhQ13q0I77o+JRzYtvIDn57oi7/ZzM4LtNNVDTyn5WZwblclTMuRo3xlh+Lj1hTvJE8/dODFkkJDr7zjxgNhG3TVSC9G594K1RalT3sz8Mtw8gHcn56qbk8sF6s9tWCuqkHtrXO9f74JTfXa1e/JPahTlyrJ3YKWU34mGm1YMjzQQJS3C3B809hDL0BbVW8/DvFJAfU3z2ryhzLq+olle2cocHTckNvFeo3hrxgPsrPbQpUL20E/m3LSVH4T3yzH2SC97k9eK3wm8uvhqt1TW4DhnmduQueD8U1AQEerpN0jxRHLjRUS2yj/S1uVcXOtGfEAWbnEbiiPsuZl/0WnksNdfwP6aWCpn55nVzERbQjLK//jJXadJ53+CXTkIc1US70U4Ia4xj+aTj+jnBpbIQ2n03R18lCtXjeg88zXQgDNYj1ufh7pyMeRjz+vxrzH3mbxGUAN8Nlzs+dhZLcW9mbVg/guFbgQhbCeOZCa8evDqTQobL4uW5xgIOIgjMhiYkmHoZA3vXfbr7lZLCBU05nohG6WS/bUEgTUu3Cm2hzF3mEODEWYpAY2iv/p/2gjl5i/pIrfFl1866fD5TvpRgvdHByXoI1WSYqNTC3FF6Vnkh4zp85YRYSVh/LjrdNqPq0DxANfMpoE3bkMiu24AP8yJis8Mg3OMz1bnWSn+hPq+uxcCC6TMFGc3+/EbCgzfuQjbr3yvtj9ggg8BUtYSLEltEzTKfZ+nOkrPPUmn5w5v60O5jjcjBIVP/o5eyn76TJxS0fFqSzWYYO2XIC4REBpeg4V7KK+MDPV2Ft9Hp5yO1LTg7GYKRC5jwCgxpy7YoUsBOVs6WZrDplOZ1Qo50bT4pkeYOr3Yd/Rh44GWXZhpMptkQCS9hKRU0q7bhL7czAMCn3Yq5v+IP6Dt0O/Zlu+ID8fO6n+MreURE+RqYtqv8jBSnHKeYWFIK8KJBkAEnGLfnXSeCVW7ycTngcDrZkUz/FFzmj20pFyfnJ7mVp+s9BWyUfbeChV2SsHsChdezUIF76gw0IvWvZNwovHLFeed/AkWsxliYMgg9cj2IwQq+J29FfjBsnAT7TLDhvyHykrcwB2JbnVvyiboELrbGG3VC8VrFdzo/Rgv3vsQTsCcV4l+aTJw4fqMW4Uac7TGmgI+v/gxNHG70Hr9PRHbFS3BrI+3UHi8HTHdgDu3bIaeB0sHupfyQL3J+7nIoCMDL5+MfYYVFEmzUpn1oE4WCQz/wyQfDIjseH8fA+hVkmKGhc62L2imo0Tp0qDkKBn+ASDdCGgsSQhhN641kzHzgPqTxHpE2BOxu9fgpIFbP/xSaZixzIb0GZ7mvWaDQhBkxtToJdJbLXMXrp9D/SCk6zszlTdMjSRXkjPIlM8+vZ+mR96evVOQjH2JbZbOCZmbz0CCkfgRlqPnPyVVkGMoeDAapbyMJIIyqcIycEdi+89WrjzZQoGW1ABryK/w9iLVSAB0qHJV97ceOtfPn4G3L2f2JdTvdUOGwZpk6L/1HjKomDvAHThQDm6CUtO5J1Gi4r2y1WR+e0ZtfD9GnW5sC4i3X+RZ1LcUx9abVvWdzo6Ck0e7kVsW9LrHhzhrGRzH7+rEmGxKNuGP55z/NveDnBw8u8swIlqiypHPs3sk7rp65OjB1PfqrcYsW93k3oTbzx5SYTit84iqvIr5lghEY2FvzHS7Fn7RCEjptdpu+WEjADvjzRZLqSr3J+IlyCOYuF88QoOZTsxej2pK0zyzLucb3DC1xe30CjA2wvFuQVrzC/cZJAnmMm/7GPcxqRDh7myYWZCuQJUb4KMace2U6/Zs0fV/wVc0Grh2AqvyxplouKAVkipmN8oAyA1ZzvZzU2pL4qT4CNfmH2UtwAIuXvP/+JTpTkzdsFEItAIBcjsZVDPwjECwdgQYAdDhxjVwVkAqRbyFUrFBmBtMZuqmwaj607sHOqTo8Ks6XKDk/KQULempO4u1ZDb4tz2c1+RSyrz9978xPAo/mdif+TEKtiy6fQQdjYATs7B/vTRRZSe0yh5ZZKME9xB8/Bk2A8gk9dJoFKJSxaEt+zF2BNgda/mzRJgrpDZcHK8VYxP3T9/BMKwom2cX7HPK+vRrlbCyNTH5pOMIJYex6WZPChTXhLIrQvJITzRn+BkGS2PCLq9wLahGaZjmon+CoIpgNJOLqieFEi7kDg2l3Z14LGJwvuY7FpcMIqrqmw90RD9q+NAb+qkbhthXRE+/emz4ufrOKwW/n2O/JF4u4xGFkST+eXA1R5p//EShRtVoa1EIMVNf1j8AHTLcKDZMfa/71+6V/qQSI9C5xxGwpELIjVOU9KeKsFgLFkoQtVgmHbj383NdH6Bpx2/sPPvR9ROFK/ytirZuMkIqRMCzLfvcztkDOSPaVPxsWuwBgCggfhaJYAdQDNRO49KpPoxNNjfXhHnatXUkPu0Mp8eawI4UXhgV9HooiSWKu0JYSKUvbto+iB4FBsrtkEMbBdhBlSlpYMOxVvTBZNKzf1AV+GYgOBpn6YJFPSsrFVsIb80Wm7piM9bl2P9uISEl2gDDjtxW9X/XzrvX3JwalshINJH615L0UEp++jHiYxDRXT4YriO8WxezxhPWzdL07BHJPgA3ytMtd0N4dQEkA+XqZGZ1YNt0wgwN/iu5fd4DIVpta4+VT8M/QI7uoT9FQL975BdLuM9Qol27kQ3FnZRf4f2IDxlX5kd4lM6Tm1vk19inUWzOfE/xIHPwA8rWZkUBJ0WxGam6K0+bexDpYQJYviu+aStzOa0kFIhFiZoCUiyRRwA7eTf6zmuKRTyzTkias5iTA8OZAdZmCRgnQqePgfaiAFpwV/VKNmTDa1uYE3duPwEFZOb57Ih2HjgfFEHt8/VobFV2GUk0gBBBfQ5lDdcLk4hYo4Zzokn58AqUXV1CwFgKC3v/xDOsCmAkyHwQtRnHvXfspYSduRng8I5wdjAQsGcMEQOya28Q+ON1afsnWp6nTr6PmX3GwT33CRU0YmfJgMRk18YEYshHuTCIPi6AnPY+yKSs0h6dqzVkf8FTRdx4rx2TAEHxoQFv7FVQg9BWV9nGDhjMve7GVIyeBcQ3WViVS169fXSOjd1HvsLbScj4tYYQ+kZtfVdmiB6PepOgozGDNblIEorWZHyEahIC0w59wx0spxtjnM3FJNxE4gby7lBOgJB5SucvVPnIZP2v9PslhL03T++EbmhgYC53iPTDA9MkOBVbmUyI9KccithuhzcoMw80DBB3jjCmxIWRfFtZNHqPEcUocT4IzDSlhgi4V7J99VEW8h9COfWJxemwIkM0XleUj2HrvmIp+xRxPtP1vSbENBJogT4pPDm9SiieCAFwdbLeA89Vhv4Pwhriz5R0pFe+DAR/DuTuxXbWE/zj6xDBpeWm7vPsHd3PRwirVYd8UcyudhFC6Gs/aDLMQwueqM46P6VCVSf2ytOGr6wUcIqJoaVOnqBARZ2E5TvKVF6JZlddsD79nK6pY/VtVw7vLQ8d/+JBPwA6HygDZyn7jyj9UR/0IJoTrPAh8RsQOD8WWkVakcW7O8ZLDKk3KiJEwSrZRbCjYbx8RvG8HoJxU56Md07FawY1fWbnQXHSlt1988Vmxw3+4MFL9MIn+gQel2augZ/sI7VkJ4cm+wIqo7Uj+hlgCbLwEAHhTqVwWMKyYuSl8vta+GdgzbJkv9cZ3i6YGvHOXw4/EsivoGlQmbuw7XMIP10KjXbOiktzxnruvwHDqip183mYIJsDhKcCYtY0nrZR7/JLXaUQANVxSYelPzMV6tUw4RAiZORajJ3Z9EE1bbB1ytZIj/UXtu2TgYk4xt8FYLP+AkLox+HfMoPHtdYT6CSqd0konxp2XPTMRN1DoZXzcwQ0lNk85UpMjRKp+XPG3dls43FXQyhECboJyA1jhrvqwmGrF28sFaR68Ia/DyB36rJnx+Jq6L1iqnZRxyfsy+0mojz0MmYFDxBhejw1vMogCjQYoULUmwAEVEnQXg+YGW3YhvLgjscKhHE9AE33yDoxJF/n80y/cCeJYyTrBhqCUmAtUldud3V3gKjdIrWjTe/zWBw9vhtirhnfK8fIwmcbeNtI2xwbB9XPxn4WYEab86iyunmmMjzuQ1uftHExeQPF2xiVIUBebW6OjzrmbndEJAb+n7/z9t7QKXB86fqOe2AUAcKb/7QX4piAYy+A/EIv1X00xE93DHg1BZwTpIVT2PeMkxQ7bJlqv/0p7+CgrevgQrb4ox+vkfiFONnv5IcaEVLyvXZJhbJoZW8z+na1aDNA+KfTl6oG/vPZzQwQlP35SKCCHTGGQ6zwC2bztPAfit//DT9xb/0EvmrZZwAUCc85A+n+L7WlBxud2A3jFhB7Yef6KHSu8JbLP3hIOWX4tOuHN0k6DiOvPAZvArEyffyQqoZ2MygFvo06ZsRw4fN/K6AVIdqXP5smSHK1eIjXBdlV3nq+9V5K9GWx/GgcQREd2/z40RVXdFYvq3AMSVz4U1RJQsuR9mLDckkYA1SZYc91W5AcARU2HDTY21NxpRkqZHlYm96RBgDbQvQ0ZgHEsyaFwZvwWQUbdod9dTiCDKAbgunY2U0UOwAsPX5C6hqGswxs5wBbwZAo5iCvOZv9eZkacY/iCI6UfhDTXG8sCyy6XnROy31RPNS5Z6fnqddsKBZwZoOKcXAM7W7eVOd4CaGU6w/JMSTZg8nTjKdW3S+TfpXmz2Vj/uw0S//+kenSHgxtl9zoruCQTgD2U5FWh5bLGwf8DUEsHCET0D3PEIQAAKiIAAFBLAwQKAAAAAAAZs1lRAAAAAAAAAAAAAAAACQAQAF9fTUFDT1NYL1VYDAChfJVfoXyVX/UBFABQSwMEFAAIAAgA/ZpZUQAAAAAAAAAAAAAAABUAEABfX01BQ09TWC8uX2dvcGhlci5wbmdVWAwAdXyVXz1SlV/1ARQAjZHNihNBEMdrJoi7eNmLgrcgJqDQPT3T3ZmZjYMM2Q2ILIJm0VuoTHomo5nvDll8AY++gvhWPoGv4NHOJkFcPFh0VXUXv4J/VcO9R/fBBrjCpP/mXf9D/2C7Gpwa9wDsa5PN2/4C/2XxbPZ2f7vt+AVgfb2D9PZ1eA7wNKkKinW9VrRQGpeo8Xz+6erilVbFdafaGWbdrsV6YvwZwOAf/AF/v1KtmrZVsWuwX5vwAuDhH77ZYIulzksFi3qdd5qxbyd7QZZ1UGbdUXp6JL9b9vzsx0rrujt3nCbPNZKN0UeSqtSq1B3Niyy/oaXSzi014PHAm5qzZ/MCM0U6XbWKdpxigZ+rErcdNfoMxIy7IpSeyVxwEWKKJHCDkHCejAgLfUaYJ9B3U8UTTGldZi/zm3W+iNoFcalHvSFudBWlVVugHmZ5SppoxIZN5MthF6kEA84FU6EvJAtEwlGO3CCQbLlMRqGYnz0+zrbdbmlWVZnZWFLRj7UDJ/AAfh6+9Lig3t97snqNw1jgjmUaSmmEjicr8xNqLOLLqe/HgrjCBBHzCYldM8zlVMp4wieue8HgN1BLBwiDtX90sQEAAIcCAABQSwECFQMUAAgACAD9mllRRPQPc8QhAAAqIgAACgAMAAAAAAAAAABApIEAAAAAZ29waGVyLnBuZ1VYCAB1fJVfPVKVX1BLAQIVAwoAAAAAABmzWVEAAAAAAAAAAAAAAAAJAAwAAAAAAAAAAED9QQwiAABfX01BQ09TWC9VWAgAoXyVX6F8lV9QSwECFQMUAAgACAD9mllRg7V/dLEBAACHAgAAFQAMAAAAAAAAAABApIFDIgAAX19NQUNPU1gvLl9nb3BoZXIucG5nVVgIAHV8lV89UpVfUEsFBgAAAAADAAMA1gAAAEckAAAAAA=="
+  "image": "iVBORw0KGgoAAAANSUhEUgAAAAIAAAACCAYAAABytg0kAAAAH0lEQVR4nAASAO3/Av8AAP8AAAAAAAAAAAAAAAAAAwAfFwIBJnyuSgAAAABJRU5ErkJggg=="
+}
+`
+var errReqRegisterPostingPastPublishAt = `
+{
+  "title": "This is a sample posting.",
+  "image": "iVBORw0KGgoAAAANSUhEUgAAAAIAAAACCAYAAABytg0kAAAAH0lEQVR4nAASAO3/Av8AAP8AAAAAAAAAAAAAAAAAAwAfFwIBJnyuSgAAAABJRU5ErkJggg==",
+  "publish_at": "2019-12-31T00:00:00+09:00"
 }
 `
 var errReqRegisterPostingTitleShort = `
 {
   "title": "a",
-  "image": "UEsDBBQACAAIAP2aWVEAAAAAAAAAAAAAAAAKABAAZ29waGVyLnBuZ1VYDAB1fJVfPVKVX/UBFACNWmVYVFG3HsARJAdkRKREpIYSkG4YQAaBGRgapEOQ7u5OQaRhQIEhpEEcOlW6U1q6pFvu+N374/78zo8T+1l7nbX2fs9a7zp7R8FVFUkInxACAAASpVdQdex1GwDA4SS4j70bUOfRwF5wXNQVZQHlg7RbAMBTgBJUBun5Zm/ex9FgIpv1z8E3v+u8r2X4r45KvXRC/1R6arYFy7Ao8w2CnqF/Jpra3C9IUrFgkTO1IhcjprZOU5HhhDxaTVSyq6Z5P75frdEYpsTiVD0je/Rk5nmXzLN7lYzufY+Sqph17SSu3Yb+jtgwKs/7XTntN2+430ne3Rtv1Rreut47bthYIALJEjCG/lenVz/N8nHzw3NCVumobtq659q87La3iYntnl4m8NK1K9q/JS3kYSlBoSBLxZ2r/hL3pV5+Yjp8WH/Sao0HT74yHCXBS7svB8rCF6l9yg8aN4uRa6w34uPy5uelAk6YSuB/F1tasQPMti3jOzYucUEIcB6vtIHawgMvhjv3aMKhDs+O4HCV2qI7qN2vnb0XwqI6+R6usmwuToAlvNgqRm1hcWmJCi82dNIePa2dmXG2cueUKVHhjxdt5+3D9zEOtvQgcPIuAs48LSE8e9/akHjzXqUu0uiZrPH090A/MjvbGVYISzoCfgxd7n8QVi9NOU67uyWFoDm9EKW+UAXoy58+LwDhX6082SnQkJv5hLJc/udkarsHH4poMpubHYgvrLPYuTfgl9+hrigzYLyFhFEJ04MmkoJjAU1qCVDvt2vCAoQ2hP/8RB0CYA4Tk/aMgefqxlP5qPJE4br802BcGyHCXQglktNueVVcWs5gLRSwMDffIvkUwunOzDCe036OB5h7oPdcfYbAbFq3t8gdJohWPh6WHBaBs4qAxnS02XMm2b+h9v+Zfex5DfBVQCoju3PZ6pYdlYCn6XJm2VmfyF72c93vpFXQLjbo1qCQmR8LyBdcPz0bNUXwQuIW6jZDMK69FgzW3Hr90f77JVGEWe/OlzypQs5E2T5377vPL8kN0T7ZoQSLaiLO0C/HuSy6RSi5MSjuL9ukgeX27BY8W+8dtXn3bCAlKO4BRXzpUsy9x/Fvy2p01r/I8yv6rQ/rsIZ9KKvUFLqAr+AGbHBEo/bpaQ9WaHmCZWHcyE4USdk31mo8EUl0VIrhXzkEHbuuwLDn3X77ITcir5dWQrEgMcnAsXJI7NSe4iPQTe0uajZtX5/f6Md5FSGlelKCbyfm5mWIZhmGq6RYxm/7T4ORUCx3oJtM05bxY2I5QDjISi79CWpjeFrVcVfpXJQx3Zxwxzkin5oOYIu3FHGBXHzx+OrEsWGoTEO2EKg56pbTCQgGGFUdZrjJ/nIMjXxu/6gl1u0pOQwRkaQUGbLXX/T9ES8zWiKi58paG8SuSzzk4WC7RZGyJntVwhP24YN4PL47WfxpdK8zY51iwTcmRgZT4vMfGL/YWhTLo/gLEGFmMoX6a4WTVw0qPbjJtXkVg14ASnVZrVnaY0/36OjqvBjC6qp/gI9lKrLFYS3aOPxexR6rMsB9V1ZQTpFaN57XUi/eKfaAdxH/YY3n9bju66EwHIB9KlGcgIUXbW4ZkbQ0/Plzi83MnfWQRTq67z9r8morFt1PC2lpQkBekmlp3cseCmMtC7NNpAzNuyu1FRUsZuUQi82+tpp9lcWrVXxqOUKAxpgLtRFr1+sDtUFz39vpC5EszwCI2QDsvmIEWgEKgwft/pVbp/okLOivigzHzBIiHjAWvi0jT1hfEBVhh+j2VPcrslQHZ0w8G+0wRSKDSwXHxypTp23MNEj0tiH8k9BVp1DLTmpbLTZP1uwyFAyx72YW04pAKkRuldvaBc2t9epo7LtY+RqyKIfoGenHOztcSe33xpG8NWLqgtkbytm62M1O0ZT2LU9+iUkouyvqL5XjeNf8/NQmfW6to6ewTGE4VMfUT+MK5W+uoZrdW4mWhmrB8aFAJSohpKnG6qy04OSHAjRLl+SapJANR5Otf8tpUoyznuKyyG8mOnYaeuPKN9biOxHchgHy/L4Y93ZCR0EOO3fbsyfWJ12I1XVHfrwJe7z9Av4RIqfwS2E5tvUO3ywT0RITcc4dn2FqcWL0+fNZdEc3Yp0Pvr/yL1pJf8Db+PTk0cqTQrMng6ECrxUm7D/FlPSlCkkA2khShTbEj2Jsy5ZIrtQFxQHSQ0vTAGdfdQ9nj9GZYVz7ZsuHNpApf5+ZLzO2bUaPOrtbpp2xOiULTXxHws6pDHsZPlyiPWj+pH4fWYr6pCcEXbOFivxpz483c8Ek7SX/uhlW147iEBRY94+FYX5zJcjx1JnOfWgteBbPAXnW1xxEjHUimUh0Id2N6dnBlF45bMP6S2TeDE3yrGQyOUnRo3lPD+5jm9IQ4p33jhGxKpejwYSU30kqhS5XGur17KU1qA2HD9fLaPh5ixmCiktc40s5Pk3DHz1MefTb93UnzYaEaq5oqHAsqW5C2Af/D8ICZZqi31UETMZ9nBlawCubZfqX9JOdgbYlnxpqDZpM5AtHgHSKsvQFcyxx5KSfNLRe4TwMJuQkCzmX6Ygn5nh31O3kZydcIYL+Z3XnRhNscGTXPpMN399vcOPzwmkg/QOP3sf75eY2xzzzS7tOiOxse3RVS9GrjQFqsoWclBoQD16diqqsMYGrgy27Z5gQWCMEqz3sGqVzsG3S9sUt5iOZm7wClSttzllk72sPZbuuk2/W5ci3mZSvAxKrt2RU9DC56gDCfkMJ4umFyrSprXsc037SJY9HnzfOWYLH7MkwGRZ4d/t2XM28cXU4nkpIW5YJ7PdE02vaUN+bw1jUywiksHNRtoMiLUVJxUsVO/w5ZAl1pj+Vn/TnVakOjuDTeE/NbT+zPirxeVp+suPyJsugdj9rJSWF18DQIoCg2sRsSBQ3xE33lC3meZ/3eAr97GS1Q7EHl2eNM7c9OkqU5TPA0c/usSZGYINlez7cTJbwufckF9gJkf6u0/v5L0u3VziZwYSAhF+jtONMy6QApnw9asHLld4yY8u+gcB6G9u/mQ5IY+H00vrtF9vzH1VMPu/x9UbYyQADDed40teNcRCGeeKcI2IiiGShcMuKb/irH6NvzrZKlj66mXiWpbVRvysyZAS+I4UAdHip76YxkXasuxYN1aO/FvajCV0CWtN7DWindF5sV5o1NCbt/W+4RmpEWlYso73eQk7pO2vfuCnL/zrxG7BsrBW3mTFY4K7HF5joNLHA5PWkyy1M3LBk881s8rcKVXeosAQMNuIrTomHzsGwQodtOi6Ucg7vXavU/OGlruW/Bst800WwjMyyYXQxtRsX5pDD+SHdpSKPqcKE5WT5S77g1e0fI2+vcA/KngEKjim+oVLBdE9jVt0Z1VTtrhf0glu/bPLlqEHIdIUgVDUpQl4yozn8ZOFkqe+OiqelgayiRTL28jJMoEJofGp5NqF2pbTRPcP5haA3yU8ZgQ3xz+js3r4+x2M3/YKvGtjBgszrTl1YKgehMuTWs7g9byTEr8gW2xjim1Or7E2dCXnIWqJT8aUixGYdeqQkL59Pfintu8gxGN90AhztM2de+B2Zz6keHxMV1mnYyhMryh7NbsqVdW2gE1vTfpsk1hu5bf6wbbirkOIRv6i/EFrBJLwJ4vobBwhMxTfqtrpbcXkseqTXc74mu+472vnUrasKk631VVG0eq54pgnsr7+Bhtxzq0E/1c7sWzuhlFN807nov7fDHuGgpH9Dcxjgf6Zxs34q9OdoqfRiYMdhKYzhFpSytpaWltXxT26UVBYwqrrfR3zb617CyaM2R7/1410RH9VX569JFkiCEleS/Y4Q7/1fH158Bvz55bLYrTJn37xklXvjykq/twB8yttPJH6oVryQQaWL48Iv0ry17X7Vfp6jPEMvdbHgUrzQwjmjujFocfeh1vUmqQWtfRhD2I/8IJPiBFnNpfd5/XgU66ktae9nngNf629UD+Lj8zakJNKrB7nrkryzU/Zqu1vJse/aZnX0PxrLtrYpU7vUU3d0JZby3Bejtq0eWcKm81FF/29sGJTWVtPSXnD76sHyWru2vAV1hAuAOy23+SLGSGmLC1mNyYbujfxi8ycOjXV33m5Ze/2OxGao/ZAUoVJunkVzU3F3eZ1PAWjbvfHtmTIK2Nk8khoa4jG+U+axRLvnTOy99Hd/pmLR0diuQW2ET7F3IhVww4RIT0zPZNg1toUPwAvBf3GaDyfnsDqi8A5SpVRuu86LGAJcB4bu/13kYIdk27qJZUuKRVHl3PGw2+b63yCWqfRHD8IJW1/rTg6/ActTIamPk5c209DmM1StN2DfivN0Iv+zk9WEFf27m3mBuu9qza3rVqofS7Ixd8tVZXNM2ULAoo5qRtuq12D5xmVGo/bVb3TPa46PPX9HOaxkSvhUdrceBi/v2zxMDhtoNo8+wmU9evt8bvU9a0vUjqS3fM/ZK8XayNS4Lqp6ahqGXYSrhE1eEY8qMCQ/6YPlxsaxKJsLmH13SF/Qrn2g5Yt1grrJ36K1F1/nDw+gzPVJbkJ+ukGsk4nHxVTpR84u9CW+mrYmNMNUK0fhpXy4Dlpil0K1kTCY6nKuFjkG0yZd4JLeWumynpfWxGEV2KUxRmiiJWZ4PcIhz8xcVKkGk+Hzzo6XUmqeQ8PP/c+G5Jmpw/LD7uw4snkqtQVKs/dvurLzk5I0plkLUF4PZJpbd4hGI9y0697E19PYU6RYuAo++6qYHfno7Hr/4Ar3yfaaQc5HV82dNfXJB/lNDaR7K1e2AM6utRulgPNuboSSastNAU80jC/a+VXAydLbB60OG4u+SsikZNk5dqWzzU77sAB14LBZzCj9ScZLw6Umjq2/5TxL8WeZ8ha6l30+B9wNqWmxP6TWJB6aAYc0DjDnQwV1Y/O5F57tex/Mu7dYOWauptKc5MzSGYax4cC8nSPRo3Yxdt9BnAXyUJSO+11axuDPsI5WQEvzuRgw4hSr+PD32CLWjz2DICPpEvby1vtRyybI0f0qt53tygNWqXas8gb0y4LWpnN7Xx+H5VQ7eZE/+1kiMnQprmSFDge38Xt7H1wlC8HzfsnR3GjAn3bT5eYumJrkLdeu3/pW2VDImwlvkauWOx51b3WAcJ3xglZD+uPOrcqss+rG7CSW5zwYrqrf1Epb03/rxviFWYrvrodZjGgZ9K0GSdIj4pvCjfYWE/KEFjFLG+A1i4YWO46/J+WNnxd0yQD+TvX94/T5M7ZLkxh5hK5q0t7J/uHDNP0qj6l+d5i3Vwerhdx3CD5mTl8UKm/5/lizJC05httgCPEH+Lu4dt5Ro71I15kXg8p8ov6U1zAt1hp4kPzm57Y7TJRhrJ+geA95EUPYevEibNVlnYTqy8l6mUDA97kalvmGWdPvG1/e8pQWg5BbHK2AP4Nl+rP5SYbxg3oe/FuHlSx25TapYcL8diMD9pB7E5Tk+7tv3BgH/8TLN78dHfayCvhGl83vgGzD2dq4cUHUYpOkkOUv9bO/s8uvfsREduelR/HemPRwQDRGzaH8dUIWS4WxN9UWPvCBv4WiFM4LQR6uk1x+jcb1C9kuJsgOFgIO7Ixk4EltGEy7vCwzPhOa8NTpRMREJIY9xlKsntjmu+aIIgmgA1ftKINl08eBtb2VLLVPAIf+Bl5qxJ0aneGwk+dsk5cW9xWrbzzBzmaw/XaiUBSv5fYPNeitmmySduG45B8kIXuiTuxO7h/2W6AQ9QinLT+9VWH8DXQ3N/bxLODl3wZ0GfwnnoNPZ76zh3qAS0/IV6vjO7ZfG2pMvpb3XJIZDP4cZ5ymdmjOB1q7aWR4WD5abutLlvwFf9hdsIZAfCsY/P6yiW/tYQo47iaBFF+dqwxBenOVxVMRxiTxqOcbU2tXQo4fYfv7/jm4w13ynNNxdq7Ccb2U3Lh2cpVgChOjELa/gSZY0OKrdjkLU1Si3jcI/Rg55UTazzPLAxHhBZy485ht86MXS2Y7z6z5DnQzZIs6RbHkL3mqvTCuJaqZ7M7HRFrD6/dPh7z91QBmiHIxbKOhmIXTrdSkPH6oUcUb7mQ5v8dVb8l8XuH8s7/B0+B5R1CIBn2TyGrVzb2AfKrTlSWkPyOXz8HTv51+uT84sBlr3OyOPEQt4AGViqEnQkbQ0pSYR+3qnZ2QmGwJrn4rc1J/QzCLvtYpxUhBtvpBP8aF1Pq2n6CkMKHTY/85ve+Vj1eEa0Hr1S+jpRYx+46uq0zH+npNZEt4BI7QOPSzxIxE8n8o76VflRLFtr1D1WnUKrOAogrzjPJLHxIDXer39NO+eNt8z6yFPSjdihYDOcSJAI//GsvAXuEsfee54bC3a6TI7Li1/tMSXqVcz4TMuZ3nfCbu1FJnMxmH5eIN01TJkgHZsdaePpPJU7Rv+PoU/UqLKcZNAPry8pZPyGwfk13DDwSH9cg5LtrE6CadRHd7d2iNsC5wi172Oksn+4s8PX/ryHMzV/Hnj59HBzinu8fDP9ot7otwXQudE9e1Bj0/b3jnGL1nGn5m36r1UQ9RxjVPF3VPp2j8QHqAV24ymgKpP4T48pJaJSfzGDVjixp5qBjCGu9MpZjO4ZbaALJKS+B/DTVIi7cRpnNfG/dh+RZLcMN6jRAEGxzr3RO9MhKpPVMBbgasgVqOZujQwxTtJeI+xKlDasbjAfjrTem5pC16oaz1DC1iG8ERXsO/4z6N2KIUEj7N2n2+HRVjGHvBMAWK5mtq3VQ/1VOfS1DTDC75DOUpbY8uCsa+iTvgnLlhQ13q0I77o+JRzYtvIDn57oi7/ZzM4LtNNVDTyn5WZwblclTMuRo3xlh+Lj1hTvJE8/dODFkkJDr7zjxgNhG3TVSC9G594K1RalT3sz8Mtw8gHcn56qbk8sF6s9tWCuqkHtrXO9f74JTfXa1e/JPahTlyrJ3YKWU34mGm1YMjzQQJS3C3B809hDL0BbVW8/DvFJAfU3z2ryhzLq+olle2cocHTckNvFeo3hrxgPsrPbQpUL20E/m3LSVH4T3yzH2SC97k9eK3wm8uvhqt1TW4DhnmduQueD8U1AQEerpN0jxRHLjRUS2yj/S1uVcXOtGfEAWbnEbiiPsuZl/0WnksNdfwP6aWCpn55nVzERbQjLK//jJXadJ53+CXTkIc1US70U4Ia4xj+aTj+jnBpbIQ2n03R18lCtXjeg88zXQgDNYj1ufh7pyMeRjz+vxrzH3mbxGUAN8Nlzs+dhZLcW9mbVg/guFbgQhbCeOZCa8evDqTQobL4uW5xgIOIgjMhiYkmHoZA3vXfbr7lZLCBU05nohG6WS/bUEgTUu3Cm2hzF3mEODEWYpAY2iv/p/2gjl5i/pIrfFl1866fD5TvpRgvdHByXoI1WSYqNTC3FF6Vnkh4zp85YRYSVh/LjrdNqPq0DxANfMpoE3bkMiu24AP8yJis8Mg3OMz1bnWSn+hPq+uxcCC6TMFGc3+/EbCgzfuQjbr3yvtj9ggg8BUtYSLEltEzTKfZ+nOkrPPUmn5w5v60O5jjcjBIVP/o5eyn76TJxS0fFqSzWYYO2XIC4REBpeg4V7KK+MDPV2Ft9Hp5yO1LTg7GYKRC5jwCgxpy7YoUsBOVs6WZrDplOZ1Qo50bT4pkeYOr3Yd/Rh44GWXZhpMptkQCS9hKRU0q7bhL7czAMCn3Yq5v+IP6Dt0O/Zlu+ID8fO6n+MreURE+RqYtqv8jBSnHKeYWFIK8KJBkAEnGLfnXSeCVW7ycTngcDrZkUz/FFzmj20pFyfnJ7mVp+s9BWyUfbeChV2SsHsChdezUIF76gw0IvWvZNwovHLFeed/AkWsxliYMgg9cj2IwQq+J29FfjBsnAT7TLDhvyHykrcwB2JbnVvyiboELrbGG3VC8VrFdzo/Rgv3vsQTsCcV4l+aTJw4fqMW4Uac7TGmgI+v/gxNHG70Hr9PRHbFS3BrI+3UHi8HTHdgDu3bIaeB0sHupfyQL3J+7nIoCMDL5+MfYYVFEmzUpn1oE4WCQz/wyQfDIjseH8fA+hVkmKGhc62L2imo0Tp0qDkKBn+ASDdCGgsSQhhN641kzHzgPqTxHpE2BOxu9fgpIFbP/xSaZixzIb0GZ7mvWaDQhBkxtToJdJbLXMXrp9D/SCk6zszlTdMjSRXkjPIlM8+vZ+mR96evVOQjH2JbZbOCZmbz0CCkfgRlqPnPyVVkGMoeDAapbyMJIIyqcIycEdi+89WrjzZQoGW1ABryK/w9iLVSAB0qHJV97ceOtfPn4G3L2f2JdTvdUOGwZpk6L/1HjKomDvAHThQDm6CUtO5J1Gi4r2y1WR+e0ZtfD9GnW5sC4i3X+RZ1LcUx9abVvWdzo6Ck0e7kVsW9LrHhzhrGRzH7+rEmGxKNuGP55z/NveDnBw8u8swIlqiypHPs3sk7rp65OjB1PfqrcYsW93k3oTbzx5SYTit84iqvIr5lghEY2FvzHS7Fn7RCEjptdpu+WEjADvjzRZLqSr3J+IlyCOYuF88QoOZTsxej2pK0zyzLucb3DC1xe30CjA2wvFuQVrzC/cZJAnmMm/7GPcxqRDh7myYWZCuQJUb4KMace2U6/Zs0fV/wVc0Grh2AqvyxplouKAVkipmN8oAyA1ZzvZzU2pL4qT4CNfmH2UtwAIuXvP/+JTpTkzdsFEItAIBcjsZVDPwjECwdgQYAdDhxjVwVkAqRbyFUrFBmBtMZuqmwaj607sHOqTo8Ks6XKDk/KQULempO4u1ZDb4tz2c1+RSyrz9978xPAo/mdif+TEKtiy6fQQdjYATs7B/vTRRZSe0yh5ZZKME9xB8/Bk2A8gk9dJoFKJSxaEt+zF2BNgda/mzRJgrpDZcHK8VYxP3T9/BMKwom2cX7HPK+vRrlbCyNTH5pOMIJYex6WZPChTXhLIrQvJITzRn+BkGS2PCLq9wLahGaZjmon+CoIpgNJOLqieFEi7kDg2l3Z14LGJwvuY7FpcMIqrqmw90RD9q+NAb+qkbhthXRE+/emz4ufrOKwW/n2O/JF4u4xGFkST+eXA1R5p//EShRtVoa1EIMVNf1j8AHTLcKDZMfa/71+6V/qQSI9C5xxGwpELIjVOU9KeKsFgLFkoQtVgmHbj383NdH6Bpx2/sPPvR9ROFK/ytirZuMkIqRMCzLfvcztkDOSPaVPxsWuwBgCggfhaJYAdQDNRO49KpPoxNNjfXhHnatXUkPu0Mp8eawI4UXhgV9HooiSWKu0JYSKUvbto+iB4FBsrtkEMbBdhBlSlpYMOxVvTBZNKzf1AV+GYgOBpn6YJFPSsrFVsIb80Wm7piM9bl2P9uISEl2gDDjtxW9X/XzrvX3JwalshINJH615L0UEp++jHiYxDRXT4YriO8WxezxhPWzdL07BHJPgA3ytMtd0N4dQEkA+XqZGZ1YNt0wgwN/iu5fd4DIVpta4+VT8M/QI7uoT9FQL975BdLuM9Qol27kQ3FnZRf4f2IDxlX5kd4lM6Tm1vk19inUWzOfE/xIHPwA8rWZkUBJ0WxGam6K0+bexDpYQJYviu+aStzOa0kFIhFiZoCUiyRRwA7eTf6zmuKRTyzTkias5iTA8OZAdZmCRgnQqePgfaiAFpwV/VKNmTDa1uYE3duPwEFZOb57Ih2HjgfFEHt8/VobFV2GUk0gBBBfQ5lDdcLk4hYo4Zzokn58AqUXV1CwFgKC3v/xDOsCmAkyHwQtRnHvXfspYSduRng8I5wdjAQsGcMEQOya28Q+ON1afsnWp6nTr6PmX3GwT33CRU0YmfJgMRk18YEYshHuTCIPi6AnPY+yKSs0h6dqzVkf8FTRdx4rx2TAEHxoQFv7FVQg9BWV9nGDhjMve7GVIyeBcQ3WViVS169fXSOjd1HvsLbScj4tYYQ+kZtfVdmiB6PepOgozGDNblIEorWZHyEahIC0w59wx0spxtjnM3FJNxE4gby7lBOgJB5SucvVPnIZP2v9PslhL03T++EbmhgYC53iPTDA9MkOBVbmUyI9KccithuhzcoMw80DBB3jjCmxIWRfFtZNHqPEcUocT4IzDSlhgi4V7J99VEW8h9COfWJxemwIkM0XleUj2HrvmIp+xRxPtP1vSbENBJogT4pPDm9SiieCAFwdbLeA89Vhv4Pwhriz5R0pFe+DAR/DuTuxXbWE/zj6xDBpeWm7vPsHd3PRwirVYd8UcyudhFC6Gs/aDLMQwueqM46P6VCVSf2ytOGr6wUcIqJoaVOnqBARZ2E5TvKVF6JZlddsD79nK6pY/VtVw7vLQ8d/+JBPwA6HygDZyn7jyj9UR/0IJoTrPAh8RsQOD8WWkVakcW7O8ZLDKk3KiJEwSrZRbCjYbx8RvG8HoJxU56Md07FawY1fWbnQXHSlt1988Vmxw3+4MFL9MIn+gQel2augZ/sI7VkJ4cm+wIqo7Uj+hlgCbLwEAHhTqVwWMKyYuSl8vta+GdgzbJkv9cZ3i6YGvHOXw4/EsivoGlQmbuw7XMIP10KjXbOiktzxnruvwHDqip183mYIJsDhKcCYtY0nrZR7/JLXaUQANVxSYelPzMV6tUw4RAiZORajJ3Z9EE1bbB1ytZIj/UXtu2TgYk4xt8FYLP+AkLox+HfMoPHtdYT6CSqd0konxp2XPTMRN1DoZXzcwQ0lNk85UpMjRKp+XPG3dls43FXQyhECboJyA1jhrvqwmGrF28sFaR68Ia/DyB36rJnx+Jq6L1iqnZRxyfsy+0mojz0MmYFDxBhejw1vMogCjQYoULUmwAEVEnQXg+YGW3YhvLgjscKhHE9AE33yDoxJF/n80y/cCeJYyTrBhqCUmAtUldud3V3gKjdIrWjTe/zWBw9vhtirhnfK8fIwmcbeNtI2xwbB9XPxn4WYEab86iyunmmMjzuQ1uftHExeQPF2xiVIUBebW6OjzrmbndEJAb+n7/z9t7QKXB86fqOe2AUAcKb/7QX4piAYy+A/EIv1X00xE93DHg1BZwTpIVT2PeMkxQ7bJlqv/0p7+CgrevgQrb4ox+vkfiFONnv5IcaEVLyvXZJhbJoZW8z+na1aDNA+KfTl6oG/vPZzQwQlP35SKCCHTGGQ6zwC2bztPAfit//DT9xb/0EvmrZZwAUCc85A+n+L7WlBxud2A3jFhB7Yef6KHSu8JbLP3hIOWX4tOuHN0k6DiOvPAZvArEyffyQqoZ2MygFvo06ZsRw4fN/K6AVIdqXP5smSHK1eIjXBdlV3nq+9V5K9GWx/GgcQREd2/z40RVXdFYvq3AMSVz4U1RJQsuR9mLDckkYA1SZYc91W5AcARU2HDTY21NxpRkqZHlYm96RBgDbQvQ0ZgHEsyaFwZvwWQUbdod9dTiCDKAbgunY2U0UOwAsPX5C6hqGswxs5wBbwZAo5iCvOZv9eZkacY/iCI6UfhDTXG8sCyy6XnROy31RPNS5Z6fnqddsKBZwZoOKcXAM7W7eVOd4CaGU6w/JMSTZg8nTjKdW3S+TfpXmz2Vj/uw0S//+kenSHgxtl9zoruCQTgD2U5FWh5bLGwf8DUEsHCET0D3PEIQAAKiIAAFBLAwQKAAAAAAAZs1lRAAAAAAAAAAAAAAAACQAQAF9fTUFDT1NYL1VYDAChfJVfoXyVX/UBFABQSwMEFAAIAAgA/ZpZUQAAAAAAAAAAAAAAABUAEABfX01BQ09TWC8uX2dvcGhlci5wbmdVWAwAdXyVXz1SlV/1ARQAjZHNihNBEMdrJoi7eNmLgrcgJqDQPT3T3ZmZjYMM2Q2ILIJm0VuoTHomo5nvDll8AY++gvhWPoGv4NHOJkFcPFh0VXUXv4J/VcO9R/fBBrjCpP/mXf9D/2C7Gpwa9wDsa5PN2/4C/2XxbPZ2f7vt+AVgfb2D9PZ1eA7wNKkKinW9VrRQGpeo8Xz+6erilVbFdafaGWbdrsV6YvwZwOAf/AF/v1KtmrZVsWuwX5vwAuDhH77ZYIulzksFi3qdd5qxbyd7QZZ1UGbdUXp6JL9b9vzsx0rrujt3nCbPNZKN0UeSqtSq1B3Niyy/oaXSzi014PHAm5qzZ/MCM0U6XbWKdpxigZ+rErcdNfoMxIy7IpSeyVxwEWKKJHCDkHCejAgLfUaYJ9B3U8UTTGldZi/zm3W+iNoFcalHvSFudBWlVVugHmZ5SppoxIZN5MthF6kEA84FU6EvJAtEwlGO3CCQbLlMRqGYnz0+zrbdbmlWVZnZWFLRj7UDJ/AAfh6+9Lig3t97snqNw1jgjmUaSmmEjicr8xNqLOLLqe/HgrjCBBHzCYldM8zlVMp4wieue8HgN1BLBwiDtX90sQEAAIcCAABQSwECFQMUAAgACAD9mllRRPQPc8QhAAAqIgAACgAMAAAAAAAAAABApIEAAAAAZ29waGVyLnBuZ1VYCAB1fJVfPVKVX1BLAQIVAwoAAAAAABmzWVEAAAAAAAAAAAAAAAAJAAwAAAAAAAAAAED9QQwiAABfX01BQ09TWC9VWAgAoXyVX6F8lV9QSwECFQMUAAgACAD9mllRg7V/dLEBAACHAgAAFQAMAAAAAAAAAABApIFDIgAAX19NQUNPU1gvLl9nb3BoZXIucG5nVVgIAHV8lV89UpVfUEsFBgAAAAADAAMA1gAAAEckAAAAAA=="
+  "image": "iVBORw0KGgoAAAANSUhEUgAAAAIAAAACCAYAAABytg0kAAAAH0lEQVR4nAASAO3/Av8AAP8AAAAAAAAAAAAAAAAAAwAfFwIBJnyuSgAAAABJRU5ErkJggg=="
 }
 `
 var errReqRegisterPostingDecodeFailure = `
@@ -59,12 +99,50 @@ var errReqRegisterPostingDecodeFailure = `
 }
 `
 
+var errReqRegisterPostingNotImage = `
+{
+  "title": "This is a sample posting.",
+  "image": "VGhpcyBpcyBub3QgYW4gaW1hZ2Uu"
+}
+`
+
+var successReqRegisterPostingImages = `
+{
+  "title": "This is a sample posting.",
+  "images": [
+    "iVBORw0KGgoAAAANSUhEUgAAAAIAAAACCAYAAABytg0kAAAAH0lEQVR4nAASAO3/Av8AAP8AAAAAAAAAAAAAAAAAAwAfFwIBJnyuSgAAAABJRU5ErkJggg==",
+    "iVBORw0KGgoAAAANSUhEUgAAAAIAAAACCAYAAABytg0kAAAAH0lEQVR4nAASAO3/Av8AAP8AAAAAAAAAAAAAAAAAAwAfFwIBJnyuSgAAAABJRU5ErkJggg=="
+  ]
+}
+`
+var errReqRegisterPostingImageAndImages = `
+{
+  "title": "This is a sample posting.",
+  "image": "iVBORw0KGgoAAAANSUhEUgAAAAIAAAACCAYAAABytg0kAAAAH0lEQVR4nAASAO3/Av8AAP8AAAAAAAAAAAAAAAAAAwAfFwIBJnyuSgAAAABJRU5ErkJggg==",
+  "images": [
+    "iVBORw0KGgoAAAANSUhEUgAAAAIAAAACCAYAAABytg0kAAAAH0lEQVR4nAASAO3/Av8AAP8AAAAAAAAAAAAAAAAAAwAfFwIBJnyuSgAAAABJRU5ErkJggg=="
+  ]
+}
+`
+
 var errRespRegisterPostingWithoutImage = `
 {
   "status": 400,
   "message": "image: cannot be blank."
 }
 `
+var errRespRegisterPostingUploadNotFound = `
+{
+  "status": 400,
+  "message": "the upload doesn't exist"
+}
+`
+var errRespRegisterPostingUploadKeysWithImage = `
+{
+  "status": 400,
+  "message": "image: must be blank when upload_keys is set."
+}
+`
 var errRespRegisterPostingWithoutTitle = `
 {
   "status": 400,
@@ -74,7 +152,13 @@ var errRespRegisterPostingWithoutTitle = `
 var errRespRegisterPostingUnderBarTitle = `
 {
   "status": 400,
-  "message": "title: must not contain _."
+  "message": "title: must not contain _ outside hashtags."
+}
+`
+var errRespRegisterPostingPastPublishAt = `
+{
+  "status": 400,
+  "message": "publish_at: must be a future time."
 }
 `
 var errRespRegisterPostingTitleShort = `
@@ -89,10 +173,97 @@ var errRespRegisterPostingDecodeFailure = `
   "message": "image decode failure"
 }
 `
+var errRespRegisterPostingNotImage = `
+{
+  "status": 400,
+  "message": "unsupported image type"
+}
+`
+var errRespRegisterPostingNotCat = `
+{
+  "status": 400,
+  "message": "you can post only a cat image"
+}
+`
+var errRespRegisterPostingDuplicate = `
+{
+  "status": 400,
+  "message": "you have already posted the same image recently"
+}
+`
+var errRespRegisterPostingTooLarge = `
+{
+  "status": 400,
+  "message": "image is too large"
+}
+`
+var errRespRegisterPostingTooManyImages = `
+{
+  "status": 400,
+  "message": "images: the length must be between 1 and 10."
+}
+`
+var errRespRegisterPostingMultipartTooManyImages = `
+{
+  "status": 400,
+  "message": "image: the number of images must be no more than 10."
+}
+`
+var errRespRegisterPostingImageAndImages = `
+{
+  "status": 400,
+  "message": "image: must be blank when images is set."
+}
+`
+
+// newMultipartRegisterPosting builds a multipart body whose parts are in the given order.
+func newMultipartRegisterPosting(fields [][2]string) (body string, contentType string) {
+	var b bytes.Buffer
+	mw := multipart.NewWriter(&b)
+	for _, f := range fields {
+		if f[0] == "image" {
+			img, _ := base64.StdEncoding.DecodeString(f[1])
+			fw, _ := mw.CreateFormFile(f[0], "image.png")
+			_, _ = fw.Write(img)
+		} else {
+			_ = mw.WriteField(f[0], f[1])
+		}
+	}
+	_ = mw.Close()
+	return b.String(), mw.FormDataContentType()
+}
+
+var testPNG = "iVBORw0KGgoAAAANSUhEUgAAAAIAAAACCAYAAABytg0kAAAAH0lEQVR4nAASAO3/Av8AAP8AAAAAAAAAAAAAAAAAAwAfFwIBJnyuSgAAAABJRU5ErkJggg=="
+var successMultipartRegisterPosting, successMultipartRegisterPostingContentType = newMultipartRegisterPosting([][2]string{{"title", "This is a sample posting."}, {"image", testPNG}})
+var successMultipartRegisterPostingAltText, successMultipartRegisterPostingAltTextContentType = newMultipartRegisterPosting([][2]string{{"title", "This is a sample posting."}, {"alt_text", "A tabby cat sleeping on the sofa"}, {"image", testPNG}})
+var errMultipartRegisterPostingWithoutImage, errMultipartRegisterPostingWithoutImageContentType = newMultipartRegisterPosting([][2]string{{"title", "This is a sample posting."}})
+var errMultipartRegisterPostingImageFirst, errMultipartRegisterPostingImageFirstContentType = newMultipartRegisterPosting([][2]string{{"image", testPNG}, {"title", "This is a sample posting."}})
+var successMultipartRegisterPostingImages, successMultipartRegisterPostingImagesContentType = newMultipartRegisterPosting([][2]string{{"title", "This is a sample posting."}, {"image", testPNG}, {"image", testPNG}})
+var errMultipartRegisterPostingTooManyImages, errMultipartRegisterPostingTooManyImagesContentType = newMultipartRegisterPosting(append([][2]string{{"title", "This is a sample posting."}}, testImageFields(11)...))
+
+func testImageFields(n int) (fields [][2]string) {
+	for i := 0; i < n; i++ {
+		fields = append(fields, [2]string{"image", testPNG})
+	}
+	return
+}
+
+func testReqRegisterPostingImages(n int) string {
+	var images []string
+	for i := 0; i < n; i++ {
+		images = append(images, `"`+testPNG+`"`)
+	}
+	return `{"title": "This is a sample posting.", "images": [` + strings.Join(images, ",") + `]}`
+}
 
 func TestRegisterPosting(t *testing.T) {
 	type args struct {
-		reqBody string
+		reqBody      string
+		contentType  string
+		imageMaxByte int64
+		labels       []model.Label
+		// posted by the same user in advance
+		postedReqBody string
 	}
 	tests := []struct {
 		name       string
@@ -100,6 +271,9 @@ func TestRegisterPosting(t *testing.T) {
 		method     string
 		want       string
 		wantStatus int
+		wantImages int
+		// the labels of the image when empty
+		wantAltText string
 	}{
 		{
 			name:       "success",
@@ -107,6 +281,119 @@ func TestRegisterPosting(t *testing.T) {
 			method:     http.MethodPost,
 			want:       testingHelper.RespSimpleSuccess,
 			wantStatus: http.StatusOK,
+			wantImages: 1,
+		},
+		{
+			name:       "success multipart",
+			args:       args{reqBody: successMultipartRegisterPosting, contentType: successMultipartRegisterPostingContentType},
+			method:     http.MethodPost,
+			want:       testingHelper.RespSimpleSuccess,
+			wantStatus: http.StatusOK,
+			wantImages: 1,
+		},
+		{
+			name:       "success multiple images",
+			args:       args{reqBody: successReqRegisterPostingImages},
+			method:     http.MethodPost,
+			want:       testingHelper.RespSimpleSuccess,
+			wantStatus: http.StatusOK,
+			wantImages: 2,
+		},
+		{
+			name:       "success multipart multiple images",
+			args:       args{reqBody: successMultipartRegisterPostingImages, contentType: successMultipartRegisterPostingImagesContentType},
+			method:     http.MethodPost,
+			want:       testingHelper.RespSimpleSuccess,
+			wantStatus: http.StatusOK,
+			wantImages: 2,
+		},
+		{
+			name:        "success alt text",
+			args:        args{reqBody: successReqRegisterPostingAltText},
+			method:      http.MethodPost,
+			want:        testingHelper.RespSimpleSuccess,
+			wantStatus:  http.StatusOK,
+			wantImages:  1,
+			wantAltText: "A tabby cat sleeping on the sofa",
+		},
+		{
+			name:        "success multipart alt text",
+			args:        args{reqBody: successMultipartRegisterPostingAltText, contentType: successMultipartRegisterPostingAltTextContentType},
+			method:      http.MethodPost,
+			want:        testingHelper.RespSimpleSuccess,
+			wantStatus:  http.StatusOK,
+			wantImages:  1,
+			wantAltText: "A tabby cat sleeping on the sofa",
+		},
+		{
+			name:       "error too many images",
+			args:       args{reqBody: testReqRegisterPostingImages(11)},
+			method:     http.MethodPost,
+			want:       errRespRegisterPostingTooManyImages,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "error multipart too many images",
+			args:       args{reqBody: errMultipartRegisterPostingTooManyImages, contentType: errMultipartRegisterPostingTooManyImagesContentType},
+			method:     http.MethodPost,
+			want:       errRespRegisterPostingMultipartTooManyImages,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "error image and images",
+			args:       args{reqBody: errReqRegisterPostingImageAndImages},
+			method:     http.MethodPost,
+			want:       errRespRegisterPostingImageAndImages,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "error multipart empty image",
+			args:       args{reqBody: errMultipartRegisterPostingWithoutImage, contentType: errMultipartRegisterPostingWithoutImageContentType},
+			method:     http.MethodPost,
+			want:       errRespRegisterPostingWithoutImage,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "error multipart image before title",
+			args:       args{reqBody: errMultipartRegisterPostingImageFirst, contentType: errMultipartRegisterPostingImageFirstContentType},
+			method:     http.MethodPost,
+			want:       errRespRegisterPostingWithoutTitle,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "error not image",
+			args:       args{reqBody: errReqRegisterPostingNotImage},
+			method:     http.MethodPost,
+			want:       errRespRegisterPostingNotImage,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "error too large image",
+			args:       args{reqBody: successReqRegisterPosting, imageMaxByte: 10},
+			method:     http.MethodPost,
+			want:       errRespRegisterPostingTooLarge,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "error not cat image",
+			args:       args{reqBody: successReqRegisterPosting, labels: []model.Label{{Description: "Dog", Score: 0.98}, {Description: "Whiskers", Score: 0.9}}},
+			method:     http.MethodPost,
+			want:       errRespRegisterPostingNotCat,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "error cat label under threshold",
+			args:       args{reqBody: successReqRegisterPosting, labels: []model.Label{{Description: "Cat", Score: 0.3}}},
+			method:     http.MethodPost,
+			want:       errRespRegisterPostingNotCat,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "error duplicate image",
+			args:       args{reqBody: successReqRegisterPosting, postedReqBody: successReqRegisterPosting},
+			method:     http.MethodPost,
+			want:       errRespRegisterPostingDuplicate,
+			wantStatus: http.StatusBadRequest,
 		},
 		{
 			name:       "error empty image",
@@ -115,6 +402,20 @@ func TestRegisterPosting(t *testing.T) {
 			want:       errRespRegisterPostingWithoutImage,
 			wantStatus: http.StatusBadRequest,
 		},
+		{
+			name:       "error upload of other user",
+			args:       args{reqBody: errReqRegisterPostingUploadOfOtherUser},
+			method:     http.MethodPost,
+			want:       errRespRegisterPostingUploadNotFound,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "error upload_keys with image",
+			args:       args{reqBody: errReqRegisterPostingUploadKeysWithImage},
+			method:     http.MethodPost,
+			want:       errRespRegisterPostingUploadKeysWithImage,
+			wantStatus: http.StatusBadRequest,
+		},
 		{
 			name:       "error empty title",
 			args:       args{reqBody: errReqRegisterPostingWithoutTitle},
@@ -129,6 +430,13 @@ func TestRegisterPosting(t *testing.T) {
 			want:       errRespRegisterPostingUnderBarTitle,
 			wantStatus: http.StatusBadRequest,
 		},
+		{
+			name:       "error past publish_at",
+			args:       args{reqBody: errReqRegisterPostingPastPublishAt},
+			method:     http.MethodPost,
+			want:       errRespRegisterPostingPastPublishAt,
+			wantStatus: http.StatusBadRequest,
+		},
 		{
 			name:       "error short title",
 			args:       args{reqBody: errReqRegisterPostingTitleShort},
@@ -166,9 +474,32 @@ func TestRegisterPosting(t *testing.T) {
 			err = userRepo.Create(context.Background(), &dummy.User2)
 			assert.NoError(t, err)
 
+			if tt.args.labels != nil {
+				defaultNewImageClassifier := newImageClassifier
+				newImageClassifier = func() classifier.ImageClassifier { return classifier.NewFakeClassifier(tt.args.labels) }
+				defer func() { newImageClassifier = defaultNewImageClassifier }()
+			}
+			if tt.args.imageMaxByte != 0 {
+				defaultImageMaxByte := helper.ImageMaxByte
+				helper.ImageMaxByte = tt.args.imageMaxByte
+				defer func() { helper.ImageMaxByte = defaultImageMaxByte }()
+			}
+			if tt.args.postedReqBody != "" {
+				req, err := http.NewRequest(http.MethodPost, "/postings", strings.NewReader(tt.args.postedReqBody))
+				assert.NoError(t, err)
+				req = req.WithContext(httpContext.SetTokenUserID(req.Context(), dummy.User1.ID))
+				req = req.WithContext(httpContext.SetTokenUserName(req.Context(), dummy.User1.Name))
+				resp := httptest.NewRecorder()
+				PostingController(resp, req)
+				assert.Equal(t, http.StatusOK, resp.Code)
+			}
+
 			// http request
 			req, err := http.NewRequest(tt.method, "/postings", strings.NewReader(tt.args.reqBody))
 			assert.NoError(t, err)
+			if tt.args.contentType != "" {
+				req.Header.Set(helper.HeaderKeyContentType, tt.args.contentType)
+			}
 			req = req.WithContext(httpContext.SetTokenUserID(req.Context(), dummy.User1.ID))
 			req = req.WithContext(httpContext.SetTokenUserName(req.Context(), dummy.User1.Name))
 			resp := httptest.NewRecorder()
@@ -181,11 +512,29 @@ func TestRegisterPosting(t *testing.T) {
 			if tt.wantStatus == http.StatusOK {
 				postings, err := testingHelper.FindAllPostings(context.Background(), db)
 				assert.NoError(t, err)
-				dummy.Posting1.CreatedAt = lib.NowFunc()
-				dummy.Posting1.UpdatedAt = lib.NowFunc()
+				// the key is derived from the content of the image under the user's prefix
+				assert.Regexp(t, `^http://localhost:9000/toebeans-postings/1/[0-9a-f]{64}_full\.jpg$`, postings[0].ImageURL)
+				want := dummy.Posting1
+				want.ImageURL = postings[0].ImageURL
+				if tt.wantAltText != "" {
+					want.AltText = tt.wantAltText
+				}
+				want.CreatedAt = lib.NowFunc()
+				want.UpdatedAt = lib.NowFunc()
 				postings[0].CreatedAt = lib.NowFunc()
 				postings[0].UpdatedAt = lib.NowFunc()
-				assert.Equal(t, dummy.Posting1, postings[0])
+				assert.Equal(t, want, postings[0])
+
+				images, err := testingHelper.FindAllPostingImages(context.Background(), db)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantImages, len(images))
+				for i, image := range images {
+					assert.Equal(t, postings[0].ID, image.PostingID)
+					assert.Equal(t, int8(i), image.Position)
+					assert.NotNil(t, image.DHash)
+					assert.Equal(t, "http://localhost:9000/toebeans-postings/"+imaging.VariantKey(image.ObjectKey, imaging.VariantFull), image.ImageURL)
+				}
+				assert.Equal(t, postings[0].ImageURL, images[0].ImageURL)
 			}
 
 			// assert http
@@ -198,6 +547,58 @@ func TestRegisterPosting(t *testing.T) {
 	}
 }
 
+func TestParseMultipartRegisterPostingSpooledImages(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		ct      string
+		wantErr bool
+	}{
+		{
+			name: "success",
+			body: successMultipartRegisterPostingImages,
+			ct:   successMultipartRegisterPostingImagesContentType,
+		},
+		{
+			name:    "error too many images",
+			body:    errMultipartRegisterPostingTooManyImages,
+			ct:      errMultipartRegisterPostingTooManyImagesContentType,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// spool into a directory of this test only
+			tmpDir := t.TempDir()
+			orgTmpDir := os.Getenv("TMPDIR")
+			os.Setenv("TMPDIR", tmpDir)
+			defer os.Setenv("TMPDIR", orgTmpDir)
+
+			req, err := http.NewRequest(http.MethodPost, "/postings", strings.NewReader(tt.body))
+			assert.NoError(t, err)
+			req.Header.Set(helper.HeaderKeyContentType, tt.ct)
+
+			// test target
+			_, imgs, err := parseMultipartRegisterPosting(req)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, imgs, 2)
+				b, err := ioutil.ReadAll(imgs[0])
+				assert.NoError(t, err)
+				img, _ := base64.StdEncoding.DecodeString(testPNG)
+				assert.Equal(t, img, b)
+				removeSpooledImages(imgs)
+			}
+			files, err := ioutil.ReadDir(tmpDir)
+			assert.NoError(t, err)
+			assert.Empty(t, files)
+		})
+	}
+}
+
 var successRespGetPostings = `
 {
   "postings": [
@@ -206,7 +607,20 @@ var successRespGetPostings = `
       "user_name": "testUser1",
       "uploaded_at": "2020-01-01T00:00:00+09:00",
       "title": "This is a sample posting.",
-      "image_url": "http://localhost:9000/toebeans-postings/20200101000000_testUser1",
+      "alt_text": "Cat, Whiskers, Tabby cat",
+      "image_url": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_full.jpg",
+      "image_urls": {
+        "thumb": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_thumb.jpg",
+        "feed": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_feed.jpg",
+        "full": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_full.jpg"
+      },
+      "images": [
+        {
+          "thumb": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_thumb.jpg",
+          "feed": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_feed.jpg",
+          "full": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_full.jpg"
+        }
+      ],
       "liked_count": 0,
       "liked": false
     }
@@ -218,10 +632,10 @@ var successRespGetPostingsEmpty = `
   "postings": []
 }
 `
-var errRespGetPostingsWithoutSinceAt = `
+var errRespGetPostingsInvalidCursor = `
 {
   "status": 400,
-  "message": "since_at: cannot be blank."
+  "message": "cursor: is invalid."
 }
 `
 var errRespGetPostingsWithoutLimit = `
@@ -239,6 +653,7 @@ var errRespGetPostingsNotExistsUserName = `
 
 func TestGetPostings(t *testing.T) {
 	type args struct {
+		cursor   string
 		sinceAt  string
 		limit    string
 		userName string
@@ -272,10 +687,24 @@ func TestGetPostings(t *testing.T) {
 			wantStatus: http.StatusOK,
 		},
 		{
-			name:       "error empty since_at",
+			name:       "success without cursor",
 			args:       args{limit: "50"},
 			method:     http.MethodGet,
-			want:       errRespGetPostingsWithoutSinceAt,
+			want:       successRespGetPostings,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "success with cursor",
+			args:       args{cursor: helper.EncodeCursor(model.Cursor{CreatedAt: time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)}), limit: "50"},
+			method:     http.MethodGet,
+			want:       successRespGetPostings,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "error invalid cursor",
+			args:       args{cursor: "2100-01-01T00:00:00+09:00", limit: "50"},
+			method:     http.MethodGet,
+			want:       errRespGetPostingsInvalidCursor,
 			wantStatus: http.StatusBadRequest,
 		},
 		{
@@ -323,9 +752,9 @@ func TestGetPostings(t *testing.T) {
 			// http request
 			var req *http.Request
 			if tt.args.userName == "" {
-				req, err = http.NewRequest(tt.method, fmt.Sprintf("/postings?since_at=%s&limit=%s", tt.args.sinceAt, tt.args.limit), nil)
+				req, err = http.NewRequest(tt.method, fmt.Sprintf("/postings?cursor=%s&since_at=%s&limit=%s", tt.args.cursor, tt.args.sinceAt, tt.args.limit), nil)
 			} else {
-				req, err = http.NewRequest(tt.method, fmt.Sprintf("/postings?since_at=%s&limit=%s&user_name=%s", tt.args.sinceAt, tt.args.limit, tt.args.userName), nil)
+				req, err = http.NewRequest(tt.method, fmt.Sprintf("/postings?cursor=%s&since_at=%s&limit=%s&user_name=%s", tt.args.cursor, tt.args.sinceAt, tt.args.limit, tt.args.userName), nil)
 			}
 			assert.NoError(t, err)
 			req = req.WithContext(httpContext.SetTokenUserName(req.Context(), dummy.User1.Name))
@@ -340,27 +769,131 @@ func TestGetPostings(t *testing.T) {
 			respBodyByte, err := ioutil.ReadAll(resp.Body)
 			assert.NoError(t, err)
 			respBody := string(respBodyByte)
-			assert.JSONEq(t, tt.want, respBody)
+			assert.JSONEq(t, tt.want, testingHelper.StripURLSignatures(respBody))
 		})
 	}
 }
 
-var errRespDeletePostingWithoutPostingID = `
+func TestGetPostingsCursor(t *testing.T) {
+	// init
+	db := testingHelper.SetupDBTest()
+	defer testingHelper.TeardownDBTest(db)
+	testingHelper.SetTestTime()
+	defer testingHelper.ResetTime()
+
+	// insert dummy data which are created in the same second
+	userRepo := repository.NewUserRepository(db)
+	err := userRepo.Create(context.Background(), &dummy.User1)
+	assert.NoError(t, err)
+	postingRepo := repository.NewPostingRepository(db)
+	for i := 0; i < 2; i++ {
+		p := dummy.Posting1
+		err = postingRepo.Create(context.Background(), &p)
+		assert.NoError(t, err)
+	}
+	err = testingHelper.UpdateNow(db, "postings")
+	assert.NoError(t, err)
+
+	// follow next_cursor until the last page
+	var gotIDs []int64
+	cursor := ""
+	for i := 0; i < 3; i++ {
+		req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("/postings?cursor=%s&limit=1", cursor), nil)
+		assert.NoError(t, err)
+		req = req.WithContext(httpContext.SetTokenUserName(req.Context(), dummy.User1.Name))
+		resp := httptest.NewRecorder()
+
+		PostingController(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		var body modelHTTP.ResponseGetPostings
+		err = json.NewDecoder(resp.Body).Decode(&body)
+		assert.NoError(t, err)
+		for _, p := range body.Postings {
+			gotIDs = append(gotIDs, p.PostingId)
+		}
+		if body.NextCursor == "" {
+			break
+		}
+		cursor = body.NextCursor
+	}
+	assert.Equal(t, 2, len(gotIDs))
+	assert.True(t, gotIDs[0] > gotIDs[1])
+}
+
+var successRespGetPopularPostings = `
+{
+  "postings": [
+    {
+      "posting_id": 1,
+      "user_name": "testUser1",
+      "uploaded_at": "2020-01-01T00:00:00+09:00",
+      "title": "This is a sample posting.",
+      "alt_text": "Cat, Whiskers, Tabby cat",
+      "image_url": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_full.jpg",
+      "image_urls": {
+        "thumb": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_thumb.jpg",
+        "feed": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_feed.jpg",
+        "full": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_full.jpg"
+      },
+      "images": [
+        {
+          "thumb": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_thumb.jpg",
+          "feed": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_feed.jpg",
+          "full": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_full.jpg"
+        }
+      ],
+      "liked_count": 0,
+      "liked": false
+    },
+    {
+      "posting_id": 2,
+      "user_name": "testUser2",
+      "uploaded_at": "2020-01-01T00:00:00+09:00",
+      "title": "test title",
+      "alt_text": "test alt text",
+      "image_url": "test url",
+      "image_urls": {
+        "thumb": "test url",
+        "feed": "test url",
+        "full": "test url"
+      },
+      "images": [
+        {
+          "thumb": "test url",
+          "feed": "test url",
+          "full": "test url"
+        }
+      ],
+      "liked_count": 1,
+      "liked": true
+    }
+  ]
+}
+`
+var errRespGetPopularPostingsInvalidPeriod = `
 {
   "status": 400,
-  "message": "cannot be blank"
+  "message": "period: must be a valid value"
 }
 `
-var errRespDeletePostingNotExistingID = `
+var errRespGetPopularPostingsZeroLimit = `
 {
   "status": 400,
-  "message": "not exists data error"
+  "message": "limit: must be no less than 1"
+}
+`
+var errRespGetPopularPostingsTooLargeLimit = `
+{
+  "status": 400,
+  "message": "limit: must be no greater than 127"
 }
 `
 
-func TestDeletePosting(t *testing.T) {
+func TestGetPopularPostings(t *testing.T) {
 	type args struct {
-		postingID int64
+		period string
+		limit  string
 	}
 	tests := []struct {
 		name       string
@@ -371,27 +904,931 @@ func TestDeletePosting(t *testing.T) {
 	}{
 		{
 			name:       "success",
-			args:       args{postingID: dummy.Posting1.ID},
-			method:     http.MethodDelete,
-			want:       testingHelper.RespSimpleSuccess,
+			args:       args{limit: "50"},
+			method:     http.MethodGet,
+			want:       successRespGetPopularPostings,
 			wantStatus: http.StatusOK,
 		},
 		{
-			name:       "error empty posting_id",
+			name:       "success all",
+			args:       args{period: "all", limit: "50"},
+			method:     http.MethodGet,
+			want:       successRespGetPopularPostings,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "error invalid period",
+			args:       args{period: "month", limit: "50"},
+			method:     http.MethodGet,
+			want:       errRespGetPopularPostingsInvalidPeriod,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "error empty limit",
 			args:       args{},
-			method:     http.MethodDelete,
-			want:       errRespDeletePostingWithoutPostingID,
+			method:     http.MethodGet,
+			want:       errRespGetPostingsWithoutLimit,
 			wantStatus: http.StatusBadRequest,
 		},
 		{
-			name:       "error not existing posting_id",
-			args:       args{postingID: 99999},
-			method:     http.MethodDelete,
-			want:       errRespDeletePostingNotExistingID,
+			name:       "error zero limit",
+			args:       args{limit: "0"},
+			method:     http.MethodGet,
+			want:       errRespGetPopularPostingsZeroLimit,
 			wantStatus: http.StatusBadRequest,
 		},
 		{
-			name:       "error forbidden guest user",
+			name:       "error too large limit",
+			args:       args{limit: "128"},
+			method:     http.MethodGet,
+			want:       errRespGetPopularPostingsTooLargeLimit,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "not allowed method",
+			args:       args{},
+			method:     http.MethodPost,
+			want:       testingHelper.ErrNotAllowedMethod,
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// init
+			db := testingHelper.SetupDBTest()
+			defer testingHelper.TeardownDBTest(db)
+			testingHelper.SetTestTime()
+			defer testingHelper.ResetTime()
+
+			// insert dummy data. posting1 has a comment, which weighs more than the like of posting2.
+			userRepo := repository.NewUserRepository(db)
+			postingRepo := repository.NewPostingRepository(db)
+			likeRepo := repository.NewLikeRepository(db)
+			commentRepo := repository.NewCommentRepository(db)
+			err := userRepo.Create(context.Background(), &dummy.User1)
+			assert.NoError(t, err)
+			err = userRepo.Create(context.Background(), &dummy.User2)
+			assert.NoError(t, err)
+			err = postingRepo.Create(context.Background(), &dummy.Posting1)
+			assert.NoError(t, err)
+			err = postingRepo.Create(context.Background(), &dummy.Posting2)
+			assert.NoError(t, err)
+			err = testingHelper.UpdateNow(db, "postings")
+			assert.NoError(t, err)
+			err = likeRepo.Create(context.Background(), &dummy.Like1to2)
+			assert.NoError(t, err)
+			err = testingHelper.UpdateNow(db, "likes")
+			assert.NoError(t, err)
+			err = commentRepo.Create(context.Background(), &dummy.Comment1)
+			assert.NoError(t, err)
+			err = testingHelper.UpdateNow(db, "comments")
+			assert.NoError(t, err)
+			err = usecase.NewRefreshPostingScores(mysql.NewDBTransaction(db), repository.NewPostingScoreRepository(db)).RefreshPostingScoresUseCase(context.Background())
+			assert.NoError(t, err)
+			err = usecase.NewReconcileCounters(repository.NewPostingCounterRepository(db), repository.NewUserCounterRepository(db)).ReconcileCountersUseCase(context.Background())
+			assert.NoError(t, err)
+
+			// http request
+			req, err := http.NewRequest(tt.method, fmt.Sprintf("/postings/popular?period=%s&limit=%s", tt.args.period, tt.args.limit), nil)
+			assert.NoError(t, err)
+			req = req.WithContext(httpContext.SetTokenUserName(req.Context(), dummy.User1.Name))
+			resp := httptest.NewRecorder()
+
+			// test target
+			PostingController(resp, req)
+			assert.NoError(t, err)
+
+			// assert http
+			assert.Equal(t, tt.wantStatus, resp.Code)
+			respBodyByte, err := ioutil.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			respBody := string(respBodyByte)
+			assert.JSONEq(t, tt.want, testingHelper.StripURLSignatures(respBody))
+		})
+	}
+}
+
+var successRespGetPosting = `
+{
+  "posting_id": 1,
+  "user_name": "testUser1",
+  "user_icon": "UNKNOWN",
+  "uploaded_at": "2020-01-01T00:00:00+09:00",
+  "title": "This is a sample posting.",
+  "alt_text": "Cat, Whiskers, Tabby cat",
+  "image_url": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_full.jpg",
+  "image_urls": {
+    "thumb": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_thumb.jpg",
+    "feed": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_feed.jpg",
+    "full": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_full.jpg"
+  },
+  "images": [
+    {
+      "thumb": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_thumb.jpg",
+      "feed": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_feed.jpg",
+      "full": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_full.jpg"
+    }
+  ],
+  "liked_count": 1,
+  "liked": true,
+  "comment_count": 1,
+  "comments": [
+    {
+      "comment_id": 1,
+      "user_name": "testUser1",
+      "commented_at": "2020-01-01T00:00:00+09:00",
+      "comment": "test comment"
+    }
+  ],
+  "cats": [
+    {
+      "cat_id": 1,
+      "user_name": "testUser1",
+      "name": "Tama",
+      "breed": "Japanese Bobtail",
+      "birthday": "2018-04-01",
+      "icon": "UNKNOWN",
+      "bio": "likes napping in the sun",
+      "created_at": "2020-01-01T00:00:00+09:00"
+    }
+  ]
+}
+`
+var successRespGetPostingNotLiked = `
+{
+  "posting_id": 1,
+  "user_name": "testUser1",
+  "user_icon": "UNKNOWN",
+  "uploaded_at": "2020-01-01T00:00:00+09:00",
+  "title": "This is a sample posting.",
+  "alt_text": "Cat, Whiskers, Tabby cat",
+  "image_url": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_full.jpg",
+  "image_urls": {
+    "thumb": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_thumb.jpg",
+    "feed": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_feed.jpg",
+    "full": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_full.jpg"
+  },
+  "images": [
+    {
+      "thumb": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_thumb.jpg",
+      "feed": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_feed.jpg",
+      "full": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_full.jpg"
+    }
+  ],
+  "liked_count": 1,
+  "liked": false,
+  "comment_count": 1,
+  "comments": [
+    {
+      "comment_id": 1,
+      "user_name": "testUser1",
+      "commented_at": "2020-01-01T00:00:00+09:00",
+      "comment": "test comment"
+    }
+  ],
+  "cats": [
+    {
+      "cat_id": 1,
+      "user_name": "testUser1",
+      "name": "Tama",
+      "breed": "Japanese Bobtail",
+      "birthday": "2018-04-01",
+      "icon": "UNKNOWN",
+      "bio": "likes napping in the sun",
+      "created_at": "2020-01-01T00:00:00+09:00"
+    }
+  ]
+}
+`
+var errRespGetPostingNotExistingID = `
+{
+  "status": 404,
+  "message": "not exists data error"
+}
+`
+
+func TestGetPosting(t *testing.T) {
+	type args struct {
+		postingID     int64
+		tokenUserName string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		method     string
+		want       string
+		wantStatus int
+	}{
+		{
+			name:       "success",
+			args:       args{postingID: dummy.Posting1.ID, tokenUserName: dummy.User2.Name},
+			method:     http.MethodGet,
+			want:       successRespGetPosting,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "success not liked",
+			args:       args{postingID: dummy.Posting1.ID, tokenUserName: dummy.User1.Name},
+			method:     http.MethodGet,
+			want:       successRespGetPostingNotLiked,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "error not existing posting",
+			args:       args{postingID: 100, tokenUserName: dummy.User1.Name},
+			method:     http.MethodGet,
+			want:       errRespGetPostingNotExistingID,
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "not allowed method",
+			args:       args{postingID: dummy.Posting1.ID, tokenUserName: dummy.User1.Name},
+			method:     http.MethodHead,
+			want:       testingHelper.ErrNotAllowedMethod,
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// init
+			db := testingHelper.SetupDBTest()
+			defer testingHelper.TeardownDBTest(db)
+			testingHelper.SetTestTime()
+			defer testingHelper.ResetTime()
+
+			// insert dummy data
+			userRepo := repository.NewUserRepository(db)
+			postingRepo := repository.NewPostingRepository(db)
+			postingImageRepo := repository.NewPostingImageRepository(db)
+			likeRepo := repository.NewLikeRepository(db)
+			commentRepo := repository.NewCommentRepository(db)
+			catRepo := repository.NewCatRepository(db)
+			postingCatRepo := repository.NewPostingCatRepository(db)
+			err := userRepo.Create(context.Background(), &dummy.User1)
+			assert.NoError(t, err)
+			err = userRepo.Create(context.Background(), &dummy.User2)
+			assert.NoError(t, err)
+			err = postingRepo.Create(context.Background(), &dummy.Posting1)
+			assert.NoError(t, err)
+			err = postingImageRepo.Create(context.Background(), &dummy.PostingImage1)
+			assert.NoError(t, err)
+			err = likeRepo.Create(context.Background(), &dummy.Like2to1)
+			assert.NoError(t, err)
+			err = commentRepo.Create(context.Background(), &dummy.Comment1)
+			assert.NoError(t, err)
+			err = testingHelper.UpdateNow(db, "postings")
+			assert.NoError(t, err)
+			err = testingHelper.UpdateNow(db, "comments")
+			assert.NoError(t, err)
+			err = catRepo.Create(context.Background(), &dummy.Cat1)
+			assert.NoError(t, err)
+			err = postingCatRepo.Create(context.Background(), &dummy.PostingCat1)
+			assert.NoError(t, err)
+			err = testingHelper.UpdateNow(db, "cats")
+			assert.NoError(t, err)
+			err = usecase.NewReconcileCounters(repository.NewPostingCounterRepository(db), repository.NewUserCounterRepository(db)).ReconcileCountersUseCase(context.Background())
+			assert.NoError(t, err)
+
+			// http request
+			req, err := http.NewRequest(tt.method, fmt.Sprintf("/postings/%v", tt.args.postingID), nil)
+			assert.NoError(t, err)
+			vars := map[string]string{"posting_id": strconv.Itoa(int(tt.args.postingID))}
+			req = mux.SetURLVars(req, vars)
+			req = req.WithContext(httpContext.SetTokenUserName(req.Context(), tt.args.tokenUserName))
+			resp := httptest.NewRecorder()
+
+			// test target
+			PostingController(resp, req)
+			assert.NoError(t, err)
+
+			// assert http
+			assert.Equal(t, tt.wantStatus, resp.Code)
+			respBodyByte, err := ioutil.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			respBody := string(respBodyByte)
+			assert.JSONEq(t, tt.want, testingHelper.StripURLSignatures(respBody))
+		})
+	}
+}
+
+var successRespGetPostingStats = `
+{
+  "posting_id": 1,
+  "daily": [
+    {
+      "date": "2019-12-31",
+      "impressions": 0,
+      "views": 0,
+      "likes": 0,
+      "comments": 0
+    },
+    {
+      "date": "2020-01-01",
+      "impressions": 1,
+      "views": 1,
+      "likes": 1,
+      "comments": 1
+    }
+  ]
+}
+`
+var errRespGetPostingStatsNotOwner = `
+{
+  "status": 403,
+  "message": "you can see only the stats of your posting"
+}
+`
+var errRespGetPostingStatsInvalidDays = `
+{
+  "status": 400,
+  "message": "days: must be no less than 1"
+}
+`
+
+func TestGetPostingStats(t *testing.T) {
+	type args struct {
+		postingID     int64
+		tokenUserName string
+		days          string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		method     string
+		want       string
+		wantStatus int
+	}{
+		{
+			name:       "success",
+			args:       args{postingID: dummy.Posting1.ID, tokenUserName: dummy.User1.Name, days: "2"},
+			method:     http.MethodGet,
+			want:       successRespGetPostingStats,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "error not owner",
+			args:       args{postingID: dummy.Posting1.ID, tokenUserName: dummy.User2.Name, days: "2"},
+			method:     http.MethodGet,
+			want:       errRespGetPostingStatsNotOwner,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "error not existing posting",
+			args:       args{postingID: 100, tokenUserName: dummy.User1.Name, days: "2"},
+			method:     http.MethodGet,
+			want:       errRespGetPostingNotExistingID,
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "error invalid days",
+			args:       args{postingID: dummy.Posting1.ID, tokenUserName: dummy.User1.Name, days: "0"},
+			method:     http.MethodGet,
+			want:       errRespGetPostingStatsInvalidDays,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "not allowed method",
+			args:       args{postingID: dummy.Posting1.ID, tokenUserName: dummy.User1.Name, days: "2"},
+			method:     http.MethodPost,
+			want:       testingHelper.ErrNotAllowedMethod,
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// init
+			db := testingHelper.SetupDBTest()
+			defer testingHelper.TeardownDBTest(db)
+			testingHelper.SetTestTime()
+			defer testingHelper.ResetTime()
+			// drop the views left by the other tests
+			for len(repository.DefaultPostingViewBuffer.DrainBatch()) > 0 {
+			}
+
+			// insert dummy data
+			userRepo := repository.NewUserRepository(db)
+			postingRepo := repository.NewPostingRepository(db)
+			likeRepo := repository.NewLikeRepository(db)
+			commentRepo := repository.NewCommentRepository(db)
+			err := userRepo.Create(context.Background(), &dummy.User1)
+			assert.NoError(t, err)
+			err = userRepo.Create(context.Background(), &dummy.User2)
+			assert.NoError(t, err)
+			err = postingRepo.Create(context.Background(), &dummy.Posting1)
+			assert.NoError(t, err)
+			err = likeRepo.Create(context.Background(), &dummy.Like2to1)
+			assert.NoError(t, err)
+			err = commentRepo.Create(context.Background(), &dummy.Comment1)
+			assert.NoError(t, err)
+			err = testingHelper.UpdateNow(db, "postings")
+			assert.NoError(t, err)
+			err = testingHelper.UpdateNow(db, "likes")
+			assert.NoError(t, err)
+			err = testingHelper.UpdateNow(db, "comments")
+			assert.NoError(t, err)
+
+			// user2 sees posting1 in the feed and opens it twice, and the views of the owner are not counted
+			for _, v := range []struct {
+				path     string
+				userName string
+			}{
+				{path: "/postings?limit=10", userName: dummy.User2.Name},
+				{path: fmt.Sprintf("/postings/%v", dummy.Posting1.ID), userName: dummy.User2.Name},
+				{path: fmt.Sprintf("/postings/%v", dummy.Posting1.ID), userName: dummy.User2.Name},
+				{path: fmt.Sprintf("/postings/%v", dummy.Posting1.ID), userName: dummy.User1.Name},
+			} {
+				req, err := http.NewRequest(http.MethodGet, v.path, nil)
+				assert.NoError(t, err)
+				req = mux.SetURLVars(req, map[string]string{"posting_id": strconv.Itoa(int(dummy.Posting1.ID))})
+				req = req.WithContext(httpContext.SetTokenUserName(req.Context(), v.userName))
+				resp := httptest.NewRecorder()
+				PostingController(resp, req)
+				assert.Equal(t, http.StatusOK, resp.Code)
+			}
+			err = usecase.NewFlushPostingViews(repository.NewPostingViewRepository(db), repository.DefaultPostingViewBuffer).FlushPostingViewsUseCase(context.Background())
+			assert.NoError(t, err)
+			views, err := testingHelper.FindAllPostingViews(context.Background(), db)
+			assert.NoError(t, err)
+			assert.Equal(t, 2, len(views))
+
+			// http request
+			req, err := http.NewRequest(tt.method, fmt.Sprintf("/postings/%v/stats?days=%v", tt.args.postingID, tt.args.days), nil)
+			assert.NoError(t, err)
+			vars := map[string]string{"posting_id": strconv.Itoa(int(tt.args.postingID))}
+			req = mux.SetURLVars(req, vars)
+			req = req.WithContext(httpContext.SetTokenUserName(req.Context(), tt.args.tokenUserName))
+			resp := httptest.NewRecorder()
+
+			// test target
+			PostingController(resp, req)
+
+			// assert http
+			assert.Equal(t, tt.wantStatus, resp.Code)
+			respBodyByte, err := ioutil.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			respBody := string(respBodyByte)
+			assert.JSONEq(t, tt.want, respBody)
+		})
+	}
+}
+
+var successRespGetScheduledPostings = `
+{
+  "postings": [
+    {
+      "posting_id": 1,
+      "user_name": "testUser1",
+      "uploaded_at": "2020-01-02T00:00:00+09:00",
+      "title": "This is a sample posting.",
+      "alt_text": "Cat, Whiskers, Tabby cat",
+      "image_url": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_full.jpg",
+      "image_urls": {
+        "thumb": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_thumb.jpg",
+        "feed": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_feed.jpg",
+        "full": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_full.jpg"
+      },
+      "images": [
+        {
+          "thumb": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_thumb.jpg",
+          "feed": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_feed.jpg",
+          "full": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_full.jpg"
+        }
+      ],
+      "publish_at": "2020-01-02T00:00:00+09:00",
+      "liked_count": 0,
+      "liked": false
+    }
+  ]
+}
+`
+
+func TestGetScheduledPostings(t *testing.T) {
+	type args struct {
+		path          string
+		tokenUserName string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		method     string
+		want       string
+		wantStatus int
+	}{
+		{
+			name:       "success",
+			args:       args{path: "/postings/scheduled", tokenUserName: dummy.User1.Name},
+			method:     http.MethodGet,
+			want:       successRespGetScheduledPostings,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "success other user",
+			args:       args{path: "/postings/scheduled", tokenUserName: dummy.User2.Name},
+			method:     http.MethodGet,
+			want:       successRespGetPostingsEmpty,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "success hidden from postings",
+			args:       args{path: "/postings?limit=50", tokenUserName: dummy.User1.Name},
+			method:     http.MethodGet,
+			want:       successRespGetPostingsEmpty,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "not allowed method",
+			args:       args{path: "/postings/scheduled", tokenUserName: dummy.User1.Name},
+			method:     http.MethodPost,
+			want:       testingHelper.ErrNotAllowedMethod,
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// init
+			db := testingHelper.SetupDBTest()
+			defer testingHelper.TeardownDBTest(db)
+			testingHelper.SetTestTime()
+			defer testingHelper.ResetTime()
+
+			// insert dummy data
+			userRepo := repository.NewUserRepository(db)
+			postingRepo := repository.NewPostingRepository(db)
+			err := userRepo.Create(context.Background(), &dummy.User1)
+			assert.NoError(t, err)
+			err = userRepo.Create(context.Background(), &dummy.User2)
+			assert.NoError(t, err)
+			publishAt := testingHelper.GetTestTime().AddDate(0, 0, 1)
+			scheduled := dummy.Posting1
+			scheduled.PublishAt = &publishAt
+			err = postingRepo.Create(context.Background(), &scheduled)
+			assert.NoError(t, err)
+
+			// http request
+			req, err := http.NewRequest(tt.method, tt.args.path, nil)
+			assert.NoError(t, err)
+			req = req.WithContext(httpContext.SetTokenUserName(req.Context(), tt.args.tokenUserName))
+			resp := httptest.NewRecorder()
+
+			// test target
+			PostingController(resp, req)
+			assert.NoError(t, err)
+
+			// assert http
+			assert.Equal(t, tt.wantStatus, resp.Code)
+			respBodyByte, err := ioutil.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			respBody := string(respBodyByte)
+			assert.JSONEq(t, tt.want, testingHelper.StripURLSignatures(respBody))
+		})
+	}
+}
+
+var successReqUpdatePosting = `
+{
+  "title": "This is an edited posting. #Cat_Nap #tabby"
+}
+`
+var errReqUpdatePostingUnderBarTitle = `
+{
+  "title": "This_is_an_edited_posting."
+}
+`
+var errReqUpdatePostingTitleShort = `
+{
+  "title": "a"
+}
+`
+var errRespUpdatePostingNotExistingID = `
+{
+  "status": 400,
+  "message": "not exists data error"
+}
+`
+var errRespUpdatePostingAlreadyPublished = `
+{
+  "status": 400,
+  "message": "the posting has already been published"
+}
+`
+var errRespUpdatePostingNotOwnedCat = `
+{
+  "status": 400,
+  "message": "you can tag a posting only with your cats"
+}
+`
+var errRespUpdatePostingNotOwner = `
+{
+  "status": 403,
+  "message": "you can edit only your posting"
+}
+`
+
+func TestUpdatePosting(t *testing.T) {
+	type args struct {
+		postingID int64
+		reqBody   string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		method     string
+		want       string
+		wantStatus int
+	}{
+		{
+			name:       "success",
+			args:       args{postingID: dummy.Posting1.ID, reqBody: successReqUpdatePosting},
+			method:     http.MethodPut,
+			want:       testingHelper.RespSimpleSuccess,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "success retag",
+			args:       args{postingID: dummy.Posting1.ID, reqBody: successReqUpdatePosting},
+			method:     http.MethodPut,
+			want:       testingHelper.RespSimpleSuccess,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "success same title",
+			args:       args{postingID: dummy.Posting1.ID, reqBody: `{"title": "` + dummy.Posting1.Title + `"}`},
+			method:     http.MethodPut,
+			want:       testingHelper.RespSimpleSuccess,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "success tag cats",
+			args:       args{postingID: dummy.Posting1.ID, reqBody: `{"title": "` + dummy.Posting1.Title + `", "cat_ids": [1, 1]}`},
+			method:     http.MethodPut,
+			want:       testingHelper.RespSimpleSuccess,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "success alt text",
+			args:       args{postingID: dummy.Posting1.ID, reqBody: `{"title": "` + dummy.Posting1.Title + `", "alt_text": "A tabby cat sleeping on the sofa"}`},
+			method:     http.MethodPut,
+			want:       testingHelper.RespSimpleSuccess,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "success default alt text",
+			args:       args{postingID: dummy.Posting1.ID, reqBody: `{"title": "` + dummy.Posting1.Title + `", "alt_text": " "}`},
+			method:     http.MethodPut,
+			want:       testingHelper.RespSimpleSuccess,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "error not owned cat",
+			args:       args{postingID: dummy.Posting1.ID, reqBody: `{"title": "` + dummy.Posting1.Title + `", "cat_ids": [2]}`},
+			method:     http.MethodPut,
+			want:       errRespUpdatePostingNotOwnedCat,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "error reschedule published posting",
+			args:       args{postingID: dummy.Posting1.ID, reqBody: `{"title": "` + dummy.Posting1.Title + `", "publish_at": "2100-01-01T00:00:00+09:00"}`},
+			method:     http.MethodPut,
+			want:       errRespUpdatePostingAlreadyPublished,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "error under bar title",
+			args:       args{postingID: dummy.Posting1.ID, reqBody: errReqUpdatePostingUnderBarTitle},
+			method:     http.MethodPut,
+			want:       errRespRegisterPostingUnderBarTitle,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "error title short",
+			args:       args{postingID: dummy.Posting1.ID, reqBody: errReqUpdatePostingTitleShort},
+			method:     http.MethodPut,
+			want:       errRespRegisterPostingTitleShort,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "error not existing posting_id",
+			args:       args{postingID: 99999, reqBody: successReqUpdatePosting},
+			method:     http.MethodPut,
+			want:       errRespUpdatePostingNotExistingID,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "error not owner",
+			args:       args{postingID: dummy.Posting2.ID, reqBody: successReqUpdatePosting},
+			method:     http.MethodPut,
+			want:       errRespUpdatePostingNotOwner,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "error forbidden guest user",
+			args:       args{postingID: dummy.Posting1.ID, reqBody: successReqUpdatePosting},
+			method:     http.MethodPut,
+			want:       testingHelper.ErrForbidden,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "not allowed method",
+			args:       args{postingID: dummy.Posting1.ID},
+			method:     http.MethodHead,
+			want:       testingHelper.ErrNotAllowedMethod,
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// init
+			db := testingHelper.SetupDBTest()
+			defer testingHelper.TeardownDBTest(db)
+			testingHelper.SetTestTime()
+			defer testingHelper.ResetTime()
+
+			// insert dummy data
+			userRepo := repository.NewUserRepository(db)
+			postingRepo := repository.NewPostingRepository(db)
+			catRepo := repository.NewCatRepository(db)
+			err := userRepo.Create(context.Background(), &dummy.User1)
+			assert.NoError(t, err)
+			err = userRepo.Create(context.Background(), &dummy.User2)
+			assert.NoError(t, err)
+			err = postingRepo.Create(context.Background(), &dummy.Posting1)
+			assert.NoError(t, err)
+			err = postingRepo.Create(context.Background(), &dummy.Posting2)
+			assert.NoError(t, err)
+			err = catRepo.Create(context.Background(), &dummy.Cat1)
+			assert.NoError(t, err)
+			err = catRepo.Create(context.Background(), &dummy.Cat2)
+			assert.NoError(t, err)
+			if tt.name == "success retag" {
+				// the posting was tagged two days ago
+				_, err = db.Exec("UPDATE `postings` SET `title` = ? WHERE `id` = ?", "This is a sample posting. #tabby #old", dummy.Posting1.ID)
+				assert.NoError(t, err)
+				tagRepo := repository.NewTagRepository(db)
+				postingTagRepo := repository.NewPostingTagRepository(db)
+				for _, name := range []string{"tabby", "old"} {
+					tag := model.Tag{Name: name}
+					err = tagRepo.Upsert(context.Background(), &tag)
+					assert.NoError(t, err)
+					err = postingTagRepo.Create(context.Background(), &model.PostingTag{PostingID: dummy.Posting1.ID, TagID: tag.ID})
+					assert.NoError(t, err)
+				}
+				_, err = db.Exec("UPDATE `posting_tags` SET `created_at` = ?", lib.NowFunc().AddDate(0, 0, -2))
+				assert.NoError(t, err)
+			}
+			if tt.name == "success default alt text" {
+				// the alt text was written by the user and the image is described again
+				_, err = db.Exec("UPDATE `postings` SET `alt_text` = ? WHERE `id` = ?", "A tabby cat sleeping on the sofa", dummy.Posting1.ID)
+				assert.NoError(t, err)
+				putDraftUpload(t, "20200101000000_testUser1_full.jpg")
+			}
+
+			// http request
+			req, err := http.NewRequest(tt.method, fmt.Sprintf("/postings/%v", tt.args.postingID), strings.NewReader(tt.args.reqBody))
+			assert.NoError(t, err)
+			vars := map[string]string{"posting_id": strconv.Itoa(int(tt.args.postingID))}
+			req = mux.SetURLVars(req, vars)
+			if tt.name == "error forbidden guest user" {
+				req = req.WithContext(httpContext.SetTokenUserName(req.Context(), helper.GuestUserName))
+			} else {
+				req = req.WithContext(httpContext.SetTokenUserName(req.Context(), dummy.User1.Name))
+			}
+			resp := httptest.NewRecorder()
+
+			// test target
+			PostingController(resp, req)
+			assert.NoError(t, err)
+
+			// assert db
+			if tt.name == "success" {
+				postings, err := testingHelper.FindAllPostings(context.Background(), db)
+				assert.NoError(t, err)
+				assert.Equal(t, "This is an edited posting. #Cat_Nap #tabby", postings[0].Title)
+				assert.NotNil(t, postings[0].EditedAt)
+				histories, err := testingHelper.FindAllPostingTitleHistories(context.Background(), db)
+				assert.NoError(t, err)
+				assert.Equal(t, 1, len(histories))
+				assert.Equal(t, dummy.Posting1.ID, histories[0].PostingID)
+				assert.Equal(t, dummy.Posting1.Title, histories[0].Title)
+				tags, err := testingHelper.FindAllTags(context.Background(), db)
+				assert.NoError(t, err)
+				assert.Equal(t, 2, len(tags))
+				assert.Equal(t, "cat_nap", tags[0].Name)
+				assert.Equal(t, "tabby", tags[1].Name)
+				postingTags, err := testingHelper.FindAllPostingTags(context.Background(), db)
+				assert.NoError(t, err)
+				assert.Equal(t, 2, len(postingTags))
+				for i, pt := range postingTags {
+					assert.Equal(t, dummy.Posting1.ID, pt.PostingID)
+					assert.Equal(t, tags[i].ID, pt.TagID)
+				}
+			}
+			if tt.name == "success tag cats" {
+				postingCats, err := testingHelper.FindAllPostingCats(context.Background(), db)
+				assert.NoError(t, err)
+				assert.Equal(t, 1, len(postingCats))
+				assert.Equal(t, dummy.Posting1.ID, postingCats[0].PostingID)
+				assert.Equal(t, dummy.Cat1.ID, postingCats[0].CatID)
+			}
+			if tt.name == "success alt text" {
+				postings, err := testingHelper.FindAllPostings(context.Background(), db)
+				assert.NoError(t, err)
+				assert.Equal(t, "A tabby cat sleeping on the sofa", postings[0].AltText)
+				assert.Equal(t, dummy.Posting1.Title, postings[0].Title)
+				// the alt text is not a title edit
+				assert.Nil(t, postings[0].EditedAt)
+			}
+			if tt.name == "success default alt text" {
+				postings, err := testingHelper.FindAllPostings(context.Background(), db)
+				assert.NoError(t, err)
+				assert.Equal(t, "Cat, Whiskers, Tabby cat", postings[0].AltText)
+			}
+			if tt.name == "success retag" {
+				// only the removed tag is unlinked and only the added tag is linked now
+				postingTags, err := testingHelper.FindAllPostingTags(context.Background(), db)
+				assert.NoError(t, err)
+				assert.Equal(t, 2, len(postingTags))
+				tabby, err := repository.NewTagRepository(db).GetWhereName(context.Background(), "tabby")
+				assert.NoError(t, err)
+				catNap, err := repository.NewTagRepository(db).GetWhereName(context.Background(), "cat_nap")
+				assert.NoError(t, err)
+				assert.Equal(t, tabby.ID, postingTags[0].TagID)
+				assert.True(t, postingTags[0].CreatedAt.Before(lib.NowFunc().AddDate(0, 0, -1)))
+				assert.Equal(t, catNap.ID, postingTags[1].TagID)
+			}
+			if tt.name == "success same title" {
+				postings, err := testingHelper.FindAllPostings(context.Background(), db)
+				assert.NoError(t, err)
+				assert.Nil(t, postings[0].EditedAt)
+				assert.Equal(t, dummy.Posting1.AltText, postings[0].AltText)
+				histories, err := testingHelper.FindAllPostingTitleHistories(context.Background(), db)
+				assert.NoError(t, err)
+				assert.Equal(t, 0, len(histories))
+			}
+
+			// assert http
+			assert.Equal(t, tt.wantStatus, resp.Code)
+			respBodyByte, err := ioutil.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			respBody := string(respBodyByte)
+			assert.JSONEq(t, tt.want, respBody)
+		})
+	}
+}
+
+var errRespDeletePostingWithoutPostingID = `
+{
+  "status": 400,
+  "message": "cannot be blank"
+}
+`
+var errRespDeletePostingNotExistingID = `
+{
+  "status": 400,
+  "message": "not exists data error"
+}
+`
+
+func TestDeletePosting(t *testing.T) {
+	type args struct {
+		postingID int64
+	}
+	tests := []struct {
+		name       string
+		args       args
+		method     string
+		want       string
+		wantStatus int
+	}{
+		{
+			name:       "success",
+			args:       args{postingID: dummy.Posting1.ID},
+			method:     http.MethodDelete,
+			want:       testingHelper.RespSimpleSuccess,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "success image registered again",
+			args:       args{postingID: dummy.Posting1.ID},
+			method:     http.MethodDelete,
+			want:       testingHelper.RespSimpleSuccess,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "error empty posting_id",
+			args:       args{},
+			method:     http.MethodDelete,
+			want:       errRespDeletePostingWithoutPostingID,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "error not existing posting_id",
+			args:       args{postingID: 99999},
+			method:     http.MethodDelete,
+			want:       errRespDeletePostingNotExistingID,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "error forbidden guest user",
 			args:       args{postingID: dummy.Posting1.ID},
 			method:     http.MethodDelete,
 			want:       testingHelper.ErrForbidden,
@@ -420,6 +1857,37 @@ func TestDeletePosting(t *testing.T) {
 			assert.NoError(t, err)
 			err = postingRepo.Create(context.Background(), &dummy.Posting1)
 			assert.NoError(t, err)
+			postingImageRepo := repository.NewPostingImageRepository(db)
+			err = postingImageRepo.Create(context.Background(), &dummy.PostingImage1)
+			assert.NoError(t, err)
+			// another user saved it
+			err = userRepo.Create(context.Background(), &dummy.User2)
+			assert.NoError(t, err)
+			bookmarkRepo := repository.NewBookmarkRepository(db)
+			err = bookmarkRepo.Create(context.Background(), &dummy.Bookmark2)
+			assert.NoError(t, err)
+			collectionRepo := repository.NewCollectionRepository(db)
+			err = collectionRepo.Create(context.Background(), &dummy.Collection1)
+			assert.NoError(t, err)
+			collectionPostingRepo := repository.NewCollectionPostingRepository(db)
+			err = collectionPostingRepo.Create(context.Background(), &dummy.CollectionPosting1)
+			assert.NoError(t, err)
+			postingViewRepo := repository.NewPostingViewRepository(db)
+			err = postingViewRepo.CreateIgnoringDuplicates(context.Background(), []model.PostingView{dummy.PostingView2to1})
+			assert.NoError(t, err)
+			// keys are derived from the content, so the same photo posted again has the same key
+			fullKey := imaging.VariantKey(dummy.PostingImage1.ObjectKey, imaging.VariantFull)
+			if tt.name == "success image registered again" {
+				err = postingRepo.Create(context.Background(), &dummy.Posting2)
+				assert.NoError(t, err)
+				image := dummy.PostingImage1
+				image.ID = 2
+				image.PostingID = dummy.Posting2.ID
+				err = postingImageRepo.Create(context.Background(), &image)
+				assert.NoError(t, err)
+				putDraftUpload(t, fullKey)
+				defer func() { _ = aws.DeleteObject(os.Getenv("S3_BUCKET_POSTINGS"), fullKey) }()
+			}
 
 			// http request
 			req, err := http.NewRequest(tt.method, fmt.Sprintf("/postings/%v", tt.args.postingID), nil)
@@ -438,10 +1906,44 @@ func TestDeletePosting(t *testing.T) {
 			assert.NoError(t, err)
 
 			// assert db
-			if tt.wantStatus == http.StatusOK {
+			if tt.name == "success image registered again" {
+				images, err := testingHelper.FindAllPostingImages(context.Background(), db)
+				assert.NoError(t, err)
+				assert.Equal(t, 1, len(images))
+				// the deletion is dequeued without deleting the object
+				deletions, err := testingHelper.FindAllObjectDeletions(context.Background(), db)
+				assert.NoError(t, err)
+				assert.Equal(t, 0, len(deletions))
+				_, err = aws.HeadObject(os.Getenv("S3_BUCKET_POSTINGS"), fullKey)
+				assert.NoError(t, err)
+			} else if tt.wantStatus == http.StatusOK {
 				postings, err := testingHelper.FindAllPostings(context.Background(), db)
 				assert.NoError(t, err)
 				assert.Equal(t, 0, len(postings))
+				images, err := testingHelper.FindAllPostingImages(context.Background(), db)
+				assert.NoError(t, err)
+				assert.Equal(t, 0, len(images))
+				// every variant is deleted from S3 right after the commit, so nothing is left to retry
+				deletions, err := testingHelper.FindAllObjectDeletions(context.Background(), db)
+				assert.NoError(t, err)
+				assert.Equal(t, 0, len(deletions))
+				// it disappears from the bookmarks and the collections but they are kept
+				bookmarks, err := testingHelper.FindAllBookmarks(context.Background(), db)
+				assert.NoError(t, err)
+				assert.Equal(t, 0, len(bookmarks))
+				collectionPostings, err := testingHelper.FindAllCollectionPostings(context.Background(), db)
+				assert.NoError(t, err)
+				assert.Equal(t, 0, len(collectionPostings))
+				collections, err := testingHelper.FindAllCollections(context.Background(), db)
+				assert.NoError(t, err)
+				assert.Equal(t, 1, len(collections))
+				views, err := testingHelper.FindAllPostingViews(context.Background(), db)
+				assert.NoError(t, err)
+				assert.Equal(t, 0, len(views))
+			} else {
+				images, err := testingHelper.FindAllPostingImages(context.Background(), db)
+				assert.NoError(t, err)
+				assert.Equal(t, 1, len(images))
 			}
 
 			// assert http
@@ -0,0 +1,145 @@
+package controller
+
+import (
+	"encoding/json"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/http/context"
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/http/helper"
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/application/usecase"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+	modelHTTP "github.com/gold-kou/ToeBeans/backend/app/domain/model/http"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+)
+
+func SearchController(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.URL.Path == "/search":
+		switch r.Method {
+		case http.MethodGet:
+			postings, userNames, likedCounts, likes, users, err := search(r)
+			switch err := err.(type) {
+			case nil:
+				var httpUsers = []modelHTTP.ResponseSearchUser{}
+				for _, u := range users {
+					httpUsers = append(httpUsers, modelHTTP.ResponseSearchUser{
+						UserName:         u.Name,
+						Icon:             usecase.SignIconURL(u.Icon),
+						SelfIntroduction: u.SelfIntroduction,
+					})
+				}
+				resp := modelHTTP.ResponseSearch{
+					Postings: newResponseGetPostings(postings, userNames, likedCounts, likes).Postings,
+					Users:    httpUsers,
+				}
+				w.Header().Set(helper.HeaderKeyContentType, helper.HeaderValueApplicationJSON)
+				w.WriteHeader(http.StatusOK)
+				if err := json.NewEncoder(w).Encode(resp); err != nil {
+					log.Println(err.Error())
+				}
+			case *helper.BadRequestError:
+				helper.ResponseBadRequest(w, err.Error())
+			case *helper.AuthorizationError:
+				helper.ResponseUnauthorized(w, err.Error())
+			case *helper.InternalServerError:
+				helper.ResponseInternalServerError(w, err.Error())
+			default:
+				helper.ResponseInternalServerError(w, err.Error())
+			}
+		default:
+			methods := []string{http.MethodGet}
+			helper.ResponseNotAllowedMethod(w, errMsgNotAllowedMethod, methods)
+		}
+	default:
+		helper.ResponseInternalServerError(w, errMsgControllerPath)
+	}
+}
+
+func search(r *http.Request) (postings []model.Posting, userNames []string, likedCounts []int64, likes []model.Like, users []model.User, err error) {
+	tokenUserName, err := context.GetTokenUserName(r.Context())
+	if err != nil {
+		log.Println(err)
+		err = helper.NewInternalServerError(err.Error())
+		return
+	}
+
+	// get request parameter
+	query := r.URL.Query().Get("q")
+	searchType := r.URL.Query().Get("type")
+	limit := r.URL.Query().Get("limit")
+	if limit == "" {
+		err = helper.NewBadRequestError("limit: cannot be blank.")
+		return
+	}
+	limitInt, err := strconv.Atoi(limit)
+	if err != nil {
+		log.Println(err)
+		err = helper.NewBadRequestError(err.Error())
+		return
+	}
+	var offsetInt int
+	if offset := r.URL.Query().Get("offset"); offset != "" {
+		offsetInt, err = strconv.Atoi(offset)
+		if err != nil {
+			log.Println(err)
+			err = helper.NewBadRequestError(err.Error())
+			return
+		}
+	}
+
+	// validation check
+	// ngram parser indexes every 2 characters, so a single character never matches
+	if err = validation.Validate(query, validation.Required, validation.RuneLength(modelHTTP.MinVarcharLength, modelHTTP.MaxVarcharLength)); err != nil {
+		log.Println(err)
+		err = helper.NewBadRequestError("q: " + err.Error())
+		return
+	}
+	if err = validation.Validate(searchType, validation.In(usecase.SearchTypePosting, usecase.SearchTypeUser)); err != nil {
+		log.Println(err)
+		err = helper.NewBadRequestError("type: " + err.Error())
+		return
+	}
+	// the repositories take limit as int8, so a larger one would wrap around
+	if err = validation.Validate(limitInt, validation.Min(1), validation.Max(math.MaxInt8)); err != nil {
+		log.Println(err)
+		err = helper.NewBadRequestError("limit: " + err.Error())
+		return
+	}
+	if err = validation.Validate(offsetInt, validation.Min(0)); err != nil {
+		log.Println(err)
+		err = helper.NewBadRequestError("offset: " + err.Error())
+		return
+	}
+
+	// db connect
+	db, err := mysql.NewDB()
+	if err != nil {
+		log.Println(err)
+		err = helper.NewInternalServerError(err.Error())
+		return
+	}
+	defer db.Close()
+	tx := mysql.NewDBTransaction(db)
+
+	// repository
+	userRepo := repository.NewUserRepository(db)
+	postingRepo := repository.NewPostingRepository(db)
+	likeRepo := repository.NewLikeRepository(db)
+	postingCounterRepo := repository.NewPostingCounterRepository(db)
+	postingImageRepo := repository.NewPostingImageRepository(db)
+
+	// UseCase
+	u := usecase.NewSearch(tx, tokenUserName, query, searchType, int8(limitInt), offsetInt, userRepo, postingRepo, likeRepo, postingCounterRepo, postingImageRepo)
+	if postings, userNames, likedCounts, likes, users, err = u.SearchUseCase(r.Context()); err != nil {
+		log.Println(err)
+		err = helper.NewInternalServerError(err.Error())
+		return
+	}
+	return
+}
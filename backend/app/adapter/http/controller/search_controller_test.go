@@ -0,0 +1,233 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	httpContext "github.com/gold-kou/ToeBeans/backend/app/adapter/http/context"
+
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+
+	"github.com/gold-kou/ToeBeans/backend/testing/dummy"
+
+	testingHelper "github.com/gold-kou/ToeBeans/backend/testing"
+	"github.com/stretchr/testify/assert"
+)
+
+var successRespSearchPostings = `
+{
+  "postings": [
+    {
+      "posting_id": 1,
+      "user_name": "testUser1",
+      "uploaded_at": "2020-01-01T00:00:00+09:00",
+      "title": "This is a sample posting.",
+      "alt_text": "Cat, Whiskers, Tabby cat",
+      "image_url": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_full.jpg",
+      "image_urls": {
+        "thumb": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_thumb.jpg",
+        "feed": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_feed.jpg",
+        "full": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_full.jpg"
+      },
+      "images": [
+        {
+          "thumb": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_thumb.jpg",
+          "feed": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_feed.jpg",
+          "full": "http://localhost:9000/toebeans-postings/20200101000000_testUser1_full.jpg"
+        }
+      ],
+      "liked_count": 0,
+      "liked": false
+    }
+  ],
+  "users": []
+}
+`
+var successRespSearchUsers = `
+{
+  "postings": [],
+  "users": [
+    {
+      "user_name": "testUser1",
+      "icon": "UNKNOWN",
+      "self_introduction": "UNKNOWN"
+    }
+  ]
+}
+`
+var successRespSearchEmpty = `
+{
+  "postings": [],
+  "users": []
+}
+`
+var errRespSearchWithoutQuery = `
+{
+  "status": 400,
+  "message": "q: cannot be blank"
+}
+`
+var errRespSearchWrongType = `
+{
+  "status": 400,
+  "message": "type: must be a valid value"
+}
+`
+var errRespSearchWithoutLimit = `
+{
+  "status": 400,
+  "message": "limit: cannot be blank."
+}
+`
+var errRespSearchTooLargeLimit = `
+{
+  "status": 400,
+  "message": "limit: must be no greater than 127"
+}
+`
+
+func TestSearch(t *testing.T) {
+	type args struct {
+		query      string
+		searchType string
+		limit      string
+		offset     string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		method     string
+		want       string
+		wantStatus int
+	}{
+		{
+			name:       "success posting",
+			args:       args{query: "sample", limit: "50"},
+			method:     http.MethodGet,
+			want:       successRespSearchPostings,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "success posting with type",
+			args:       args{query: "sample posting", searchType: "posting", limit: "50"},
+			method:     http.MethodGet,
+			want:       successRespSearchPostings,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "success user",
+			args:       args{query: "testUser1", searchType: "user", limit: "50"},
+			method:     http.MethodGet,
+			want:       successRespSearchUsers,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "success operators are ignored",
+			args:       args{query: "-sample", limit: "50"},
+			method:     http.MethodGet,
+			want:       successRespSearchPostings,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "success next page",
+			args:       args{query: "sample", limit: "50", offset: "1"},
+			method:     http.MethodGet,
+			want:       successRespSearchEmpty,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "success no hit",
+			args:       args{query: "kitten", limit: "50"},
+			method:     http.MethodGet,
+			want:       successRespSearchEmpty,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "error empty q",
+			args:       args{limit: "50"},
+			method:     http.MethodGet,
+			want:       errRespSearchWithoutQuery,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "error wrong type",
+			args:       args{query: "sample", searchType: "comment", limit: "50"},
+			method:     http.MethodGet,
+			want:       errRespSearchWrongType,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "error empty limit",
+			args:       args{query: "sample"},
+			method:     http.MethodGet,
+			want:       errRespSearchWithoutLimit,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "error too large limit",
+			args:       args{query: "sample", limit: "128"},
+			method:     http.MethodGet,
+			want:       errRespSearchTooLargeLimit,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "not allowed method",
+			args:       args{},
+			method:     http.MethodPost,
+			want:       testingHelper.ErrNotAllowedMethod,
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// init
+			db := testingHelper.SetupDBTest()
+			defer testingHelper.TeardownDBTest(db)
+			testingHelper.SetTestTime()
+			defer testingHelper.ResetTime()
+
+			// insert dummy data
+			userRepo := repository.NewUserRepository(db)
+			postingRepo := repository.NewPostingRepository(db)
+			err := userRepo.Create(context.Background(), &dummy.User1)
+			assert.NoError(t, err)
+			err = userRepo.Create(context.Background(), &dummy.User2)
+			assert.NoError(t, err)
+			err = userRepo.UpdateEmailVerifiedWhereNameActivationKey(context.Background(), true, dummy.User1.Name, dummy.User1.ActivationKey)
+			assert.NoError(t, err)
+			err = postingRepo.Create(context.Background(), &dummy.Posting1)
+			assert.NoError(t, err)
+			err = postingRepo.Create(context.Background(), &dummy.Posting2)
+			assert.NoError(t, err)
+			err = testingHelper.UpdateNow(db, "postings")
+			assert.NoError(t, err)
+
+			// http request
+			v := url.Values{}
+			v.Set("q", tt.args.query)
+			v.Set("type", tt.args.searchType)
+			v.Set("limit", tt.args.limit)
+			v.Set("offset", tt.args.offset)
+			req, err := http.NewRequest(tt.method, fmt.Sprintf("/search?%s", v.Encode()), nil)
+			assert.NoError(t, err)
+			req = req.WithContext(httpContext.SetTokenUserName(req.Context(), dummy.User1.Name))
+			resp := httptest.NewRecorder()
+
+			// test target
+			SearchController(resp, req)
+			assert.NoError(t, err)
+
+			// assert http
+			assert.Equal(t, tt.wantStatus, resp.Code)
+			respBodyByte, err := ioutil.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			respBody := string(respBodyByte)
+			assert.JSONEq(t, tt.want, testingHelper.StripURLSignatures(respBody))
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package controller
+
+import (
+	"encoding/json"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/gorilla/mux"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/http/context"
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/http/helper"
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/application/usecase"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+	modelHTTP "github.com/gold-kou/ToeBeans/backend/app/domain/model/http"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+	"github.com/gold-kou/ToeBeans/backend/app/lib/hashtag"
+)
+
+func TagController(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.URL.Path == "/tags/trending":
+		switch r.Method {
+		case http.MethodGet:
+			tagCounts, err := getTrendingTags(r)
+			switch err := err.(type) {
+			case nil:
+				var httpTags = []modelHTTP.ResponseGetTrendingTag{}
+				for _, tc := range tagCounts {
+					httpTags = append(httpTags, modelHTTP.ResponseGetTrendingTag{
+						Name:  tc.Name,
+						Count: tc.Count,
+					})
+				}
+				resp := modelHTTP.ResponseGetTrendingTags{
+					Tags: httpTags,
+				}
+				w.Header().Set(helper.HeaderKeyContentType, helper.HeaderValueApplicationJSON)
+				w.WriteHeader(http.StatusOK)
+				if err := json.NewEncoder(w).Encode(resp); err != nil {
+					log.Println(err.Error())
+				}
+			case *helper.BadRequestError:
+				helper.ResponseBadRequest(w, err.Error())
+			case *helper.AuthorizationError:
+				helper.ResponseUnauthorized(w, err.Error())
+			case *helper.InternalServerError:
+				helper.ResponseInternalServerError(w, err.Error())
+			default:
+				helper.ResponseInternalServerError(w, err.Error())
+			}
+		default:
+			methods := []string{http.MethodGet}
+			helper.ResponseNotAllowedMethod(w, errMsgNotAllowedMethod, methods)
+		}
+	case strings.HasPrefix(r.URL.Path, "/tags/") && strings.HasSuffix(r.URL.Path, "/postings"):
+		switch r.Method {
+		case http.MethodGet:
+			postings, userNames, likedCounts, likes, next, err := getTagPostings(r)
+			switch err := err.(type) {
+			case nil:
+				resp := newResponseGetPostings(postings, userNames, likedCounts, likes)
+				resp.NextCursor = next
+				w.Header().Set(helper.HeaderKeyContentType, helper.HeaderValueApplicationJSON)
+				w.WriteHeader(http.StatusOK)
+				if err := json.NewEncoder(w).Encode(resp); err != nil {
+					log.Println(err.Error())
+				}
+			case *helper.BadRequestError:
+				helper.ResponseBadRequest(w, err.Error())
+			case *helper.AuthorizationError:
+				helper.ResponseUnauthorized(w, err.Error())
+			case *helper.InternalServerError:
+				helper.ResponseInternalServerError(w, err.Error())
+			default:
+				helper.ResponseInternalServerError(w, err.Error())
+			}
+		default:
+			methods := []string{http.MethodGet}
+			helper.ResponseNotAllowedMethod(w, errMsgNotAllowedMethod, methods)
+		}
+	default:
+		helper.ResponseInternalServerError(w, errMsgControllerPath)
+	}
+}
+
+func getTagPostings(r *http.Request) (postings []model.Posting, userNames []string, likedCounts []int64, likes []model.Like, next string, err error) {
+	tokenUserName, err := context.GetTokenUserName(r.Context())
+	if err != nil {
+		log.Println(err)
+		err = helper.NewInternalServerError(err.Error())
+		return
+	}
+
+	// get request parameter
+	vars := mux.Vars(r)
+	tag, _ := vars["tag"]
+	cursor, limit, err := getPagingParams(r, true)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	// validation check
+	if err = validation.Validate(hashtag.Normalize(tag), validation.Required, validation.RuneLength(1, hashtag.MaxLength)); err != nil {
+		log.Println(err)
+		err = helper.NewBadRequestError("tag: " + err.Error())
+		return
+	}
+
+	// db connect
+	db, err := mysql.NewDB()
+	if err != nil {
+		log.Println(err)
+		err = helper.NewInternalServerError(err.Error())
+		return
+	}
+	defer db.Close()
+	tx := mysql.NewDBTransaction(db)
+
+	// repository
+	userRepo := repository.NewUserRepository(db)
+	postingRepo := repository.NewPostingRepository(db)
+	likeRepo := repository.NewLikeRepository(db)
+	postingCounterRepo := repository.NewPostingCounterRepository(db)
+	postingImageRepo := repository.NewPostingImageRepository(db)
+	tagRepo := repository.NewTagRepository(db)
+
+	// UseCase
+	u := usecase.NewGetTagPostings(tx, tokenUserName, tag, cursor, int8(limit), userRepo, postingRepo, likeRepo, postingCounterRepo, postingImageRepo, tagRepo)
+	if postings, userNames, likedCounts, likes, err = u.GetTagPostingsUseCase(r.Context()); err != nil {
+		log.Println(err)
+		err = helper.NewInternalServerError(err.Error())
+		return
+	}
+	next = postingsNextCursor(postings, limit)
+	return
+}
+
+func getTrendingTags(r *http.Request) (tagCounts []model.TagCount, err error) {
+	tokenUserName, err := context.GetTokenUserName(r.Context())
+	if err != nil {
+		log.Println(err)
+		err = helper.NewInternalServerError(err.Error())
+		return
+	}
+
+	// get request parameter
+	limit := r.URL.Query().Get("limit")
+	if limit == "" {
+		err = helper.NewBadRequestError("limit: cannot be blank.")
+		return
+	}
+	limitInt, err := strconv.Atoi(limit)
+	if err != nil {
+		log.Println(err)
+		err = helper.NewBadRequestError(err.Error())
+		return
+	}
+
+	// validation check
+	// the repositories take limit as int8, so a larger one would wrap around
+	if err = validation.Validate(limitInt, validation.Min(1), validation.Max(math.MaxInt8)); err != nil {
+		log.Println(err)
+		err = helper.NewBadRequestError("limit: " + err.Error())
+		return
+	}
+
+	// db connect
+	db, err := mysql.NewDB()
+	if err != nil {
+		log.Println(err)
+		err = helper.NewInternalServerError(err.Error())
+		return
+	}
+	defer db.Close()
+	tx := mysql.NewDBTransaction(db)
+
+	// repository
+	userRepo := repository.NewUserRepository(db)
+	tagRepo := repository.NewTagRepository(db)
+
+	// UseCase
+	u := usecase.NewGetTrendingTags(tx, tokenUserName, int8(limitInt), userRepo, tagRepo)
+	if tagCounts, err = u.GetTrendingTagsUseCase(r.Context()); err != nil {
+		log.Println(err)
+		err = helper.NewInternalServerError(err.Error())
+		return
+	}
+	return
+}
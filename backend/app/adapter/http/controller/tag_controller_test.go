@@ -0,0 +1,296 @@
+package controller
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	httpContext "github.com/gold-kou/ToeBeans/backend/app/adapter/http/context"
+
+	"github.com/gorilla/mux"
+
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+
+	"github.com/gold-kou/ToeBeans/backend/app/lib"
+	"github.com/gold-kou/ToeBeans/backend/testing/dummy"
+
+	testingHelper "github.com/gold-kou/ToeBeans/backend/testing"
+	"github.com/stretchr/testify/assert"
+)
+
+var successRespGetTrendingTags = `
+{
+  "tags": [
+    {
+      "name": "cat_nap",
+      "count": 2
+    },
+    {
+      "name": "tabby",
+      "count": 1
+    }
+  ]
+}
+`
+var successRespGetTrendingTagsEmpty = `
+{
+  "tags": []
+}
+`
+var errRespGetTrendingTagsWithoutLimit = `
+{
+  "status": 400,
+  "message": "limit: cannot be blank."
+}
+`
+var errRespGetTrendingTagsNegativeLimit = `
+{
+  "status": 400,
+  "message": "limit: must be no less than 1"
+}
+`
+var errRespGetTrendingTagsTooLargeLimit = `
+{
+  "status": 400,
+  "message": "limit: must be no greater than 127"
+}
+`
+
+func insertDummyTags(t *testing.T, db *sql.DB) {
+	userRepo := repository.NewUserRepository(db)
+	postingRepo := repository.NewPostingRepository(db)
+	tagRepo := repository.NewTagRepository(db)
+	postingTagRepo := repository.NewPostingTagRepository(db)
+	err := userRepo.Create(context.Background(), &dummy.User1)
+	assert.NoError(t, err)
+	err = userRepo.Create(context.Background(), &dummy.User2)
+	assert.NoError(t, err)
+	err = postingRepo.Create(context.Background(), &dummy.Posting1)
+	assert.NoError(t, err)
+	err = postingRepo.Create(context.Background(), &dummy.Posting2)
+	assert.NoError(t, err)
+	err = testingHelper.UpdateNow(db, "postings")
+	assert.NoError(t, err)
+	err = tagRepo.Upsert(context.Background(), &dummy.Tag1)
+	assert.NoError(t, err)
+	err = tagRepo.Upsert(context.Background(), &dummy.Tag2)
+	assert.NoError(t, err)
+	err = postingTagRepo.Create(context.Background(), &dummy.PostingTag1)
+	assert.NoError(t, err)
+	err = postingTagRepo.Create(context.Background(), &dummy.PostingTag2)
+	assert.NoError(t, err)
+	err = postingTagRepo.Create(context.Background(), &dummy.PostingTag3)
+	assert.NoError(t, err)
+	err = testingHelper.UpdateNow(db, "posting_tags")
+	assert.NoError(t, err)
+}
+
+func TestGetTagPostings(t *testing.T) {
+	type args struct {
+		tag     string
+		sinceAt string
+		limit   string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		method     string
+		want       string
+		wantStatus int
+	}{
+		{
+			name:       "success",
+			args:       args{tag: "tabby", sinceAt: "2100-01-01T00:00:00+09:00", limit: "50"},
+			method:     http.MethodGet,
+			want:       successRespGetTagPostings,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "success not normalized tag",
+			args:       args{tag: "Tabby", sinceAt: "2100-01-01T00:00:00+09:00", limit: "50"},
+			method:     http.MethodGet,
+			want:       successRespGetTagPostings,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "success not used tag",
+			args:       args{tag: "kitten", sinceAt: "2100-01-01T00:00:00+09:00", limit: "50"},
+			method:     http.MethodGet,
+			want:       successRespGetPostingsEmpty,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "success without since_at",
+			args:       args{tag: "tabby", limit: "50"},
+			method:     http.MethodGet,
+			want:       successRespGetTagPostings,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "error empty limit",
+			args:       args{tag: "tabby", sinceAt: "2100-01-01T00:00:00+09:00"},
+			method:     http.MethodGet,
+			want:       errRespGetPostingsWithoutLimit,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "not allowed method",
+			args:       args{tag: "tabby"},
+			method:     http.MethodHead,
+			want:       testingHelper.ErrNotAllowedMethod,
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// init
+			db := testingHelper.SetupDBTest()
+			defer testingHelper.TeardownDBTest(db)
+			testingHelper.SetTestTime()
+			defer testingHelper.ResetTime()
+
+			// insert dummy data
+			insertDummyTags(t, db)
+
+			// http request
+			req, err := http.NewRequest(tt.method, fmt.Sprintf("/tags/%s/postings?since_at=%s&limit=%s", tt.args.tag, tt.args.sinceAt, tt.args.limit), nil)
+			assert.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{"tag": tt.args.tag})
+			req = req.WithContext(httpContext.SetTokenUserName(req.Context(), dummy.User1.Name))
+			resp := httptest.NewRecorder()
+
+			// test target
+			TagController(resp, req)
+			assert.NoError(t, err)
+
+			// assert http
+			assert.Equal(t, tt.wantStatus, resp.Code)
+			respBodyByte, err := ioutil.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			respBody := string(respBodyByte)
+			assert.JSONEq(t, tt.want, respBody)
+		})
+	}
+}
+
+var successRespGetTagPostings = `
+{
+  "postings": [
+    {
+      "posting_id": 2,
+      "user_name": "testUser2",
+      "uploaded_at": "2020-01-01T00:00:00+09:00",
+      "title": "test title",
+      "alt_text": "test alt text",
+      "image_url": "test url",
+      "image_urls": {
+        "thumb": "test url",
+        "feed": "test url",
+        "full": "test url"
+      },
+      "images": [
+        {
+          "thumb": "test url",
+          "feed": "test url",
+          "full": "test url"
+        }
+      ],
+      "liked_count": 0,
+      "liked": false
+    }
+  ]
+}
+`
+
+func TestGetTrendingTags(t *testing.T) {
+	type args struct {
+		limit string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		method     string
+		want       string
+		wantStatus int
+	}{
+		{
+			name:       "success",
+			args:       args{limit: "10"},
+			method:     http.MethodGet,
+			want:       successRespGetTrendingTags,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "success out of window",
+			args:       args{limit: "10"},
+			method:     http.MethodGet,
+			want:       successRespGetTrendingTagsEmpty,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "error empty limit",
+			args:       args{},
+			method:     http.MethodGet,
+			want:       errRespGetTrendingTagsWithoutLimit,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "error negative limit",
+			args:       args{limit: "-1"},
+			method:     http.MethodGet,
+			want:       errRespGetTrendingTagsNegativeLimit,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "error too large limit",
+			args:       args{limit: "300"},
+			method:     http.MethodGet,
+			want:       errRespGetTrendingTagsTooLargeLimit,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "not allowed method",
+			args:       args{},
+			method:     http.MethodPost,
+			want:       testingHelper.ErrNotAllowedMethod,
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// init
+			db := testingHelper.SetupDBTest()
+			defer testingHelper.TeardownDBTest(db)
+			testingHelper.SetTestTime()
+			defer testingHelper.ResetTime()
+
+			// insert dummy data
+			insertDummyTags(t, db)
+			if tt.name == "success out of window" {
+				_, err := db.Exec("UPDATE `posting_tags` SET `created_at` = ?", lib.NowFunc().AddDate(0, 0, -2))
+				assert.NoError(t, err)
+			}
+
+			// http request
+			req, err := http.NewRequest(tt.method, fmt.Sprintf("/tags/trending?limit=%s", tt.args.limit), nil)
+			assert.NoError(t, err)
+			req = req.WithContext(httpContext.SetTokenUserName(req.Context(), dummy.User1.Name))
+			resp := httptest.NewRecorder()
+
+			// test target
+			TagController(resp, req)
+			assert.NoError(t, err)
+
+			// assert http
+			assert.Equal(t, tt.wantStatus, resp.Code)
+			respBodyByte, err := ioutil.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			respBody := string(respBodyByte)
+			assert.JSONEq(t, tt.want, respBody)
+		})
+	}
+}
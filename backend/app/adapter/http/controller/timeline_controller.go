@@ -0,0 +1,88 @@
+package controller
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/http/context"
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/http/helper"
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/application/usecase"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+)
+
+func TimelineController(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.URL.Path == "/timeline":
+		switch r.Method {
+		case http.MethodGet:
+			postings, userNames, likedCounts, likes, next, err := getTimeline(r)
+			switch err := err.(type) {
+			case nil:
+				resp := newResponseGetPostings(postings, userNames, likedCounts, likes)
+				resp.NextCursor = next
+				w.Header().Set(helper.HeaderKeyContentType, helper.HeaderValueApplicationJSON)
+				w.WriteHeader(http.StatusOK)
+				if err := json.NewEncoder(w).Encode(resp); err != nil {
+					log.Println(err.Error())
+				}
+			case *helper.BadRequestError:
+				helper.ResponseBadRequest(w, err.Error())
+			case *helper.AuthorizationError:
+				helper.ResponseUnauthorized(w, err.Error())
+			case *helper.InternalServerError:
+				helper.ResponseInternalServerError(w, err.Error())
+			default:
+				helper.ResponseInternalServerError(w, err.Error())
+			}
+		default:
+			methods := []string{http.MethodGet}
+			helper.ResponseNotAllowedMethod(w, errMsgNotAllowedMethod, methods)
+		}
+	default:
+		helper.ResponseInternalServerError(w, errMsgControllerPath)
+	}
+}
+
+func getTimeline(r *http.Request) (postings []model.Posting, userNames []string, likedCounts []int64, likes []model.Like, next string, err error) {
+	tokenUserName, err := context.GetTokenUserName(r.Context())
+	if err != nil {
+		log.Println(err)
+		err = helper.NewInternalServerError(err.Error())
+		return
+	}
+
+	// get request parameter
+	cursor, limit, err := getPagingParams(r, true)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	// db connect
+	db, err := mysql.NewDB()
+	if err != nil {
+		log.Println(err)
+		err = helper.NewInternalServerError(err.Error())
+		return
+	}
+	defer db.Close()
+	tx := mysql.NewDBTransaction(db)
+
+	// repository
+	userRepo := repository.NewUserRepository(db)
+	postingRepo := repository.NewPostingRepository(db)
+	postingImageRepo := repository.NewPostingImageRepository(db)
+
+	// UseCase
+	u := usecase.NewGetTimeline(tx, tokenUserName, cursor, int8(limit), userRepo, postingRepo, postingImageRepo, repository.DefaultPostingViewBuffer)
+	if postings, userNames, likedCounts, likes, err = u.GetTimelineUseCase(r.Context()); err != nil {
+		log.Println(err)
+		err = helper.NewInternalServerError(err.Error())
+		return
+	}
+	next = postingsNextCursor(postings, limit)
+	return
+}
@@ -0,0 +1,149 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	httpContext "github.com/gold-kou/ToeBeans/backend/app/adapter/http/context"
+	"github.com/gold-kou/ToeBeans/backend/app/application/usecase"
+
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+
+	"github.com/gold-kou/ToeBeans/backend/testing/dummy"
+
+	testingHelper "github.com/gold-kou/ToeBeans/backend/testing"
+	"github.com/stretchr/testify/assert"
+)
+
+var successRespGetTimeline = `
+{
+  "postings": [
+    {
+      "posting_id": 2,
+      "user_name": "testUser2",
+      "uploaded_at": "2020-01-01T00:00:00+09:00",
+      "title": "test title",
+      "alt_text": "test alt text",
+      "image_url": "test url",
+      "image_urls": {
+        "thumb": "test url",
+        "feed": "test url",
+        "full": "test url"
+      },
+      "images": [
+        {
+          "thumb": "test url",
+          "feed": "test url",
+          "full": "test url"
+        }
+      ],
+      "liked_count": 1,
+      "liked": true
+    }
+  ]
+}
+`
+var errRespGetTimelineWithoutLimit = `
+{
+  "status": 400,
+  "message": "limit: cannot be blank."
+}
+`
+
+func TestGetTimeline(t *testing.T) {
+	type args struct {
+		limit string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		method     string
+		follow     bool
+		want       string
+		wantStatus int
+	}{
+		{
+			name:       "success",
+			args:       args{limit: "50"},
+			method:     http.MethodGet,
+			follow:     true,
+			want:       successRespGetTimeline,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "success no followees",
+			args:       args{limit: "50"},
+			method:     http.MethodGet,
+			want:       successRespGetPostingsEmpty,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "error empty limit",
+			args:       args{},
+			method:     http.MethodGet,
+			want:       errRespGetTimelineWithoutLimit,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "not allowed method",
+			args:       args{},
+			method:     http.MethodHead,
+			want:       testingHelper.ErrNotAllowedMethod,
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// init
+			db := testingHelper.SetupDBTest()
+			defer testingHelper.TeardownDBTest(db)
+			testingHelper.SetTestTime()
+			defer testingHelper.ResetTime()
+
+			// insert dummy data
+			userRepo := repository.NewUserRepository(db)
+			postingRepo := repository.NewPostingRepository(db)
+			likeRepo := repository.NewLikeRepository(db)
+			followRepo := repository.NewFollowRepository(db)
+			err := userRepo.Create(context.Background(), &dummy.User1)
+			assert.NoError(t, err)
+			err = userRepo.Create(context.Background(), &dummy.User2)
+			assert.NoError(t, err)
+			err = postingRepo.Create(context.Background(), &dummy.Posting1)
+			assert.NoError(t, err)
+			err = postingRepo.Create(context.Background(), &dummy.Posting2)
+			assert.NoError(t, err)
+			err = testingHelper.UpdateNow(db, "postings")
+			assert.NoError(t, err)
+			err = likeRepo.Create(context.Background(), &dummy.Like1to2)
+			assert.NoError(t, err)
+			if tt.follow {
+				err = followRepo.Create(context.Background(), &dummy.Follow1to2)
+				assert.NoError(t, err)
+			}
+			err = usecase.NewReconcileCounters(repository.NewPostingCounterRepository(db), repository.NewUserCounterRepository(db)).ReconcileCountersUseCase(context.Background())
+			assert.NoError(t, err)
+
+			// http request
+			req, err := http.NewRequest(tt.method, fmt.Sprintf("/timeline?limit=%s", tt.args.limit), nil)
+			assert.NoError(t, err)
+			req = req.WithContext(httpContext.SetTokenUserName(req.Context(), dummy.User1.Name))
+			resp := httptest.NewRecorder()
+
+			// test target
+			TimelineController(resp, req)
+			assert.NoError(t, err)
+
+			// assert http
+			assert.Equal(t, tt.wantStatus, resp.Code)
+			respBodyByte, err := ioutil.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			respBody := string(respBodyByte)
+			assert.JSONEq(t, tt.want, respBody)
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package controller
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"net/http"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/http/context"
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/http/helper"
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/application/usecase"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+	modelHTTP "github.com/gold-kou/ToeBeans/backend/app/domain/model/http"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+)
+
+func UploadController(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.URL.Path == "/uploads":
+		switch r.Method {
+		case http.MethodPost:
+			upload, err := registerUpload(r)
+			switch err := err.(type) {
+			case nil:
+				resp := modelHTTP.ResponseRegisterUpload{
+					UploadKey: upload.Key,
+					UploadURL: upload.URL,
+					ExpiresAt: upload.ExpiresAt,
+				}
+				w.Header().Set(helper.HeaderKeyContentType, helper.HeaderValueApplicationJSON)
+				w.WriteHeader(http.StatusOK)
+				if err := json.NewEncoder(w).Encode(resp); err != nil {
+					log.Println(err.Error())
+				}
+			case *helper.BadRequestError:
+				helper.ResponseBadRequest(w, err.Error())
+			case *helper.AuthorizationError:
+				helper.ResponseUnauthorized(w, err.Error())
+			case *helper.InternalServerError:
+				helper.ResponseInternalServerError(w, err.Error())
+			default:
+				helper.ResponseInternalServerError(w, err.Error())
+			}
+		default:
+			methods := []string{http.MethodPost}
+			helper.ResponseNotAllowedMethod(w, errMsgNotAllowedMethod, methods)
+		}
+	default:
+		helper.ResponseInternalServerError(w, errMsgControllerPath)
+	}
+}
+
+func registerUpload(r *http.Request) (upload model.Upload, err error) {
+	tokenUserName, err := context.GetTokenUserName(r.Context())
+	if err != nil {
+		log.Println(err)
+		return upload, helper.NewInternalServerError(err.Error())
+	}
+
+	// get request parameter
+	var reqRegisterUpload *modelHTTP.RequestRegisterUpload
+	b, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		log.Println(err)
+		return upload, helper.NewBadRequestError(err.Error())
+	}
+	defer r.Body.Close()
+	if err := json.Unmarshal(b, &reqRegisterUpload); err != nil {
+		log.Println(err)
+		return upload, helper.NewBadRequestError(err.Error())
+	}
+
+	// validation check
+	err = reqRegisterUpload.ValidateParam()
+	if err != nil {
+		log.Println(err)
+		return upload, helper.NewBadRequestError(err.Error())
+	}
+	// the presigned URL accepts exactly this size, so larger images never reach the bucket
+	if reqRegisterUpload.Size > helper.ImageMaxByte {
+		log.Println(helper.ErrImageTooLarge)
+		return upload, helper.NewBadRequestError(helper.ErrImageTooLarge.Error())
+	}
+
+	// db connect
+	db, err := mysql.NewDB()
+	if err != nil {
+		log.Println(err)
+		return upload, helper.NewInternalServerError(err.Error())
+	}
+	defer db.Close()
+
+	// repository
+	userRepo := repository.NewUserRepository(db)
+
+	// UseCase
+	u := usecase.NewRegisterUpload(tokenUserName, reqRegisterUpload, userRepo)
+	upload, err = u.RegisterUploadUseCase(r.Context())
+	if err != nil {
+		log.Println(err)
+		if err == usecase.ErrTokenInvalidNotExistingUserName {
+			return upload, helper.NewAuthorizationError(err.Error())
+		}
+		return upload, helper.NewInternalServerError(err.Error())
+	}
+	return upload, nil
+}
@@ -0,0 +1,169 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	httpContext "github.com/gold-kou/ToeBeans/backend/app/adapter/http/context"
+	modelHTTP "github.com/gold-kou/ToeBeans/backend/app/domain/model/http"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+	"github.com/gold-kou/ToeBeans/backend/app/lib"
+
+	"github.com/gold-kou/ToeBeans/backend/testing/dummy"
+
+	testingHelper "github.com/gold-kou/ToeBeans/backend/testing"
+	"github.com/stretchr/testify/assert"
+)
+
+var successReqRegisterUpload = `
+{
+  "content_type": "image/png",
+  "size": 1024
+}
+`
+var errReqRegisterUploadGIF = `
+{
+  "content_type": "image/gif",
+  "size": 1024
+}
+`
+var errReqRegisterUploadWithoutSize = `
+{
+  "content_type": "image/png"
+}
+`
+var errReqRegisterUploadTooLarge = `
+{
+  "content_type": "image/png",
+  "size": 104857600
+}
+`
+
+var errRespRegisterUploadGIF = `
+{
+  "status": 400,
+  "message": "content_type: must be a valid value."
+}
+`
+var errRespRegisterUploadWithoutSize = `
+{
+  "status": 400,
+  "message": "size: cannot be blank."
+}
+`
+var errRespRegisterUploadTooLarge = `
+{
+  "status": 400,
+  "message": "image is too large"
+}
+`
+var errRespRegisterUploadNotExistingUser = `
+{
+  "status": 401,
+  "message": "the user name contained in token doesn't exist"
+}
+`
+
+func TestRegisterUpload(t *testing.T) {
+	type args struct {
+		reqBody string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		method     string
+		want       string
+		wantStatus int
+	}{
+		{
+			name:       "success",
+			args:       args{reqBody: successReqRegisterUpload},
+			method:     http.MethodPost,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "error unsupported content type",
+			args:       args{reqBody: errReqRegisterUploadGIF},
+			method:     http.MethodPost,
+			want:       errRespRegisterUploadGIF,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "error empty size",
+			args:       args{reqBody: errReqRegisterUploadWithoutSize},
+			method:     http.MethodPost,
+			want:       errRespRegisterUploadWithoutSize,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "error too large",
+			args:       args{reqBody: errReqRegisterUploadTooLarge},
+			method:     http.MethodPost,
+			want:       errRespRegisterUploadTooLarge,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "error not existing user",
+			args:       args{reqBody: successReqRegisterUpload},
+			method:     http.MethodPost,
+			want:       errRespRegisterUploadNotExistingUser,
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "not allowed method",
+			args:       args{},
+			method:     http.MethodGet,
+			want:       testingHelper.ErrNotAllowedMethod,
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// init
+			db := testingHelper.SetupDBTest()
+			defer testingHelper.TeardownDBTest(db)
+			testingHelper.SetTestTime()
+			defer testingHelper.ResetTime()
+
+			// insert dummy data
+			userRepo := repository.NewUserRepository(db)
+			err := userRepo.Create(context.Background(), &dummy.User1)
+			assert.NoError(t, err)
+
+			// http request
+			req, err := http.NewRequest(tt.method, "/uploads", strings.NewReader(tt.args.reqBody))
+			assert.NoError(t, err)
+			if tt.name == "error not existing user" {
+				req = req.WithContext(httpContext.SetTokenUserName(req.Context(), dummy.User2.Name))
+			} else {
+				req = req.WithContext(httpContext.SetTokenUserName(req.Context(), dummy.User1.Name))
+			}
+			resp := httptest.NewRecorder()
+
+			// test target
+			UploadController(resp, req)
+
+			// assert http
+			assert.Equal(t, tt.wantStatus, resp.Code)
+			respBodyByte, err := ioutil.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			if tt.wantStatus == http.StatusOK {
+				// the key and the signature are random
+				var upload modelHTTP.ResponseRegisterUpload
+				assert.NoError(t, json.Unmarshal(respBodyByte, &upload))
+				assert.Regexp(t, `^uploads/1/[0-9a-f-]{36}$`, upload.UploadKey)
+				assert.True(t, strings.HasPrefix(upload.UploadURL, "http://localhost:9000/"))
+				assert.Contains(t, upload.UploadURL, upload.UploadKey)
+				assert.True(t, lib.NowFunc().Add(15*time.Minute).Equal(upload.ExpiresAt))
+				return
+			}
+			assert.JSONEq(t, tt.want, string(respBodyByte))
+		})
+	}
+}
@@ -27,12 +27,12 @@ func UserController(w http.ResponseWriter, r *http.Request) {
 	case r.URL.Path == "/users":
 		switch r.Method {
 		case http.MethodGet:
-			user, postingCount, likeCount, likedCount, followCount, followedCount, err := getUser(r)
+			user, postingCount, likeCount, likedCount, followCount, followedCount, cats, collections, err := getUser(r)
 			switch err := err.(type) {
 			case nil:
 				resp := modelHTTP.ResponseGetUser{
 					UserName:         user.Name,
-					Icon:             user.Icon,
+					Icon:             usecase.SignIconURL(user.Icon),
 					SelfIntroduction: user.SelfIntroduction,
 					PostingCount:     postingCount,
 					LikeCount:        likeCount,
@@ -40,6 +40,8 @@ func UserController(w http.ResponseWriter, r *http.Request) {
 					FollowCount:      followCount,
 					FollowedCount:    followedCount,
 					CreatedAt:        user.CreatedAt,
+					Cats:             newResponseGetCats(cats, user.Name),
+					Collections:      newResponseGetCollections(collections, user.Name),
 				}
 				w.Header().Set(helper.HeaderKeyContentType, helper.HeaderValueApplicationJSON)
 				w.WriteHeader(http.StatusOK)
@@ -191,7 +193,7 @@ func registerUser(r *http.Request) error {
 	return err
 }
 
-func getUser(r *http.Request) (user model.User, postingCount, likeCount, likedCount, followCount, followedCount int64, err error) {
+func getUser(r *http.Request) (user model.User, postingCount, likeCount, likedCount, followCount, followedCount int64, cats []model.Cat, collections []model.Collection, err error) {
 	tokenUserName, err := context.GetTokenUserName(r.Context())
 	if err != nil {
 		log.Println(err)
@@ -226,13 +228,13 @@ func getUser(r *http.Request) (user model.User, postingCount, likeCount, likedCo
 
 	// repository
 	userRepo := repository.NewUserRepository(db)
-	positngRepo := repository.NewPostingRepository(db)
-	likeRepo := repository.NewLikeRepository(db)
-	followRepo := repository.NewFollowRepository(db)
+	userCounterRepo := repository.NewUserCounterRepository(db)
+	catRepo := repository.NewCatRepository(db)
+	collectionRepo := repository.NewCollectionRepository(db)
 
 	// UseCase
-	u := usecase.NewGetUser(tx, tokenUserName, targetUserName, userRepo, positngRepo, likeRepo, followRepo)
-	if user, postingCount, likeCount, likedCount, followCount, followedCount, err = u.GetUserUseCase(r.Context()); err != nil {
+	u := usecase.NewGetUser(tx, tokenUserName, targetUserName, userRepo, userCounterRepo, catRepo, collectionRepo)
+	if user, postingCount, likeCount, likedCount, followCount, followedCount, cats, collections, err = u.GetUserUseCase(r.Context()); err != nil {
 		log.Println(err)
 		if err == usecase.ErrNotExistsData {
 			err = helper.NewNotFoundError(err.Error())
@@ -304,9 +306,10 @@ func updateUser(r *http.Request) (err error) {
 
 	// repository
 	userRepo := repository.NewUserRepository(db)
+	objectDeletionRepo := repository.NewObjectDeletionRepository(db)
 
 	// UseCase
-	u := usecase.NewUpdateUser(tx, userName, reqUpdateUser, userRepo)
+	u := usecase.NewUpdateUser(tx, userName, reqUpdateUser, userRepo, objectDeletionRepo)
 	if err = u.UpdateUserUseCase(r.Context()); err != nil {
 		log.Println(err)
 		if err == usecase.ErrNotExistsData || err == usecase.ErrDecodeImage {
@@ -358,9 +361,21 @@ func deleteUser(r *http.Request) (err error) {
 	likeRepo := repository.NewLikeRepository(db)
 	commentRepo := repository.NewCommentRepository(db)
 	followRepo := repository.NewFollowRepository(db)
+	postingImageRepo := repository.NewPostingImageRepository(db)
+	postingTagRepo := repository.NewPostingTagRepository(db)
+	catRepo := repository.NewCatRepository(db)
+	postingCatRepo := repository.NewPostingCatRepository(db)
+	bookmarkRepo := repository.NewBookmarkRepository(db)
+	collectionRepo := repository.NewCollectionRepository(db)
+	collectionPostingRepo := repository.NewCollectionPostingRepository(db)
+	postingViewRepo := repository.NewPostingViewRepository(db)
+	draftRepo := repository.NewDraftRepository(db)
+	postingCounterRepo := repository.NewPostingCounterRepository(db)
+	userCounterRepo := repository.NewUserCounterRepository(db)
+	objectDeletionRepo := repository.NewObjectDeletionRepository(db)
 
 	// UseCase
-	u := usecase.NewDeleteUser(tx, userName, userRepo, passwordResetRepo, postingRepo, likeRepo, commentRepo, followRepo)
+	u := usecase.NewDeleteUser(tx, userName, userRepo, passwordResetRepo, postingRepo, likeRepo, commentRepo, followRepo, postingImageRepo, postingTagRepo, catRepo, postingCatRepo, bookmarkRepo, collectionRepo, collectionPostingRepo, postingViewRepo, draftRepo, postingCounterRepo, userCounterRepo, objectDeletionRepo)
 	if err = u.DeleteUserUseCase(r.Context()); err != nil {
 		log.Println(err)
 		if err == usecase.ErrNotExitsUser {
@@ -9,13 +9,17 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	httpContext "github.com/gold-kou/ToeBeans/backend/app/adapter/http/context"
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/application/usecase"
 
 	"github.com/gorilla/mux"
 
 	"github.com/gold-kou/ToeBeans/backend/app/lib"
 
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
 	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
 
 	"github.com/gold-kou/ToeBeans/backend/app/adapter/http/helper"
@@ -242,7 +246,69 @@ var successRespGetUser = `
   "liked_count": 1,
   "follow_count": 1,
   "followed_count": 1,
-  "created_at": "2020-01-01T00:00:00+09:00"
+  "created_at": "2020-01-01T00:00:00+09:00",
+  "cats": [
+    {
+      "cat_id": 1,
+      "user_name": "testUser1",
+      "name": "Tama",
+      "breed": "Japanese Bobtail",
+      "birthday": "2018-04-01",
+      "icon": "UNKNOWN",
+      "bio": "likes napping in the sun",
+      "created_at": "2020-01-01T00:00:00+09:00"
+    }
+  ],
+  "collections": [
+    {
+      "collection_id": 1,
+      "user_name": "testUser1",
+      "name": "sleeping cats",
+      "is_public": true,
+      "created_at": "2020-01-01T00:00:00+09:00"
+    },
+    {
+      "collection_id": 2,
+      "user_name": "testUser1",
+      "name": "secret",
+      "is_public": false,
+      "created_at": "2020-01-01T00:00:00+09:00"
+    }
+  ]
+}
+`
+var successRespGetUserByOthers = `
+{
+  "user_name": "testUser1",
+  "icon": "UNKNOWN",
+  "self_introduction": "UNKNOWN",
+  "posting_count": 1,
+  "like_count": 1,
+  "liked_count": 1,
+  "follow_count": 1,
+  "followed_count": 1,
+  "created_at": "2020-01-01T00:00:00+09:00",
+  "cats": [
+    {
+      "cat_id": 1,
+      "user_name": "testUser1",
+      "name": "Tama",
+      "breed": "Japanese Bobtail",
+      "birthday": "2018-04-01",
+      "icon": "UNKNOWN",
+      "bio": "likes napping in the sun",
+      "created_at": "2020-01-01T00:00:00+09:00"
+    }
+  ],
+  "collections": [
+    {
+      "collection_id": 1,
+      "user_name": "testUser1",
+      "name": "sleeping cats",
+      "is_public": true,
+      "created_at": "2020-01-01T00:00:00+09:00"
+    }
+  ]
 }
 `
 var errorRespGetUserNameShort = `
@@ -266,7 +332,8 @@ var errRespGetUserNotExists = `
 
 func TestGetUser(t *testing.T) {
 	type args struct {
-		userName string
+		tokenUserName string
+		userName      string
 	}
 	tests := []struct {
 		name       string
@@ -282,6 +349,27 @@ func TestGetUser(t *testing.T) {
 			want:       successRespGetUser,
 			wantStatus: http.StatusOK,
 		},
+		{
+			name:       "success scheduled posting not counted",
+			args:       args{userName: dummy.User1.Name},
+			method:     http.MethodGet,
+			want:       successRespGetUser,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "success scheduled posting counted once published",
+			args:       args{userName: dummy.User1.Name},
+			method:     http.MethodGet,
+			want:       strings.Replace(successRespGetUser, `"posting_count": 1`, `"posting_count": 2`, 1),
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "success others see only public collections",
+			args:       args{tokenUserName: dummy.User2.Name, userName: dummy.User1.Name},
+			method:     http.MethodGet,
+			want:       successRespGetUserByOthers,
+			wantStatus: http.StatusOK,
+		},
 		{
 			name:       "error user_name short",
 			args:       args{userName: "a"},
@@ -317,6 +405,8 @@ func TestGetUser(t *testing.T) {
 			postingRepo := repository.NewPostingRepository(db)
 			likeRepo := repository.NewLikeRepository(db)
 			followRepo := repository.NewFollowRepository(db)
+			catRepo := repository.NewCatRepository(db)
+			collectionRepo := repository.NewCollectionRepository(db)
 			err := userRepo.Create(context.Background(), &dummy.User1)
 			assert.NoError(t, err)
 			err = userRepo.Create(context.Background(), &dummy.User2)
@@ -333,11 +423,48 @@ func TestGetUser(t *testing.T) {
 			assert.NoError(t, err)
 			err = followRepo.Create(context.Background(), &dummy.Follow2to1)
 			assert.NoError(t, err)
+			err = catRepo.Create(context.Background(), &dummy.Cat1)
+			assert.NoError(t, err)
+			err = catRepo.Create(context.Background(), &dummy.Cat2)
+			assert.NoError(t, err)
+			err = testingHelper.UpdateNow(db, "cats")
+			assert.NoError(t, err)
+			err = collectionRepo.Create(context.Background(), &dummy.Collection1)
+			assert.NoError(t, err)
+			err = collectionRepo.Create(context.Background(), &dummy.Collection2)
+			assert.NoError(t, err)
+			err = testingHelper.UpdateNow(db, "collections")
+			assert.NoError(t, err)
+			switch tt.name {
+			case "success scheduled posting not counted":
+				publishAt := lib.NowFunc().AddDate(0, 0, 1)
+				scheduled := dummy.Posting1
+				scheduled.PublishAt = &publishAt
+				err = postingRepo.Create(context.Background(), &scheduled)
+				assert.NoError(t, err)
+			case "success scheduled posting counted once published":
+				publishAt := lib.NowFunc().Add(-time.Minute)
+				scheduled := dummy.Posting1
+				scheduled.PublishAt = &publishAt
+				err = postingRepo.Create(context.Background(), &scheduled)
+				assert.NoError(t, err)
+			}
+			// the dummy data bypasses the counters, so the reconciler counts them as drifted and repairs them
+			err = usecase.NewReconcileCounters(repository.NewPostingCounterRepository(db), repository.NewUserCounterRepository(db)).ReconcileCountersUseCase(context.Background())
+			assert.NoError(t, err)
+			if tt.name == "success scheduled posting counted once published" {
+				err = usecase.NewPublishScheduledPostings(mysql.NewDBTransaction(db), postingRepo, repository.NewUserCounterRepository(db)).PublishScheduledPostingsUseCase(context.Background())
+				assert.NoError(t, err)
+			}
 
 			// http request
 			req, err := http.NewRequest(tt.method, fmt.Sprintf("/users?user_name=%s", tt.args.userName), nil)
 			assert.NoError(t, err)
-			req = req.WithContext(httpContext.SetTokenUserName(req.Context(), dummy.User1.Name))
+			tokenUserName := tt.args.tokenUserName
+			if tokenUserName == "" {
+				tokenUserName = dummy.User1.Name
+			}
+			req = req.WithContext(httpContext.SetTokenUserName(req.Context(), tokenUserName))
 			resp := httptest.NewRecorder()
 
 			// test target
@@ -487,7 +614,8 @@ func TestUpdateUser(t *testing.T) {
 			if tt.wantStatus == http.StatusOK {
 				users, err := testingHelper.FindAllUsers(context.Background(), db)
 				assert.NoError(t, err)
-				assert.Equal(t, "http://localhost:9000/toebeans-icons/testUser1", users[0].Icon)
+				// the key is derived from the content of the icon under the user's prefix
+				assert.Regexp(t, `^http://localhost:9000/toebeans-icons/1/[0-9a-f]{64}_icon\.jpg$`, users[0].Icon)
 				assert.Equal(t, "Hello!", users[0].SelfIntroduction)
 			}
 
@@ -560,6 +688,9 @@ func TestDeleteUser(t *testing.T) {
 			assert.NoError(t, err)
 			err = postingRepo.Create(context.Background(), &dummy.Posting2)
 			assert.NoError(t, err)
+			postingImageRepo := repository.NewPostingImageRepository(db)
+			err = postingImageRepo.Create(context.Background(), &dummy.PostingImage1)
+			assert.NoError(t, err)
 			followRepo := repository.NewFollowRepository(db)
 			err = followRepo.Create(context.Background(), &dummy.Follow1to2)
 			assert.NoError(t, err)
@@ -573,6 +704,27 @@ func TestDeleteUser(t *testing.T) {
 			assert.NoError(t, err)
 			err = likeRepo.Create(context.Background(), &dummy.Like2to1)
 			assert.NoError(t, err)
+			bookmarkRepo := repository.NewBookmarkRepository(db)
+			err = bookmarkRepo.Create(context.Background(), &dummy.Bookmark1)
+			assert.NoError(t, err)
+			err = bookmarkRepo.Create(context.Background(), &dummy.Bookmark2)
+			assert.NoError(t, err)
+			collectionRepo := repository.NewCollectionRepository(db)
+			err = collectionRepo.Create(context.Background(), &dummy.Collection1)
+			assert.NoError(t, err)
+			collectionPostingRepo := repository.NewCollectionPostingRepository(db)
+			err = collectionPostingRepo.Create(context.Background(), &dummy.CollectionPosting1)
+			assert.NoError(t, err)
+			err = collectionPostingRepo.Create(context.Background(), &dummy.CollectionPosting2)
+			assert.NoError(t, err)
+			postingViewRepo := repository.NewPostingViewRepository(db)
+			err = postingViewRepo.CreateIgnoringDuplicates(context.Background(), []model.PostingView{dummy.PostingView1to2, dummy.PostingView2to1})
+			assert.NoError(t, err)
+			draftRepo := repository.NewDraftRepository(db)
+			err = draftRepo.Create(context.Background(), &dummy.Draft1)
+			assert.NoError(t, err)
+			err = draftRepo.Create(context.Background(), &dummy.Draft3)
+			assert.NoError(t, err)
 
 			// http request
 			req, err := http.NewRequest(tt.method, fmt.Sprintf("/users/%s", tt.args.userName), nil)
@@ -595,6 +747,29 @@ func TestDeleteUser(t *testing.T) {
 				users, err := testingHelper.FindAllUsers(context.Background(), db)
 				assert.NoError(t, err)
 				assert.Equal(t, 1, len(users)) // user2がいるため
+				images, err := testingHelper.FindAllPostingImages(context.Background(), db)
+				assert.NoError(t, err)
+				assert.Equal(t, 0, len(images))
+				deletions, err := testingHelper.FindAllObjectDeletions(context.Background(), db)
+				assert.NoError(t, err)
+				assert.Equal(t, 0, len(deletions))
+				// user2のブックマークからも消える
+				bookmarks, err := testingHelper.FindAllBookmarks(context.Background(), db)
+				assert.NoError(t, err)
+				assert.Equal(t, 0, len(bookmarks))
+				collections, err := testingHelper.FindAllCollections(context.Background(), db)
+				assert.NoError(t, err)
+				assert.Equal(t, 0, len(collections))
+				collectionPostings, err := testingHelper.FindAllCollectionPostings(context.Background(), db)
+				assert.NoError(t, err)
+				assert.Equal(t, 0, len(collectionPostings))
+				views, err := testingHelper.FindAllPostingViews(context.Background(), db)
+				assert.NoError(t, err)
+				assert.Equal(t, 0, len(views))
+				drafts, err := testingHelper.FindAllDrafts(context.Background(), db)
+				assert.NoError(t, err)
+				assert.Equal(t, 1, len(drafts)) // user2の下書きは残る
+				assert.Equal(t, dummy.Draft3.ID, drafts[0].ID)
 			}
 
 			// assert http
@@ -0,0 +1,76 @@
+package helper
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+)
+
+var ErrCursorInvalid = errors.New("cursor is invalid")
+
+var cursorSecretKey string
+
+func init() {
+	// token.go may not be initialized yet, so JWT_SECRET_KEY is read here too
+	cursorSecretKey = os.Getenv("CURSOR_SECRET_KEY")
+	if cursorSecretKey == "" {
+		cursorSecretKey = os.Getenv("JWT_SECRET_KEY")
+	}
+}
+
+// EncodeCursor returns an opaque string which clients send back as is to get the next page.
+// It is signed so that a cursor made up by clients is rejected.
+func EncodeCursor(c model.Cursor) string {
+	payload := strconv.FormatInt(c.CreatedAt.UnixNano(), 10) + "_" + strconv.FormatInt(c.ID, 10)
+	return base64.RawURLEncoding.EncodeToString([]byte(payload)) + "." + base64.RawURLEncoding.EncodeToString(signCursor(payload))
+}
+
+func DecodeCursor(s string) (c model.Cursor, err error) {
+	parts := strings.Split(s, ".")
+	if len(parts) != 2 {
+		err = ErrCursorInvalid
+		return
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		err = ErrCursorInvalid
+		return
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil || !hmac.Equal(sig, signCursor(string(payload))) {
+		err = ErrCursorInvalid
+		return
+	}
+
+	fields := strings.Split(string(payload), "_")
+	if len(fields) != 2 {
+		err = ErrCursorInvalid
+		return
+	}
+	nano, err := strconv.ParseInt(fields[0], 10, 64)
+	if err != nil {
+		err = ErrCursorInvalid
+		return
+	}
+	id, err := strconv.ParseInt(fields[1], 10, 64)
+	if err != nil {
+		err = ErrCursorInvalid
+		return
+	}
+	c = model.Cursor{CreatedAt: time.Unix(0, nano), ID: id}
+	return
+}
+
+func signCursor(payload string) []byte {
+	mac := hmac.New(sha256.New, []byte(cursorSecretKey))
+	mac.Write([]byte(payload))
+	return mac.Sum(nil)
+}
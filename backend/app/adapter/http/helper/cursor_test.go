@@ -0,0 +1,59 @@
+package helper_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/http/helper"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+)
+
+func TestDecodeCursor(t *testing.T) {
+	c := model.Cursor{CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), ID: 123}
+	encoded := helper.EncodeCursor(c)
+	payload := strings.Split(encoded, ".")[0]
+	otherSig := strings.Split(helper.EncodeCursor(model.Cursor{CreatedAt: c.CreatedAt, ID: 124}), ".")[1]
+
+	tests := []struct {
+		name    string
+		cursor  string
+		want    model.Cursor
+		wantErr error
+	}{
+		{
+			name:   "success",
+			cursor: encoded,
+			want:   c,
+		},
+		{
+			name:    "error tampered",
+			cursor:  payload + "." + otherSig,
+			wantErr: helper.ErrCursorInvalid,
+		},
+		{
+			name:    "error without signature",
+			cursor:  payload,
+			wantErr: helper.ErrCursorInvalid,
+		},
+		{
+			name:    "error since_at format",
+			cursor:  "2020-01-01T00:00:00+09:00",
+			wantErr: helper.ErrCursorInvalid,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := helper.DecodeCursor(tt.cursor)
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.True(t, tt.want.CreatedAt.Equal(got.CreatedAt))
+			assert.Equal(t, tt.want.ID, got.ID)
+		})
+	}
+}
@@ -7,7 +7,8 @@ const (
 	HeaderKeyAllow         = "allow"
 	HeaderKeyAuthorization = "Authorization"
 
-	HeaderValueApplicationJSON = "application/json; charset=UTF-8"
-	HeaderValueHTML            = "text/html"
-	HeaderValueNoStore         = "no-store"
+	HeaderValueApplicationJSON   = "application/json; charset=UTF-8"
+	HeaderValueHTML              = "text/html"
+	HeaderValueNoStore           = "no-store"
+	HeaderValueMultipartFormData = "multipart/form-data"
 )
@@ -0,0 +1,84 @@
+package helper
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+var ErrImageTooLarge = errors.New("image is too large")
+var ErrUnsupportedImageType = errors.New("unsupported image type")
+
+const imageMaxByteDefault = 10 << 20
+
+// http.DetectContentType looks at no more than the first 512 bytes
+const sniffLen = 512
+
+var ImageMaxByte int64
+
+var allowedImageTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/webp": true,
+}
+
+func init() {
+	b, e := strconv.ParseInt(os.Getenv("IMAGE_MAX_SIZE_BYTE"), 10, 64)
+	if e != nil || b <= 0 {
+		ImageMaxByte = imageMaxByteDefault
+	} else {
+		ImageMaxByte = b
+	}
+}
+
+// ImageReader streams an uploaded image while enforcing ImageMaxByte.
+// The content type is decided by sniffing the leading bytes, not by what the client claims.
+type ImageReader struct {
+	io.Reader
+	ContentType string
+}
+
+func NewImageReader(r io.Reader) (*ImageReader, error) {
+	limited := &maxByteReader{r: r, remaining: ImageMaxByte}
+	head := make([]byte, sniffLen)
+	n, err := io.ReadFull(limited, head)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return nil, err
+	}
+	head = head[:n]
+
+	contentType := http.DetectContentType(head)
+	if !allowedImageTypes[contentType] {
+		return nil, ErrUnsupportedImageType
+	}
+	return &ImageReader{
+		Reader:      io.MultiReader(bytes.NewReader(head), limited),
+		ContentType: contentType,
+	}, nil
+}
+
+// maxByteReader fails with ErrImageTooLarge instead of silently truncating like io.LimitReader.
+type maxByteReader struct {
+	r         io.Reader
+	remaining int64
+}
+
+func (m *maxByteReader) Read(p []byte) (n int, err error) {
+	if m.remaining < 0 {
+		return 0, ErrImageTooLarge
+	}
+	// read one byte over the limit to tell "exactly the limit" from "over the limit"
+	if int64(len(p)) > m.remaining+1 {
+		p = p[:m.remaining+1]
+	}
+	n, err = m.r.Read(p)
+	m.remaining -= int64(n)
+	if m.remaining < 0 {
+		return n, ErrImageTooLarge
+	}
+	return
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/gold-kou/ToeBeans/backend/app/adapter/http/controller"
 	applicationLog "github.com/gold-kou/ToeBeans/backend/app/adapter/http/log"
 	"github.com/gold-kou/ToeBeans/backend/app/adapter/http/middleware"
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/job"
 
 	"github.com/gorilla/csrf"
 	"github.com/gorilla/mux"
@@ -69,8 +70,29 @@ func Serve() {
 	r.HandleFunc("/password-reset-email", controller.PasswordController)
 	r.HandleFunc("/password-reset", controller.PasswordController)
 	r.HandleFunc("/postings", controller.PostingController)
+	r.HandleFunc("/postings/popular", controller.PostingController)
+	r.HandleFunc("/postings/scheduled", controller.PostingController)
 	r.HandleFunc("/postings/{posting_id}", controller.PostingController)
+	r.HandleFunc("/postings/{posting_id}/stats", controller.PostingController)
+	r.HandleFunc("/uploads", controller.UploadController)
+	r.HandleFunc("/drafts", controller.DraftController)
+	r.HandleFunc("/drafts/{draft_id}", controller.DraftController)
+	r.HandleFunc("/drafts/{draft_id}/publish", controller.DraftController)
+	r.HandleFunc("/timeline", controller.TimelineController)
+	r.HandleFunc("/cats", controller.CatController)
+	r.HandleFunc("/cats/{cat_id}", controller.CatController)
+	r.HandleFunc("/cats/{cat_id}/postings", controller.CatController)
+	r.HandleFunc("/tags/trending", controller.TagController)
+	r.HandleFunc("/tags/{tag}/postings", controller.TagController)
+	r.HandleFunc("/search", controller.SearchController)
 	r.HandleFunc("/likes/{posting_id}", controller.LikeController)
+	r.HandleFunc("/likes/{posting_id}/users", controller.LikeController)
+	r.HandleFunc("/bookmarks", controller.BookmarkController)
+	r.HandleFunc("/bookmarks/{posting_id}", controller.BookmarkController)
+	r.HandleFunc("/collections", controller.CollectionController)
+	r.HandleFunc("/collections/{collection_id}", controller.CollectionController)
+	r.HandleFunc("/collections/{collection_id}/postings", controller.CollectionController)
+	r.HandleFunc("/collections/{collection_id}/postings/{posting_id}", controller.CollectionController)
 	r.HandleFunc("/comments/{posting_id}", controller.CommentController)
 	r.HandleFunc("/comments", controller.CommentController)
 	r.HandleFunc("/comments/{comment_id}", controller.CommentController)
@@ -78,6 +100,15 @@ func Serve() {
 	r.HandleFunc("/reports/users/{user_name}", controller.ReportController)
 	r.HandleFunc("/reports/postings/{posting_id}", controller.ReportController)
 
+	// periodic jobs. only one of the instances runs each of them except flushing the views buffered in each instance
+	jobCtx, stopJobs := context.WithCancel(context.Background())
+	go job.RefreshPostingScores(jobCtx)
+	go job.RetryObjectDeletions(jobCtx)
+	go job.FlushPostingViews(jobCtx)
+	go job.CleanUpDrafts(jobCtx)
+	go job.ReconcileCounters(jobCtx)
+	go job.PublishScheduledPostings(jobCtx)
+
 	// graceful shutdown
 	server := &http.Server{Addr: fmt.Sprintf(":%v", 80), Handler: r}
 	idleConnsClosed := make(chan struct{})
@@ -85,6 +116,7 @@ func Serve() {
 		sigCh := make(chan os.Signal, 1)
 		signal.Notify(sigCh, syscall.SIGTERM)
 		<-sigCh
+		stopJobs()
 
 		ctx, cancel := context.WithTimeout(context.Background(), gracefulShutdownTimeout)
 		defer cancel()
@@ -101,4 +133,7 @@ func Serve() {
 		log.Panic(err)
 	}
 	<-idleConnsClosed
+
+	// no more views are recorded once the requests are drained
+	job.FlushRemainingPostingViews(context.Background())
 }
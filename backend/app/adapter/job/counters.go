@@ -0,0 +1,68 @@
+package job
+
+/*
+repair the drifted like, comment, follow and posting counters periodically
+*/
+
+import (
+	"context"
+	"log"
+	"os"
+	"time"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/application/usecase"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+)
+
+const countersReconcileIntervalDefault = 24 * time.Hour
+
+var countersReconcileInterval time.Duration
+
+func init() {
+	t, e := time.ParseDuration(os.Getenv("COUNTERS_RECONCILE_INTERVAL_MINUTE") + "m")
+	if e != nil || t <= 0 {
+		countersReconcileInterval = countersReconcileIntervalDefault
+	} else {
+		countersReconcileInterval = t
+	}
+}
+
+// ReconcileCounters reconciles the counters right away and then every COUNTERS_RECONCILE_INTERVAL_MINUTE minutes until ctx is done.
+// Only the instance holding the job lock runs it.
+func ReconcileCounters(ctx context.Context) {
+	lock := newJobLock("counters")
+	defer lock.release()
+	ticker := time.NewTicker(countersReconcileInterval)
+	defer ticker.Stop()
+	for {
+		if lock.acquire(ctx) {
+			reconcileCounters(ctx)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func reconcileCounters(ctx context.Context) {
+	// db connect
+	db, err := mysql.NewDB()
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	defer db.Close()
+
+	// repository
+	postingCounterRepo := repository.NewPostingCounterRepository(db)
+	userCounterRepo := repository.NewUserCounterRepository(db)
+
+	// UseCase
+	u := usecase.NewReconcileCounters(postingCounterRepo, userCounterRepo)
+	if err = u.ReconcileCountersUseCase(ctx); err != nil {
+		log.Println(err)
+	}
+}
@@ -0,0 +1,69 @@
+package job
+
+/*
+delete the drafts kept longer than the retention with their images
+*/
+
+import (
+	"context"
+	"log"
+	"os"
+	"time"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/application/usecase"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+)
+
+const draftsCleanUpIntervalDefault = time.Hour
+
+var draftsCleanUpInterval time.Duration
+
+func init() {
+	t, e := time.ParseDuration(os.Getenv("DRAFTS_CLEAN_UP_INTERVAL_MINUTE") + "m")
+	if e != nil || t <= 0 {
+		draftsCleanUpInterval = draftsCleanUpIntervalDefault
+	} else {
+		draftsCleanUpInterval = t
+	}
+}
+
+// CleanUpDrafts cleans up the drafts right away and then every DRAFTS_CLEAN_UP_INTERVAL_MINUTE minutes until ctx is done.
+// Only the instance holding the job lock runs it.
+func CleanUpDrafts(ctx context.Context) {
+	lock := newJobLock("drafts")
+	defer lock.release()
+	ticker := time.NewTicker(draftsCleanUpInterval)
+	defer ticker.Stop()
+	for {
+		if lock.acquire(ctx) {
+			cleanUpDrafts(ctx)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func cleanUpDrafts(ctx context.Context) {
+	// db connect
+	db, err := mysql.NewDB()
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	defer db.Close()
+	tx := mysql.NewDBTransaction(db)
+
+	// repository
+	draftRepo := repository.NewDraftRepository(db)
+	objectDeletionRepo := repository.NewObjectDeletionRepository(db)
+
+	// UseCase
+	u := usecase.NewCleanUpDrafts(tx, draftRepo, objectDeletionRepo)
+	if err = u.CleanUpDraftsUseCase(ctx); err != nil {
+		log.Println(err)
+	}
+}
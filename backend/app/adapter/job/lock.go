@@ -0,0 +1,58 @@
+package job
+
+/*
+elect one instance to run each periodic job when several instances are running
+*/
+
+import (
+	"context"
+	"database/sql"
+	"log"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+)
+
+const jobLockPrefix = "toebeans_job_"
+
+// jobLock is a MySQL named lock which stays held by the instance which took it first.
+// The lock belongs to the connection, so it is released as soon as the instance stops or loses the connection,
+// and another instance takes it over at its next run.
+type jobLock struct {
+	name string
+	// a connection of its own because the lock must outlive the connections used by the jobs
+	db *sql.DB
+}
+
+func newJobLock(name string) *jobLock {
+	return &jobLock{name: jobLockPrefix + name}
+}
+
+// acquire reports whether this instance holds the lock, taking it when nobody holds it.
+func (l *jobLock) acquire(ctx context.Context) bool {
+	if l.db == nil {
+		db, err := mysql.NewDB()
+		if err != nil {
+			log.Println(err)
+			return false
+		}
+		l.db = db
+	}
+	q := "SELECT IF(IS_USED_LOCK(?) IS NULL, GET_LOCK(?, 0), IS_USED_LOCK(?) = CONNECTION_ID())"
+	var held sql.NullBool
+	if err := l.db.QueryRowContext(ctx, q, l.name, l.name, l.name).Scan(&held); err != nil {
+		log.Println(err)
+		return false
+	}
+	return held.Valid && held.Bool
+}
+
+// release gives the lock up by closing its connection.
+func (l *jobLock) release() {
+	if l.db == nil {
+		return
+	}
+	if err := l.db.Close(); err != nil {
+		log.Println(err)
+	}
+	l.db = nil
+}
@@ -0,0 +1,68 @@
+package job
+
+/*
+retry the deletions of S3 objects which failed right after their commit
+*/
+
+import (
+	"context"
+	"log"
+	"os"
+	"time"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/application/usecase"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+)
+
+const objectDeletionsRetryIntervalDefault = 5 * time.Minute
+
+var objectDeletionsRetryInterval time.Duration
+
+func init() {
+	t, e := time.ParseDuration(os.Getenv("OBJECT_DELETIONS_RETRY_INTERVAL_MINUTE") + "m")
+	if e != nil || t <= 0 {
+		objectDeletionsRetryInterval = objectDeletionsRetryIntervalDefault
+	} else {
+		objectDeletionsRetryInterval = t
+	}
+}
+
+// RetryObjectDeletions processes the queued deletions right away and then every OBJECT_DELETIONS_RETRY_INTERVAL_MINUTE minutes until ctx is done.
+// Only the instance holding the job lock runs it.
+func RetryObjectDeletions(ctx context.Context) {
+	lock := newJobLock("object_deletions")
+	defer lock.release()
+	ticker := time.NewTicker(objectDeletionsRetryInterval)
+	defer ticker.Stop()
+	for {
+		if lock.acquire(ctx) {
+			retryObjectDeletions(ctx)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func retryObjectDeletions(ctx context.Context) {
+	// db connect
+	db, err := mysql.NewDB()
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	defer db.Close()
+	tx := mysql.NewDBTransaction(db)
+
+	// repository
+	objectDeletionRepo := repository.NewObjectDeletionRepository(db)
+
+	// UseCase
+	u := usecase.NewProcessObjectDeletions(tx, objectDeletionRepo)
+	if err = u.ProcessObjectDeletionsUseCase(ctx); err != nil {
+		log.Println(err)
+	}
+}
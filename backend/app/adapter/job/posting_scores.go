@@ -0,0 +1,68 @@
+package job
+
+/*
+recompute the scores of the popular postings periodically
+*/
+
+import (
+	"context"
+	"log"
+	"os"
+	"time"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/application/usecase"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+)
+
+const postingScoresRefreshIntervalDefault = 10 * time.Minute
+
+var postingScoresRefreshInterval time.Duration
+
+func init() {
+	t, e := time.ParseDuration(os.Getenv("POSTING_SCORES_REFRESH_INTERVAL_MINUTE") + "m")
+	if e != nil || t <= 0 {
+		postingScoresRefreshInterval = postingScoresRefreshIntervalDefault
+	} else {
+		postingScoresRefreshInterval = t
+	}
+}
+
+// RefreshPostingScores refreshes the scores right away and then every POSTING_SCORES_REFRESH_INTERVAL_MINUTE minutes until ctx is done.
+// Only the instance holding the job lock runs it.
+func RefreshPostingScores(ctx context.Context) {
+	lock := newJobLock("posting_scores")
+	defer lock.release()
+	ticker := time.NewTicker(postingScoresRefreshInterval)
+	defer ticker.Stop()
+	for {
+		if lock.acquire(ctx) {
+			refreshPostingScores(ctx)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func refreshPostingScores(ctx context.Context) {
+	// db connect
+	db, err := mysql.NewDB()
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	defer db.Close()
+	tx := mysql.NewDBTransaction(db)
+
+	// repository
+	postingScoreRepo := repository.NewPostingScoreRepository(db)
+
+	// UseCase
+	u := usecase.NewRefreshPostingScores(tx, postingScoreRepo)
+	if err = u.RefreshPostingScoresUseCase(ctx); err != nil {
+		log.Println(err)
+	}
+}
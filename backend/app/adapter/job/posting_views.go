@@ -0,0 +1,72 @@
+package job
+
+/*
+write the posting views buffered in memory in batches
+*/
+
+import (
+	"context"
+	"log"
+	"os"
+	"time"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/application/usecase"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+)
+
+const postingViewsFlushIntervalDefault = 10 * time.Second
+
+var postingViewsFlushInterval time.Duration
+
+func init() {
+	t, e := time.ParseDuration(os.Getenv("POSTING_VIEWS_FLUSH_INTERVAL_SECOND") + "s")
+	if e != nil || t <= 0 {
+		postingViewsFlushInterval = postingViewsFlushIntervalDefault
+	} else {
+		postingViewsFlushInterval = t
+	}
+}
+
+// FlushPostingViews writes the buffered views every POSTING_VIEWS_FLUSH_INTERVAL_SECOND seconds, or as soon as a batch is buffered, until ctx is done.
+func FlushPostingViews(ctx context.Context) {
+	ticker := time.NewTicker(postingViewsFlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-repository.DefaultPostingViewBuffer.Ready():
+		}
+		flushPostingViews(ctx)
+	}
+}
+
+// FlushRemainingPostingViews writes the views left in the buffer. Call it after the server stops accepting requests.
+func FlushRemainingPostingViews(ctx context.Context) {
+	flushPostingViews(ctx)
+}
+
+func flushPostingViews(ctx context.Context) {
+	if repository.DefaultPostingViewBuffer.Len() == 0 {
+		return
+	}
+
+	// db connect
+	db, err := mysql.NewDB()
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	defer db.Close()
+
+	// repository
+	postingViewRepo := repository.NewPostingViewRepository(db)
+
+	// UseCase
+	u := usecase.NewFlushPostingViews(postingViewRepo, repository.DefaultPostingViewBuffer)
+	if err = u.FlushPostingViewsUseCase(ctx); err != nil {
+		log.Println(err)
+	}
+}
@@ -0,0 +1,69 @@
+package job
+
+/*
+mark the scheduled postings as published when their time has come and count them
+*/
+
+import (
+	"context"
+	"log"
+	"os"
+	"time"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/application/usecase"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+)
+
+const scheduledPostingsPublishIntervalDefault = time.Minute
+
+var scheduledPostingsPublishInterval time.Duration
+
+func init() {
+	t, e := time.ParseDuration(os.Getenv("SCHEDULED_POSTINGS_PUBLISH_INTERVAL_MINUTE") + "m")
+	if e != nil || t <= 0 {
+		scheduledPostingsPublishInterval = scheduledPostingsPublishIntervalDefault
+	} else {
+		scheduledPostingsPublishInterval = t
+	}
+}
+
+// PublishScheduledPostings publishes the due scheduled postings right away and then every SCHEDULED_POSTINGS_PUBLISH_INTERVAL_MINUTE minutes until ctx is done.
+// Only the instance holding the job lock runs it.
+func PublishScheduledPostings(ctx context.Context) {
+	lock := newJobLock("scheduled_postings")
+	defer lock.release()
+	ticker := time.NewTicker(scheduledPostingsPublishInterval)
+	defer ticker.Stop()
+	for {
+		if lock.acquire(ctx) {
+			publishScheduledPostings(ctx)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func publishScheduledPostings(ctx context.Context) {
+	// db connect
+	db, err := mysql.NewDB()
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	defer db.Close()
+	tx := mysql.NewDBTransaction(db)
+
+	// repository
+	postingRepo := repository.NewPostingRepository(db)
+	userCounterRepo := repository.NewUserCounterRepository(db)
+
+	// UseCase
+	u := usecase.NewPublishScheduledPostings(tx, postingRepo, userCounterRepo)
+	if err = u.PublishScheduledPostingsUseCase(ctx); err != nil {
+		log.Println(err)
+	}
+}
@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+	"log"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/classifier"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+)
+
+const altTextBackfillBatchSize = 100
+
+type BackfillAltTextsUseCaseInterface interface {
+	BackfillAltTextsUseCase() (int, error)
+}
+
+type BackfillAltTexts struct {
+	imageClassifier classifier.ImageClassifier
+	postingRepo     *repository.PostingRepository
+}
+
+func NewBackfillAltTexts(imageClassifier classifier.ImageClassifier, postingRepo *repository.PostingRepository) *BackfillAltTexts {
+	return &BackfillAltTexts{
+		imageClassifier: imageClassifier,
+		postingRepo:     postingRepo,
+	}
+}
+
+// BackfillAltTextsUseCase gives the postings without an alt text the default made from their cover images and returns how many postings got one.
+// A posting whose image can't be described is logged and skipped, and one in which nothing confident is seen stays empty.
+// Both are picked up again when it is run again.
+func (b *BackfillAltTexts) BackfillAltTextsUseCase(ctx context.Context) (described int, err error) {
+	var lastID int64
+	for {
+		var postings []model.Posting
+		postings, err = b.postingRepo.GetWithoutAltTextAfterID(ctx, lastID, altTextBackfillBatchSize)
+		if err != nil {
+			return
+		}
+		for _, p := range postings {
+			altText, e := describePostingImage(ctx, b.imageClassifier, p.ImageURL)
+			if e != nil {
+				log.Printf("posting %d: %v", p.ID, e)
+				continue
+			}
+			if altText == "" {
+				continue
+			}
+			if err = b.postingRepo.UpdateAltTextWhereID(ctx, altText, p.ID); err != nil {
+				return
+			}
+			described++
+		}
+		if len(postings) < altTextBackfillBatchSize {
+			return
+		}
+		lastID = postings[len(postings)-1].ID
+	}
+}
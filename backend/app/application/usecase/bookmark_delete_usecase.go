@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+)
+
+var ErrDeleteNotExistsBookmark = errors.New("can't delete not existing bookmark")
+
+type DeleteBookmarkUseCaseInterface interface {
+	DeleteBookmarkUseCase() error
+}
+
+type DeleteBookmark struct {
+	tx            mysql.DBTransaction
+	tokenUserName string
+	postingID     int64
+	userRepo      *repository.UserRepository
+	bookmarkRepo  *repository.BookmarkRepository
+}
+
+func NewDeleteBookmark(tx mysql.DBTransaction, tokenUserName string, postingID int64, userRepo *repository.UserRepository, bookmarkRepo *repository.BookmarkRepository) *DeleteBookmark {
+	return &DeleteBookmark{
+		tx:            tx,
+		tokenUserName: tokenUserName,
+		postingID:     postingID,
+		userRepo:      userRepo,
+		bookmarkRepo:  bookmarkRepo,
+	}
+}
+
+func (bookmark *DeleteBookmark) DeleteBookmarkUseCase(ctx context.Context) error {
+	// check userName in token exists
+	user, err := bookmark.userRepo.GetUserWhereName(ctx, bookmark.tokenUserName)
+	if err != nil {
+		if err == repository.ErrNotExistsData {
+			return ErrTokenInvalidNotExistingUserName
+		}
+		return err
+	}
+
+	_, err = bookmark.bookmarkRepo.GetWhereUserIDPostingID(ctx, user.ID, bookmark.postingID)
+	if err != nil {
+		if err == repository.ErrNotExistsData {
+			return ErrDeleteNotExistsBookmark
+		}
+		return err
+	}
+
+	err = bookmark.tx.Do(ctx, func(ctx context.Context) error {
+		return bookmark.bookmarkRepo.DeleteWhereUserIDPostingID(ctx, user.ID, bookmark.postingID)
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
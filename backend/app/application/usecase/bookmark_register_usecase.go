@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+	"github.com/gold-kou/ToeBeans/backend/app/lib"
+)
+
+var ErrAlreadyBookmarked = errors.New("you already bookmarked the posting")
+
+type RegisterBookmarkUseCaseInterface interface {
+	RegisterBookmarkUseCase() error
+}
+
+type RegisterBookmark struct {
+	tx            mysql.DBTransaction
+	tokenUserName string
+	postingID     int64
+	userRepo      *repository.UserRepository
+	postingRepo   *repository.PostingRepository
+	bookmarkRepo  *repository.BookmarkRepository
+}
+
+func NewRegisterBookmark(tx mysql.DBTransaction, tokenUserName string, postingID int64, userRepo *repository.UserRepository, postingRepo *repository.PostingRepository, bookmarkRepo *repository.BookmarkRepository) *RegisterBookmark {
+	return &RegisterBookmark{
+		tx:            tx,
+		tokenUserName: tokenUserName,
+		postingID:     postingID,
+		userRepo:      userRepo,
+		postingRepo:   postingRepo,
+		bookmarkRepo:  bookmarkRepo,
+	}
+}
+
+func (bookmark *RegisterBookmark) RegisterBookmarkUseCase(ctx context.Context) error {
+	// check userName in token exists
+	user, err := bookmark.userRepo.GetUserWhereName(ctx, bookmark.tokenUserName)
+	if err != nil {
+		if err == repository.ErrNotExistsData {
+			return ErrTokenInvalidNotExistingUserName
+		}
+		return err
+	}
+
+	p, err := bookmark.postingRepo.GetWhereID(ctx, bookmark.postingID)
+	if err != nil {
+		if err == repository.ErrNotExistsData {
+			return ErrNotExistsData
+		}
+		return err
+	}
+	// a scheduled posting can't be saved even by its owner, as the bookmarks show only published ones
+	if p.IsScheduled(lib.NowFunc()) {
+		return ErrNotExistsData
+	}
+
+	err = bookmark.tx.Do(ctx, func(ctx context.Context) error {
+		b := model.Bookmark{
+			UserID:    user.ID,
+			PostingID: bookmark.postingID,
+		}
+		if err := bookmark.bookmarkRepo.Create(ctx, &b); err != nil {
+			if err == repository.ErrDuplicateData {
+				return ErrAlreadyBookmarked
+			}
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+)
+
+type GetBookmarksUseCaseInterface interface {
+	GetBookmarksUseCase() ([]model.Posting, error)
+}
+
+type GetBookmarks struct {
+	tx                 mysql.DBTransaction
+	tokenUserName      string
+	cursor             model.Cursor
+	limit              int8
+	userRepo           *repository.UserRepository
+	postingRepo        *repository.PostingRepository
+	likeRepo           *repository.LikeRepository
+	postingCounterRepo *repository.PostingCounterRepository
+	postingImageRepo   *repository.PostingImageRepository
+}
+
+func NewGetBookmarks(tx mysql.DBTransaction, tokenUserName string, cursor model.Cursor, limit int8, userRepo *repository.UserRepository, postingRepo *repository.PostingRepository, likeRepo *repository.LikeRepository, postingCounterRepo *repository.PostingCounterRepository, postingImageRepo *repository.PostingImageRepository) *GetBookmarks {
+	return &GetBookmarks{
+		tx:                 tx,
+		tokenUserName:      tokenUserName,
+		cursor:             cursor,
+		limit:              limit,
+		userRepo:           userRepo,
+		postingRepo:        postingRepo,
+		likeRepo:           likeRepo,
+		postingCounterRepo: postingCounterRepo,
+		postingImageRepo:   postingImageRepo,
+	}
+}
+
+// GetBookmarksUseCase returns the postings the token user bookmarked, the most recently bookmarked first.
+// next points at the last bookmark of the page and is zero on the last page.
+func (b *GetBookmarks) GetBookmarksUseCase(ctx context.Context) (postings []model.Posting, userNames []string, likedCounts []int64, likes []model.Like, next model.Cursor, err error) {
+	// check userName in token exists
+	tokenUser, err := b.userRepo.GetUserWhereName(ctx, b.tokenUserName)
+	if err != nil {
+		if err == repository.ErrNotExistsData {
+			err = ErrTokenInvalidNotExistingUserName
+			return
+		}
+		return
+	}
+
+	likes, err = b.likeRepo.GetWhereUserID(ctx, tokenUser.ID)
+	if err != nil {
+		if err == repository.ErrNotExistsData {
+			// not error
+			err = nil
+		}
+		return
+	}
+
+	bookmarked, err := b.postingRepo.GetBookmarkedPostings(ctx, b.cursor, b.limit, tokenUser.ID)
+	if err != nil {
+		return
+	}
+	for _, p := range bookmarked {
+		postings = append(postings, p.Posting)
+	}
+	if len(bookmarked) > 0 && len(bookmarked) == int(b.limit) {
+		last := bookmarked[len(bookmarked)-1]
+		next = model.Cursor{CreatedAt: last.BookmarkedAt, ID: last.BookmarkID}
+	}
+
+	userNames, likedCounts, err = fillPostings(ctx, b.userRepo, b.postingCounterRepo, b.postingImageRepo, postings)
+	return
+}
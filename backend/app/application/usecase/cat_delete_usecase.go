@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+)
+
+type DeleteCatUseCaseInterface interface {
+	DeleteCatUseCase() error
+}
+
+type DeleteCat struct {
+	tx                 mysql.DBTransaction
+	catID              int64
+	tokenUserName      string
+	userRepo           *repository.UserRepository
+	catRepo            *repository.CatRepository
+	postingCatRepo     *repository.PostingCatRepository
+	objectDeletionRepo *repository.ObjectDeletionRepository
+}
+
+func NewDeleteCat(tx mysql.DBTransaction, catID int64, tokenUserName string, userRepo *repository.UserRepository, catRepo *repository.CatRepository, postingCatRepo *repository.PostingCatRepository, objectDeletionRepo *repository.ObjectDeletionRepository) *DeleteCat {
+	return &DeleteCat{
+		tx:                 tx,
+		catID:              catID,
+		tokenUserName:      tokenUserName,
+		userRepo:           userRepo,
+		catRepo:            catRepo,
+		postingCatRepo:     postingCatRepo,
+		objectDeletionRepo: objectDeletionRepo,
+	}
+}
+
+// DeleteCatUseCase deletes the cat. The postings tagged with it are kept.
+func (cat *DeleteCat) DeleteCatUseCase(ctx context.Context) error {
+	// check userName in token exists
+	user, err := cat.userRepo.GetUserWhereName(ctx, cat.tokenUserName)
+	if err != nil {
+		if err == repository.ErrNotExistsData {
+			return ErrTokenInvalidNotExistingUserName
+		}
+		return err
+	}
+
+	c, err := cat.catRepo.GetWhereID(ctx, cat.catID)
+	if err != nil {
+		if err == repository.ErrNotExistsData {
+			return ErrNotExistsData
+		}
+		return err
+	}
+	if c.UserID != user.ID {
+		return ErrNotCatOwner
+	}
+
+	var deletions []model.ObjectDeletion
+	err = cat.tx.Do(ctx, func(ctx context.Context) error {
+		if c.Icon != iconUnset {
+			// another cat of the user may have the same image as its icon
+			shared, err := cat.catRepo.ExistsIconInOtherCats(ctx, c.Icon, c.ID)
+			if err != nil {
+				return err
+			}
+			if !shared {
+				d, err := enqueueObjectDeletions(ctx, cat.objectDeletionRepo, bucketIcons, []string{objectKeyFromURL(c.Icon, bucketIcons)})
+				if err != nil {
+					return err
+				}
+				deletions = append(deletions, d...)
+			}
+		}
+		if err := cat.postingCatRepo.DeleteWhereCatID(ctx, c.ID); err != nil {
+			return err
+		}
+		return cat.catRepo.DeleteWhereID(ctx, c.ID)
+	})
+	if err != nil {
+		return err
+	}
+	deleteObjects(ctx, cat.tx, cat.objectDeletionRepo, deletions)
+	return nil
+}
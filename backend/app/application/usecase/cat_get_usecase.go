@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+)
+
+type GetCatUseCaseInterface interface {
+	GetCatUseCase() (*model.Cat, error)
+}
+
+type GetCat struct {
+	tx            mysql.DBTransaction
+	tokenUserName string
+	catID         int64
+	userRepo      *repository.UserRepository
+	catRepo       *repository.CatRepository
+}
+
+func NewGetCat(tx mysql.DBTransaction, tokenUserName string, catID int64, userRepo *repository.UserRepository, catRepo *repository.CatRepository) *GetCat {
+	return &GetCat{
+		tx:            tx,
+		tokenUserName: tokenUserName,
+		catID:         catID,
+		userRepo:      userRepo,
+		catRepo:       catRepo,
+	}
+}
+
+// GetCatUseCase returns the cat and the name of its owner.
+func (cat *GetCat) GetCatUseCase(ctx context.Context) (c model.Cat, userName string, err error) {
+	// check userName in token exists
+	_, err = cat.userRepo.GetUserWhereName(ctx, cat.tokenUserName)
+	if err != nil {
+		if err == repository.ErrNotExistsData {
+			err = ErrTokenInvalidNotExistingUserName
+		}
+		return
+	}
+
+	c, err = cat.catRepo.GetWhereID(ctx, cat.catID)
+	if err != nil {
+		if err == repository.ErrNotExistsData {
+			err = ErrNotExistsData
+		}
+		return
+	}
+
+	owner, err := cat.userRepo.GetUserWhereID(ctx, c.UserID)
+	if err != nil {
+		return
+	}
+	userName = owner.Name
+	return
+}
@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+)
+
+type GetCatPostingsUseCaseInterface interface {
+	GetCatPostingsUseCase() ([]model.Posting, error)
+}
+
+type GetCatPostings struct {
+	tx                 mysql.DBTransaction
+	tokenUserName      string
+	catID              int64
+	cursor             model.Cursor
+	limit              int8
+	userRepo           *repository.UserRepository
+	postingRepo        *repository.PostingRepository
+	likeRepo           *repository.LikeRepository
+	postingCounterRepo *repository.PostingCounterRepository
+	postingImageRepo   *repository.PostingImageRepository
+	catRepo            *repository.CatRepository
+}
+
+func NewGetCatPostings(tx mysql.DBTransaction, tokenUserName string, catID int64, cursor model.Cursor, limit int8, userRepo *repository.UserRepository, postingRepo *repository.PostingRepository, likeRepo *repository.LikeRepository, postingCounterRepo *repository.PostingCounterRepository, postingImageRepo *repository.PostingImageRepository, catRepo *repository.CatRepository) *GetCatPostings {
+	return &GetCatPostings{
+		tx:                 tx,
+		tokenUserName:      tokenUserName,
+		catID:              catID,
+		cursor:             cursor,
+		limit:              limit,
+		userRepo:           userRepo,
+		postingRepo:        postingRepo,
+		likeRepo:           likeRepo,
+		postingCounterRepo: postingCounterRepo,
+		postingImageRepo:   postingImageRepo,
+		catRepo:            catRepo,
+	}
+}
+
+// GetCatPostingsUseCase returns the gallery of the cat, which is the postings tagged with it.
+func (p *GetCatPostings) GetCatPostingsUseCase(ctx context.Context) (postings []model.Posting, userNames []string, likedCounts []int64, likes []model.Like, err error) {
+	// check userName in token exists
+	tokenUser, err := p.userRepo.GetUserWhereName(ctx, p.tokenUserName)
+	if err != nil {
+		if err == repository.ErrNotExistsData {
+			err = ErrTokenInvalidNotExistingUserName
+			return
+		}
+		return
+	}
+
+	if _, err = p.catRepo.GetWhereID(ctx, p.catID); err != nil {
+		if err == repository.ErrNotExistsData {
+			err = ErrNotExistsData
+		}
+		return
+	}
+
+	likes, err = p.likeRepo.GetWhereUserID(ctx, tokenUser.ID)
+	if err != nil {
+		if err == repository.ErrNotExistsData {
+			// not error
+			err = nil
+		}
+		return
+	}
+
+	postings, err = p.postingRepo.GetPostingsWhereCatID(ctx, p.cursor, p.limit, p.catID)
+	if err != nil {
+		return
+	}
+
+	userNames, likedCounts, err = fillPostings(ctx, p.userRepo, p.postingCounterRepo, p.postingImageRepo, postings)
+	return
+}
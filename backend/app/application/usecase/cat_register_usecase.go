@@ -0,0 +1,119 @@
+package usecase
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+	modelHTTP "github.com/gold-kou/ToeBeans/backend/app/domain/model/http"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+)
+
+type RegisterCatUseCaseInterface interface {
+	RegisterCatUseCase() error
+}
+
+type RegisterCat struct {
+	tx                 mysql.DBTransaction
+	tokenUserName      string
+	reqRegisterCat     *modelHTTP.RequestRegisterCat
+	userRepo           *repository.UserRepository
+	catRepo            *repository.CatRepository
+	objectDeletionRepo *repository.ObjectDeletionRepository
+}
+
+func NewRegisterCat(tx mysql.DBTransaction, tokenUserName string, reqRegisterCat *modelHTTP.RequestRegisterCat, userRepo *repository.UserRepository, catRepo *repository.CatRepository, objectDeletionRepo *repository.ObjectDeletionRepository) *RegisterCat {
+	return &RegisterCat{
+		tx:                 tx,
+		tokenUserName:      tokenUserName,
+		reqRegisterCat:     reqRegisterCat,
+		userRepo:           userRepo,
+		catRepo:            catRepo,
+		objectDeletionRepo: objectDeletionRepo,
+	}
+}
+
+func (cat *RegisterCat) RegisterCatUseCase(ctx context.Context) error {
+	// check userName in token exists
+	user, err := cat.userRepo.GetUserWhereName(ctx, cat.tokenUserName)
+	if err != nil {
+		if err == repository.ErrNotExistsData {
+			return ErrTokenInvalidNotExistingUserName
+		}
+		return err
+	}
+
+	birthday, err := parseCatBirthday(cat.reqRegisterCat.Birthday)
+	if err != nil {
+		return err
+	}
+
+	// put the icon to s3 before the transaction so that the transaction isn't kept open during the upload
+	icon, key := iconUnset, ""
+	if cat.reqRegisterCat.Icon != "" {
+		icon, key, err = uploadIcon(catIconPrefix(user.ID), cat.reqRegisterCat.Icon)
+		if err != nil {
+			return err
+		}
+	}
+
+	// INSERT
+	err = cat.tx.Do(ctx, func(ctx context.Context) error {
+		c := model.Cat{
+			UserID:   user.ID,
+			Name:     cat.reqRegisterCat.Name,
+			Breed:    cat.reqRegisterCat.Breed,
+			Birthday: birthday,
+			Icon:     icon,
+			Bio:      cat.reqRegisterCat.Bio,
+		}
+		return cat.catRepo.Create(ctx, &c)
+	})
+	if err != nil {
+		if key != "" {
+			discardCatIcon(ctx, cat.tx, cat.catRepo, cat.objectDeletionRepo, icon, key)
+		}
+		return err
+	}
+	return nil
+}
+
+// discardCatIcon deletes the icon uploaded for a cat which failed to be saved unless another cat uses the same one.
+// A failure is only logged because the error of saving the cat is the one returned.
+func discardCatIcon(ctx context.Context, tx mysql.DBTransaction, catRepo *repository.CatRepository, objectDeletionRepo *repository.ObjectDeletionRepository, icon, key string) {
+	exists, err := catRepo.ExistsIconInOtherCats(ctx, icon, 0)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	if exists {
+		return
+	}
+	deletions, err := enqueueObjectDeletions(ctx, objectDeletionRepo, bucketIcons, []string{key})
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	deleteObjects(ctx, tx, objectDeletionRepo, deletions)
+}
+
+// catIconPrefix keeps cat icons apart from user icons in the icons bucket.
+// It is per user rather than per cat because the icon is uploaded before the cat has its id.
+func catIconPrefix(userID int64) string {
+	return "cats/users/" + strconv.FormatInt(userID, 10)
+}
+
+// parseCatBirthday returns nil for an empty birthday, which means unknown.
+func parseCatBirthday(s string) (*time.Time, error) {
+	if s == "" {
+		return nil, nil
+	}
+	t, err := time.ParseInLocation(modelHTTP.CatBirthdayLayout, s, time.Local)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
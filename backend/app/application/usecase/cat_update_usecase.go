@@ -0,0 +1,117 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+	modelHTTP "github.com/gold-kou/ToeBeans/backend/app/domain/model/http"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+)
+
+var ErrNotCatOwner = errors.New("you can edit only your cat")
+
+type UpdateCatUseCaseInterface interface {
+	UpdateCatUseCase() error
+}
+
+type UpdateCat struct {
+	tx                 mysql.DBTransaction
+	catID              int64
+	tokenUserName      string
+	reqUpdateCat       *modelHTTP.RequestUpdateCat
+	userRepo           *repository.UserRepository
+	catRepo            *repository.CatRepository
+	objectDeletionRepo *repository.ObjectDeletionRepository
+}
+
+func NewUpdateCat(tx mysql.DBTransaction, catID int64, tokenUserName string, reqUpdateCat *modelHTTP.RequestUpdateCat, userRepo *repository.UserRepository, catRepo *repository.CatRepository, objectDeletionRepo *repository.ObjectDeletionRepository) *UpdateCat {
+	return &UpdateCat{
+		tx:                 tx,
+		catID:              catID,
+		tokenUserName:      tokenUserName,
+		reqUpdateCat:       reqUpdateCat,
+		userRepo:           userRepo,
+		catRepo:            catRepo,
+		objectDeletionRepo: objectDeletionRepo,
+	}
+}
+
+func (cat *UpdateCat) UpdateCatUseCase(ctx context.Context) error {
+	// check userName in token exists
+	user, err := cat.userRepo.GetUserWhereName(ctx, cat.tokenUserName)
+	if err != nil {
+		if err == repository.ErrNotExistsData {
+			return ErrTokenInvalidNotExistingUserName
+		}
+		return err
+	}
+
+	c, err := cat.catRepo.GetWhereID(ctx, cat.catID)
+	if err != nil {
+		if err == repository.ErrNotExistsData {
+			return ErrNotExistsData
+		}
+		return err
+	}
+	if c.UserID != user.ID {
+		return ErrNotCatOwner
+	}
+
+	birthday, err := parseCatBirthday(cat.reqUpdateCat.Birthday)
+	if err != nil {
+		return err
+	}
+
+	var location, key string
+	if cat.reqUpdateCat.Icon != "" {
+		location, key, err = uploadIcon(catIconPrefix(user.ID), cat.reqUpdateCat.Icon)
+		if err != nil {
+			return err
+		}
+	}
+
+	// the old icon is no longer referred to once the new one is committed
+	var deletions []model.ObjectDeletion
+	err = cat.tx.Do(ctx, func(ctx context.Context) error {
+		oldIcon := c.Icon
+		c.Name = cat.reqUpdateCat.Name
+		c.Breed = cat.reqUpdateCat.Breed
+		c.Birthday = birthday
+		c.Bio = cat.reqUpdateCat.Bio
+		if err := cat.catRepo.Update(ctx, &c); err != nil {
+			return err
+		}
+		if location == "" {
+			return nil
+		}
+		if err := cat.catRepo.UpdateIconWhereID(ctx, location, c.ID); err != nil {
+			return err
+		}
+		if oldIcon == iconUnset {
+			return nil
+		}
+		oldKey := objectKeyFromURL(oldIcon, bucketIcons)
+		if oldKey == key {
+			return nil
+		}
+		shared, err := cat.catRepo.ExistsIconInOtherCats(ctx, oldIcon, c.ID)
+		if err != nil {
+			return err
+		}
+		if shared {
+			return nil
+		}
+		deletions, err = enqueueObjectDeletions(ctx, cat.objectDeletionRepo, bucketIcons, []string{oldKey})
+		return err
+	})
+	if err != nil {
+		if key != "" {
+			discardCatIcon(ctx, cat.tx, cat.catRepo, cat.objectDeletionRepo, location, key)
+		}
+		return err
+	}
+	deleteObjects(ctx, cat.tx, cat.objectDeletionRepo, deletions)
+	return nil
+}
@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+)
+
+type DeleteCollectionUseCaseInterface interface {
+	DeleteCollectionUseCase() error
+}
+
+type DeleteCollection struct {
+	tx                    mysql.DBTransaction
+	collectionID          int64
+	tokenUserName         string
+	userRepo              *repository.UserRepository
+	collectionRepo        *repository.CollectionRepository
+	collectionPostingRepo *repository.CollectionPostingRepository
+}
+
+func NewDeleteCollection(tx mysql.DBTransaction, collectionID int64, tokenUserName string, userRepo *repository.UserRepository, collectionRepo *repository.CollectionRepository, collectionPostingRepo *repository.CollectionPostingRepository) *DeleteCollection {
+	return &DeleteCollection{
+		tx:                    tx,
+		collectionID:          collectionID,
+		tokenUserName:         tokenUserName,
+		userRepo:              userRepo,
+		collectionRepo:        collectionRepo,
+		collectionPostingRepo: collectionPostingRepo,
+	}
+}
+
+// DeleteCollectionUseCase deletes the collection. The postings in it are left as they are.
+func (collection *DeleteCollection) DeleteCollectionUseCase(ctx context.Context) error {
+	c, err := getOwnCollection(ctx, collection.userRepo, collection.collectionRepo, collection.tokenUserName, collection.collectionID)
+	if err != nil {
+		return err
+	}
+
+	err = collection.tx.Do(ctx, func(ctx context.Context) error {
+		if err := collection.collectionPostingRepo.DeleteWhereCollectionID(ctx, c.ID); err != nil {
+			return err
+		}
+		return collection.collectionRepo.DeleteWhereID(ctx, c.ID)
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
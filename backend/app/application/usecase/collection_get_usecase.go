@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+)
+
+type GetCollectionUseCaseInterface interface {
+	GetCollectionUseCase() (model.Collection, error)
+}
+
+type GetCollection struct {
+	tx                 mysql.DBTransaction
+	tokenUserName      string
+	collectionID       int64
+	userRepo           *repository.UserRepository
+	postingRepo        *repository.PostingRepository
+	likeRepo           *repository.LikeRepository
+	postingCounterRepo *repository.PostingCounterRepository
+	postingImageRepo   *repository.PostingImageRepository
+	collectionRepo     *repository.CollectionRepository
+}
+
+func NewGetCollection(tx mysql.DBTransaction, tokenUserName string, collectionID int64, userRepo *repository.UserRepository, postingRepo *repository.PostingRepository, likeRepo *repository.LikeRepository, postingCounterRepo *repository.PostingCounterRepository, postingImageRepo *repository.PostingImageRepository, collectionRepo *repository.CollectionRepository) *GetCollection {
+	return &GetCollection{
+		tx:                 tx,
+		tokenUserName:      tokenUserName,
+		collectionID:       collectionID,
+		userRepo:           userRepo,
+		postingRepo:        postingRepo,
+		likeRepo:           likeRepo,
+		postingCounterRepo: postingCounterRepo,
+		postingImageRepo:   postingImageRepo,
+		collectionRepo:     collectionRepo,
+	}
+}
+
+// GetCollectionUseCase returns the collection with its postings in the order the owner arranged.
+// A private collection doesn't exist for anyone but the owner.
+func (c *GetCollection) GetCollectionUseCase(ctx context.Context) (collection model.Collection, ownerName string, postings []model.Posting, userNames []string, likedCounts []int64, likes []model.Like, err error) {
+	// check userName in token exists
+	tokenUser, err := c.userRepo.GetUserWhereName(ctx, c.tokenUserName)
+	if err != nil {
+		if err == repository.ErrNotExistsData {
+			err = ErrTokenInvalidNotExistingUserName
+			return
+		}
+		return
+	}
+
+	collection, err = c.collectionRepo.GetWhereID(ctx, c.collectionID)
+	if err != nil {
+		if err == repository.ErrNotExistsData {
+			err = ErrNotExistsData
+		}
+		return
+	}
+	if !collection.IsPublic && collection.UserID != tokenUser.ID {
+		err = ErrNotExistsData
+		return
+	}
+
+	owner, err := c.userRepo.GetUserWhereID(ctx, collection.UserID)
+	if err != nil {
+		return
+	}
+	ownerName = owner.Name
+
+	likes, err = c.likeRepo.GetWhereUserID(ctx, tokenUser.ID)
+	if err != nil {
+		if err == repository.ErrNotExistsData {
+			// not error
+			err = nil
+		}
+		return
+	}
+
+	postings, err = c.postingRepo.GetPostingsWhereCollectionID(ctx, collection.ID)
+	if err != nil {
+		return
+	}
+
+	userNames, likedCounts, err = fillPostings(ctx, c.userRepo, c.postingCounterRepo, c.postingImageRepo, postings)
+	return
+}
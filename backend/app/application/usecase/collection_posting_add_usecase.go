@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+	modelHTTP "github.com/gold-kou/ToeBeans/backend/app/domain/model/http"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+	"github.com/gold-kou/ToeBeans/backend/app/lib"
+)
+
+var ErrPostingNotExists = errors.New("the posting doesn't exist")
+var ErrAlreadyInCollection = errors.New("the posting is already in the collection")
+var ErrCollectionFull = errors.New("the collection can't hold any more postings")
+
+type AddCollectionPostingUseCaseInterface interface {
+	AddCollectionPostingUseCase() error
+}
+
+type AddCollectionPosting struct {
+	tx                    mysql.DBTransaction
+	collectionID          int64
+	tokenUserName         string
+	postingID             int64
+	userRepo              *repository.UserRepository
+	postingRepo           *repository.PostingRepository
+	collectionRepo        *repository.CollectionRepository
+	collectionPostingRepo *repository.CollectionPostingRepository
+}
+
+func NewAddCollectionPosting(tx mysql.DBTransaction, collectionID int64, tokenUserName string, postingID int64, userRepo *repository.UserRepository, postingRepo *repository.PostingRepository, collectionRepo *repository.CollectionRepository, collectionPostingRepo *repository.CollectionPostingRepository) *AddCollectionPosting {
+	return &AddCollectionPosting{
+		tx:                    tx,
+		collectionID:          collectionID,
+		tokenUserName:         tokenUserName,
+		postingID:             postingID,
+		userRepo:              userRepo,
+		postingRepo:           postingRepo,
+		collectionRepo:        collectionRepo,
+		collectionPostingRepo: collectionPostingRepo,
+	}
+}
+
+// AddCollectionPostingUseCase puts the posting, which may be of any user, at the end of the collection.
+func (collection *AddCollectionPosting) AddCollectionPostingUseCase(ctx context.Context) error {
+	c, err := getOwnCollection(ctx, collection.userRepo, collection.collectionRepo, collection.tokenUserName, collection.collectionID)
+	if err != nil {
+		return err
+	}
+
+	p, err := collection.postingRepo.GetWhereID(ctx, collection.postingID)
+	if err != nil {
+		if err == repository.ErrNotExistsData {
+			return ErrPostingNotExists
+		}
+		return err
+	}
+	// a scheduled posting can't be added even by its owner, as collections show only published ones
+	if p.IsScheduled(lib.NowFunc()) {
+		return ErrPostingNotExists
+	}
+
+	err = collection.tx.Do(ctx, func(ctx context.Context) error {
+		items, err := collection.collectionPostingRepo.GetWhereCollectionID(ctx, c.ID)
+		if err != nil {
+			return err
+		}
+		if len(items) >= modelHTTP.MaxCollectionPostings {
+			return ErrCollectionFull
+		}
+		var position int
+		if len(items) > 0 {
+			position = items[len(items)-1].Position + 1
+		}
+		cp := model.CollectionPosting{
+			CollectionID: c.ID,
+			PostingID:    p.ID,
+			Position:     position,
+		}
+		if err := collection.collectionPostingRepo.Create(ctx, &cp); err != nil {
+			if err == repository.ErrDuplicateData {
+				return ErrAlreadyInCollection
+			}
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
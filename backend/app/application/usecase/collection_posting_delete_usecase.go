@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+)
+
+type DeleteCollectionPostingUseCaseInterface interface {
+	DeleteCollectionPostingUseCase() error
+}
+
+type DeleteCollectionPosting struct {
+	tx                    mysql.DBTransaction
+	collectionID          int64
+	tokenUserName         string
+	postingID             int64
+	userRepo              *repository.UserRepository
+	collectionRepo        *repository.CollectionRepository
+	collectionPostingRepo *repository.CollectionPostingRepository
+}
+
+func NewDeleteCollectionPosting(tx mysql.DBTransaction, collectionID int64, tokenUserName string, postingID int64, userRepo *repository.UserRepository, collectionRepo *repository.CollectionRepository, collectionPostingRepo *repository.CollectionPostingRepository) *DeleteCollectionPosting {
+	return &DeleteCollectionPosting{
+		tx:                    tx,
+		collectionID:          collectionID,
+		tokenUserName:         tokenUserName,
+		postingID:             postingID,
+		userRepo:              userRepo,
+		collectionRepo:        collectionRepo,
+		collectionPostingRepo: collectionPostingRepo,
+	}
+}
+
+// DeleteCollectionPostingUseCase removes the posting from the collection. The order of the rest doesn't change.
+func (collection *DeleteCollectionPosting) DeleteCollectionPostingUseCase(ctx context.Context) error {
+	c, err := getOwnCollection(ctx, collection.userRepo, collection.collectionRepo, collection.tokenUserName, collection.collectionID)
+	if err != nil {
+		return err
+	}
+
+	items, err := collection.collectionPostingRepo.GetWhereCollectionID(ctx, c.ID)
+	if err != nil {
+		return err
+	}
+	var found bool
+	for _, item := range items {
+		if item.PostingID == collection.postingID {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return ErrNotExistsData
+	}
+
+	err = collection.tx.Do(ctx, func(ctx context.Context) error {
+		return collection.collectionPostingRepo.DeleteWhereCollectionIDPostingID(ctx, c.ID, collection.postingID)
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+)
+
+var ErrInvalidCollectionOrder = errors.New("posting_ids must list every posting in the collection exactly once")
+
+type ReorderCollectionPostingsUseCaseInterface interface {
+	ReorderCollectionPostingsUseCase() error
+}
+
+type ReorderCollectionPostings struct {
+	tx                    mysql.DBTransaction
+	collectionID          int64
+	tokenUserName         string
+	postingIDs            []int64
+	userRepo              *repository.UserRepository
+	collectionRepo        *repository.CollectionRepository
+	collectionPostingRepo *repository.CollectionPostingRepository
+}
+
+func NewReorderCollectionPostings(tx mysql.DBTransaction, collectionID int64, tokenUserName string, postingIDs []int64, userRepo *repository.UserRepository, collectionRepo *repository.CollectionRepository, collectionPostingRepo *repository.CollectionPostingRepository) *ReorderCollectionPostings {
+	return &ReorderCollectionPostings{
+		tx:                    tx,
+		collectionID:          collectionID,
+		tokenUserName:         tokenUserName,
+		postingIDs:            postingIDs,
+		userRepo:              userRepo,
+		collectionRepo:        collectionRepo,
+		collectionPostingRepo: collectionPostingRepo,
+	}
+}
+
+// ReorderCollectionPostingsUseCase arranges the postings in the collection in the order of postingIDs.
+func (collection *ReorderCollectionPostings) ReorderCollectionPostingsUseCase(ctx context.Context) error {
+	c, err := getOwnCollection(ctx, collection.userRepo, collection.collectionRepo, collection.tokenUserName, collection.collectionID)
+	if err != nil {
+		return err
+	}
+
+	err = collection.tx.Do(ctx, func(ctx context.Context) error {
+		items, err := collection.collectionPostingRepo.GetWhereCollectionID(ctx, c.ID)
+		if err != nil {
+			return err
+		}
+		if len(items) != len(collection.postingIDs) {
+			return ErrInvalidCollectionOrder
+		}
+		itemIDs := make(map[int64]int64, len(items))
+		for _, item := range items {
+			itemIDs[item.PostingID] = item.ID
+		}
+		for position, postingID := range collection.postingIDs {
+			id, ok := itemIDs[postingID]
+			if !ok {
+				// not in the collection or listed twice
+				return ErrInvalidCollectionOrder
+			}
+			delete(itemIDs, postingID)
+			if err := collection.collectionPostingRepo.UpdatePositionWhereID(ctx, position, id); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+	modelHTTP "github.com/gold-kou/ToeBeans/backend/app/domain/model/http"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+)
+
+type RegisterCollectionUseCaseInterface interface {
+	RegisterCollectionUseCase() error
+}
+
+type RegisterCollection struct {
+	tx                    mysql.DBTransaction
+	tokenUserName         string
+	reqRegisterCollection *modelHTTP.RequestRegisterCollection
+	userRepo              *repository.UserRepository
+	collectionRepo        *repository.CollectionRepository
+}
+
+func NewRegisterCollection(tx mysql.DBTransaction, tokenUserName string, reqRegisterCollection *modelHTTP.RequestRegisterCollection, userRepo *repository.UserRepository, collectionRepo *repository.CollectionRepository) *RegisterCollection {
+	return &RegisterCollection{
+		tx:                    tx,
+		tokenUserName:         tokenUserName,
+		reqRegisterCollection: reqRegisterCollection,
+		userRepo:              userRepo,
+		collectionRepo:        collectionRepo,
+	}
+}
+
+func (collection *RegisterCollection) RegisterCollectionUseCase(ctx context.Context) error {
+	// check userName in token exists
+	user, err := collection.userRepo.GetUserWhereName(ctx, collection.tokenUserName)
+	if err != nil {
+		if err == repository.ErrNotExistsData {
+			return ErrTokenInvalidNotExistingUserName
+		}
+		return err
+	}
+
+	err = collection.tx.Do(ctx, func(ctx context.Context) error {
+		c := model.Collection{
+			UserID:   user.ID,
+			Name:     collection.reqRegisterCollection.Name,
+			IsPublic: collection.reqRegisterCollection.IsPublic,
+		}
+		return collection.collectionRepo.Create(ctx, &c)
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
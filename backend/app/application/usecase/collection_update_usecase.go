@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+	modelHTTP "github.com/gold-kou/ToeBeans/backend/app/domain/model/http"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+)
+
+var ErrNotCollectionOwner = errors.New("you can edit only your collection")
+
+type UpdateCollectionUseCaseInterface interface {
+	UpdateCollectionUseCase() error
+}
+
+type UpdateCollection struct {
+	tx                  mysql.DBTransaction
+	collectionID        int64
+	tokenUserName       string
+	reqUpdateCollection *modelHTTP.RequestUpdateCollection
+	userRepo            *repository.UserRepository
+	collectionRepo      *repository.CollectionRepository
+}
+
+func NewUpdateCollection(tx mysql.DBTransaction, collectionID int64, tokenUserName string, reqUpdateCollection *modelHTTP.RequestUpdateCollection, userRepo *repository.UserRepository, collectionRepo *repository.CollectionRepository) *UpdateCollection {
+	return &UpdateCollection{
+		tx:                  tx,
+		collectionID:        collectionID,
+		tokenUserName:       tokenUserName,
+		reqUpdateCollection: reqUpdateCollection,
+		userRepo:            userRepo,
+		collectionRepo:      collectionRepo,
+	}
+}
+
+func (collection *UpdateCollection) UpdateCollectionUseCase(ctx context.Context) error {
+	c, err := getOwnCollection(ctx, collection.userRepo, collection.collectionRepo, collection.tokenUserName, collection.collectionID)
+	if err != nil {
+		return err
+	}
+
+	err = collection.tx.Do(ctx, func(ctx context.Context) error {
+		c.Name = collection.reqUpdateCollection.Name
+		c.IsPublic = collection.reqUpdateCollection.IsPublic
+		return collection.collectionRepo.Update(ctx, &c)
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// getOwnCollection returns the collection which the token user is going to edit.
+// Others' private collections are treated as not existing, as they are for reading.
+func getOwnCollection(ctx context.Context, userRepo *repository.UserRepository, collectionRepo *repository.CollectionRepository, tokenUserName string, collectionID int64) (collection model.Collection, err error) {
+	// check userName in token exists
+	user, err := userRepo.GetUserWhereName(ctx, tokenUserName)
+	if err != nil {
+		if err == repository.ErrNotExistsData {
+			err = ErrTokenInvalidNotExistingUserName
+		}
+		return
+	}
+
+	collection, err = collectionRepo.GetWhereID(ctx, collectionID)
+	if err != nil {
+		if err == repository.ErrNotExistsData {
+			err = ErrNotExistsData
+		}
+		return
+	}
+	if collection.UserID != user.ID {
+		if !collection.IsPublic {
+			err = ErrNotExistsData
+			return
+		}
+		err = ErrNotCollectionOwner
+		return
+	}
+	return
+}
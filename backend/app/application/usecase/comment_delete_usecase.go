@@ -13,20 +13,22 @@ type DeleteCommentUseCaseInterface interface {
 }
 
 type DeleteComment struct {
-	tx            mysql.DBTransaction
-	tokenUserName string
-	commentID     int64
-	userRepo      *repository.UserRepository
-	commentRepo   *repository.CommentRepository
+	tx                 mysql.DBTransaction
+	tokenUserName      string
+	commentID          int64
+	userRepo           *repository.UserRepository
+	commentRepo        *repository.CommentRepository
+	postingCounterRepo *repository.PostingCounterRepository
 }
 
-func NewDeleteComment(tx mysql.DBTransaction, tokenUserName string, commentID int64, userRepo *repository.UserRepository, commentRepo *repository.CommentRepository) *DeleteComment {
+func NewDeleteComment(tx mysql.DBTransaction, tokenUserName string, commentID int64, userRepo *repository.UserRepository, commentRepo *repository.CommentRepository, postingCounterRepo *repository.PostingCounterRepository) *DeleteComment {
 	return &DeleteComment{
-		tx:            tx,
-		tokenUserName: tokenUserName,
-		commentID:     commentID,
-		userRepo:      userRepo,
-		commentRepo:   commentRepo,
+		tx:                 tx,
+		tokenUserName:      tokenUserName,
+		commentID:          commentID,
+		userRepo:           userRepo,
+		commentRepo:        commentRepo,
+		postingCounterRepo: postingCounterRepo,
 	}
 }
 
@@ -40,11 +42,25 @@ func (comment *DeleteComment) DeleteCommentUseCase(ctx context.Context) error {
 		return err
 	}
 
-	if err := comment.commentRepo.DeleteWhereID(ctx, comment.commentID); err != nil {
+	c, err := comment.commentRepo.GetWhereID(ctx, comment.commentID)
+	if err != nil {
 		if err == repository.ErrNotExistsData {
 			return ErrNotExistsData
 		}
 		return err
 	}
+
+	err = comment.tx.Do(ctx, func(ctx context.Context) error {
+		if err := comment.commentRepo.DeleteWhereID(ctx, c.ID); err != nil {
+			if err == repository.ErrNotExistsData {
+				return ErrNotExistsData
+			}
+			return err
+		}
+		return comment.postingCounterRepo.Add(ctx, &model.PostingCounter{PostingID: c.PostingID, CommentCount: -1})
+	})
+	if err != nil {
+		return err
+	}
 	return nil
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
 	modelHTTP "github.com/gold-kou/ToeBeans/backend/app/domain/model/http"
 	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+	"github.com/gold-kou/ToeBeans/backend/app/lib"
 )
 
 type RegisterCommentUseCaseInterface interface {
@@ -22,10 +23,11 @@ type RegisterComment struct {
 	userRepo           *repository.UserRepository
 	postingRepo        *repository.PostingRepository
 	commentRepo        *repository.CommentRepository
+	postingCounterRepo *repository.PostingCounterRepository
 	notificationRepo   *repository.NotificationRepository
 }
 
-func NewRegisterComment(tx mysql.DBTransaction, tokenUserID int64, tokenUserName string, postingID int, reqRegisterComment *modelHTTP.RequestRegisterComment, userRepo *repository.UserRepository, postingRepo *repository.PostingRepository, commentRepo *repository.CommentRepository, notificationRepo *repository.NotificationRepository) *RegisterComment {
+func NewRegisterComment(tx mysql.DBTransaction, tokenUserID int64, tokenUserName string, postingID int, reqRegisterComment *modelHTTP.RequestRegisterComment, userRepo *repository.UserRepository, postingRepo *repository.PostingRepository, commentRepo *repository.CommentRepository, postingCounterRepo *repository.PostingCounterRepository, notificationRepo *repository.NotificationRepository) *RegisterComment {
 	return &RegisterComment{
 		tx:                 tx,
 		tokenUserID:        tokenUserID,
@@ -35,6 +37,7 @@ func NewRegisterComment(tx mysql.DBTransaction, tokenUserID int64, tokenUserName
 		userRepo:           userRepo,
 		postingRepo:        postingRepo,
 		commentRepo:        commentRepo,
+		postingCounterRepo: postingCounterRepo,
 		notificationRepo:   notificationRepo,
 	}
 }
@@ -49,13 +52,17 @@ func (comment *RegisterComment) RegisterCommentUseCase(ctx context.Context) erro
 		return err
 	}
 
-	_, err = comment.postingRepo.GetWhereID(ctx, int64(comment.postingID))
+	p, err := comment.postingRepo.GetWhereID(ctx, int64(comment.postingID))
 	if err != nil {
 		if err == repository.ErrNotExistsData {
 			return ErrNotExistsData
 		}
 		return err
 	}
+	// others can't see a scheduled posting yet
+	if p.IsScheduled(lib.NowFunc()) && p.UserID != comment.tokenUserID {
+		return ErrNotExistsData
+	}
 	err = comment.tx.Do(ctx, func(ctx context.Context) error {
 		c := model.Comment{
 			UserID:    comment.tokenUserID,
@@ -69,6 +76,9 @@ func (comment *RegisterComment) RegisterCommentUseCase(ctx context.Context) erro
 			}
 			return err
 		}
+		if err := comment.postingCounterRepo.Add(ctx, &model.PostingCounter{PostingID: p.ID, CommentCount: 1}); err != nil {
+			return err
+		}
 
 		// TODO notification
 		// if comment.userName != p.tokenUserName {
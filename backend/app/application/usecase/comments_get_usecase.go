@@ -16,16 +16,20 @@ type GetComments struct {
 	tx            mysql.DBTransaction
 	tokenUserName string
 	postingID     int64
+	cursor        model.Cursor
+	limit         int8
 	userRepo      *repository.UserRepository
 	postingRepo   *repository.PostingRepository
 	commentRepo   *repository.CommentRepository
 }
 
-func NewGetComments(tx mysql.DBTransaction, tokenUserName string, postingID int64, userRepo *repository.UserRepository, postingRepo *repository.PostingRepository, commentRepo *repository.CommentRepository) *GetComments {
+func NewGetComments(tx mysql.DBTransaction, tokenUserName string, postingID int64, cursor model.Cursor, limit int8, userRepo *repository.UserRepository, postingRepo *repository.PostingRepository, commentRepo *repository.CommentRepository) *GetComments {
 	return &GetComments{
 		tx:            tx,
 		tokenUserName: tokenUserName,
 		postingID:     postingID,
+		cursor:        cursor,
+		limit:         limit,
 		userRepo:      userRepo,
 		postingRepo:   postingRepo,
 		commentRepo:   commentRepo,
@@ -53,7 +57,7 @@ func (c *GetComments) GetCommentsUseCase(ctx context.Context) (comments []model.
 		return
 	}
 
-	comments, err = c.commentRepo.GetCommentsWherePostingID(ctx, c.postingID)
+	comments, err = c.commentRepo.GetCommentsWherePostingID(ctx, c.cursor, c.limit, c.postingID)
 	if err != nil {
 		if err == repository.ErrNotExistsData {
 			// not error
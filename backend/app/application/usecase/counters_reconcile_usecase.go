@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"context"
+	"log"
+
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+)
+
+const counterReconcileBatchSize = 500
+
+type ReconcileCountersUseCaseInterface interface {
+	ReconcileCountersUseCase() error
+}
+
+type ReconcileCounters struct {
+	postingCounterRepo *repository.PostingCounterRepository
+	userCounterRepo    *repository.UserCounterRepository
+}
+
+func NewReconcileCounters(postingCounterRepo *repository.PostingCounterRepository, userCounterRepo *repository.UserCounterRepository) *ReconcileCounters {
+	return &ReconcileCounters{
+		postingCounterRepo: postingCounterRepo,
+		userCounterRepo:    userCounterRepo,
+	}
+}
+
+// ReconcileCountersUseCase checks the counters of every posting and user batch by batch and recounts the drifted ones.
+// The counters are kept in the same transactions as the likes, the comments, the follows and the postings,
+// so drift means a bug or a write made outside of the usecases and is logged.
+func (r *ReconcileCounters) ReconcileCountersUseCase(ctx context.Context) error {
+	var after int64
+	for {
+		drifted, last, err := r.postingCounterRepo.GetDriftedAfterPostingID(ctx, after, counterReconcileBatchSize)
+		if err != nil {
+			return err
+		}
+		if len(drifted) > 0 {
+			log.Printf("recount the drifted counters of the postings %v", drifted)
+			if err = r.postingCounterRepo.Recount(ctx, drifted); err != nil {
+				return err
+			}
+		}
+		if last == 0 {
+			break
+		}
+		after = last
+	}
+
+	after = 0
+	for {
+		drifted, last, err := r.userCounterRepo.GetDriftedAfterUserID(ctx, after, counterReconcileBatchSize)
+		if err != nil {
+			return err
+		}
+		if len(drifted) > 0 {
+			log.Printf("recount the drifted counters of the users %v", drifted)
+			if err = r.userCounterRepo.Recount(ctx, drifted); err != nil {
+				return err
+			}
+		}
+		if last == 0 {
+			return nil
+		}
+		after = last
+	}
+}
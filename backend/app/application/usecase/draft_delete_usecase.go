@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+)
+
+type DeleteDraftUseCaseInterface interface {
+	DeleteDraftUseCase() error
+}
+
+type DeleteDraft struct {
+	tx                 mysql.DBTransaction
+	draftID            int64
+	tokenUserName      string
+	userRepo           *repository.UserRepository
+	draftRepo          *repository.DraftRepository
+	objectDeletionRepo *repository.ObjectDeletionRepository
+}
+
+func NewDeleteDraft(tx mysql.DBTransaction, draftID int64, tokenUserName string, userRepo *repository.UserRepository, draftRepo *repository.DraftRepository, objectDeletionRepo *repository.ObjectDeletionRepository) *DeleteDraft {
+	return &DeleteDraft{
+		tx:                 tx,
+		draftID:            draftID,
+		tokenUserName:      tokenUserName,
+		userRepo:           userRepo,
+		draftRepo:          draftRepo,
+		objectDeletionRepo: objectDeletionRepo,
+	}
+}
+
+// DeleteDraftUseCase discards the draft with its image.
+func (draft *DeleteDraft) DeleteDraftUseCase(ctx context.Context) error {
+	d, err := getOwnDraft(ctx, draft.userRepo, draft.draftRepo, draft.tokenUserName, draft.draftID)
+	if err != nil {
+		return err
+	}
+
+	var deletions []model.ObjectDeletion
+	err = draft.tx.Do(ctx, func(ctx context.Context) error {
+		if err := draft.draftRepo.DeleteWhereID(ctx, d.ID); err != nil {
+			return err
+		}
+		deletions, err = enqueueObjectDeletions(ctx, draft.objectDeletionRepo, bucketPosting, []string{d.ObjectKey})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	deleteObjects(ctx, draft.tx, draft.objectDeletionRepo, deletions)
+	return nil
+}
@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/classifier"
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	modelHTTP "github.com/gold-kou/ToeBeans/backend/app/domain/model/http"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+)
+
+type PublishDraftUseCaseInterface interface {
+	PublishDraftUseCase() error
+}
+
+type PublishDraft struct {
+	tx                 mysql.DBTransaction
+	draftID            int64
+	tokenUserName      string
+	imageClassifier    classifier.ImageClassifier
+	userRepo           *repository.UserRepository
+	postingRepo        *repository.PostingRepository
+	postingImageRepo   *repository.PostingImageRepository
+	tagRepo            *repository.TagRepository
+	postingTagRepo     *repository.PostingTagRepository
+	catRepo            *repository.CatRepository
+	postingCatRepo     *repository.PostingCatRepository
+	draftRepo          *repository.DraftRepository
+	userCounterRepo    *repository.UserCounterRepository
+	objectDeletionRepo *repository.ObjectDeletionRepository
+}
+
+func NewPublishDraft(tx mysql.DBTransaction, draftID int64, tokenUserName string, imageClassifier classifier.ImageClassifier, userRepo *repository.UserRepository, postingRepo *repository.PostingRepository, postingImageRepo *repository.PostingImageRepository, tagRepo *repository.TagRepository, postingTagRepo *repository.PostingTagRepository, catRepo *repository.CatRepository, postingCatRepo *repository.PostingCatRepository, draftRepo *repository.DraftRepository, userCounterRepo *repository.UserCounterRepository, objectDeletionRepo *repository.ObjectDeletionRepository) *PublishDraft {
+	return &PublishDraft{
+		tx:                 tx,
+		draftID:            draftID,
+		tokenUserName:      tokenUserName,
+		imageClassifier:    imageClassifier,
+		userRepo:           userRepo,
+		postingRepo:        postingRepo,
+		postingImageRepo:   postingImageRepo,
+		tagRepo:            tagRepo,
+		postingTagRepo:     postingTagRepo,
+		catRepo:            catRepo,
+		postingCatRepo:     postingCatRepo,
+		draftRepo:          draftRepo,
+		userCounterRepo:    userCounterRepo,
+		objectDeletionRepo: objectDeletionRepo,
+	}
+}
+
+// PublishDraftUseCase posts the draft in the same way as POST /postings with upload_keys, so the image goes through the same checks.
+// The draft is deleted in the transaction creating the posting.
+// The title is checked here because a draft may be saved without it, and the validation.Errors is returned as it is.
+func (draft *PublishDraft) PublishDraftUseCase(ctx context.Context) error {
+	d, err := getOwnDraft(ctx, draft.userRepo, draft.draftRepo, draft.tokenUserName, draft.draftID)
+	if err != nil {
+		return err
+	}
+
+	req := &modelHTTP.RequestRegisterPosting{
+		Title:      d.Title,
+		AltText:    d.AltText,
+		UploadKeys: []string{d.ObjectKey},
+	}
+	if err = req.ValidateParam(); err != nil {
+		return err
+	}
+
+	posting := NewRegisterPosting(draft.tx, d.UserID, draft.tokenUserName, req, nil, draft.imageClassifier, draft.userRepo, draft.postingRepo, draft.postingImageRepo, draft.tagRepo, draft.postingTagRepo, draft.catRepo, draft.postingCatRepo, draft.draftRepo, draft.userCounterRepo, draft.objectDeletionRepo)
+	return posting.RegisterPostingUseCase(ctx)
+}
@@ -0,0 +1,99 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/aws"
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/http/helper"
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+	modelHTTP "github.com/gold-kou/ToeBeans/backend/app/domain/model/http"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+)
+
+// drafts are listed at once, so a user can keep only a limited number of them
+const maxDrafts = 50
+
+var ErrTooManyDrafts = errors.New("you can't keep any more drafts")
+var ErrDraftAlreadyExists = errors.New("the upload is already in a draft")
+
+type RegisterDraftUseCaseInterface interface {
+	RegisterDraftUseCase() (model.Draft, error)
+}
+
+type RegisterDraft struct {
+	tx               mysql.DBTransaction
+	tokenUserName    string
+	reqRegisterDraft *modelHTTP.RequestRegisterDraft
+	userRepo         *repository.UserRepository
+	draftRepo        *repository.DraftRepository
+}
+
+func NewRegisterDraft(tx mysql.DBTransaction, tokenUserName string, reqRegisterDraft *modelHTTP.RequestRegisterDraft, userRepo *repository.UserRepository, draftRepo *repository.DraftRepository) *RegisterDraft {
+	return &RegisterDraft{
+		tx:               tx,
+		tokenUserName:    tokenUserName,
+		reqRegisterDraft: reqRegisterDraft,
+		userRepo:         userRepo,
+		draftRepo:        draftRepo,
+	}
+}
+
+// RegisterDraftUseCase keeps the uploaded image with the title as a draft.
+// The image is checked whether it is a cat only when the draft is published.
+func (draft *RegisterDraft) RegisterDraftUseCase(ctx context.Context) (d model.Draft, err error) {
+	// check userName in token exists
+	user, err := draft.userRepo.GetUserWhereName(ctx, draft.tokenUserName)
+	if err != nil {
+		if err == repository.ErrNotExistsData {
+			err = ErrTokenInvalidNotExistingUserName
+		}
+		return
+	}
+
+	// the upload must exist now, or the draft could never be published
+	key := draft.reqRegisterDraft.UploadKey
+	if !isUploadKeyOf(key, user.ID) {
+		err = ErrUploadNotFound
+		return
+	}
+	o, err := aws.HeadObject(bucketPosting, key)
+	if err != nil {
+		if err == aws.ErrObjectNotFound {
+			err = ErrUploadNotFound
+		}
+		return
+	}
+	if o.Size > helper.ImageMaxByte {
+		err = helper.ErrImageTooLarge
+		return
+	}
+
+	err = draft.tx.Do(ctx, func(ctx context.Context) error {
+		count, err := draft.draftRepo.CountWhereUserID(ctx, user.ID)
+		if err != nil {
+			return err
+		}
+		if count >= maxDrafts {
+			return ErrTooManyDrafts
+		}
+		d = model.Draft{
+			UserID:    user.ID,
+			Title:     draft.reqRegisterDraft.Title,
+			AltText:   draft.reqRegisterDraft.AltText,
+			ObjectKey: key,
+		}
+		if err := draft.draftRepo.Create(ctx, &d); err != nil {
+			if err == repository.ErrDuplicateData {
+				return ErrDraftAlreadyExists
+			}
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return
+	}
+	return draft.draftRepo.GetWhereID(ctx, d.ID)
+}
@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+	modelHTTP "github.com/gold-kou/ToeBeans/backend/app/domain/model/http"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+)
+
+type UpdateDraftUseCaseInterface interface {
+	UpdateDraftUseCase() error
+}
+
+type UpdateDraft struct {
+	tx             mysql.DBTransaction
+	draftID        int64
+	tokenUserName  string
+	reqUpdateDraft *modelHTTP.RequestUpdateDraft
+	userRepo       *repository.UserRepository
+	draftRepo      *repository.DraftRepository
+}
+
+func NewUpdateDraft(tx mysql.DBTransaction, draftID int64, tokenUserName string, reqUpdateDraft *modelHTTP.RequestUpdateDraft, userRepo *repository.UserRepository, draftRepo *repository.DraftRepository) *UpdateDraft {
+	return &UpdateDraft{
+		tx:             tx,
+		draftID:        draftID,
+		tokenUserName:  tokenUserName,
+		reqUpdateDraft: reqUpdateDraft,
+		userRepo:       userRepo,
+		draftRepo:      draftRepo,
+	}
+}
+
+func (draft *UpdateDraft) UpdateDraftUseCase(ctx context.Context) error {
+	d, err := getOwnDraft(ctx, draft.userRepo, draft.draftRepo, draft.tokenUserName, draft.draftID)
+	if err != nil {
+		return err
+	}
+
+	err = draft.tx.Do(ctx, func(ctx context.Context) error {
+		d.Title = draft.reqUpdateDraft.Title
+		d.AltText = draft.reqUpdateDraft.AltText
+		return draft.draftRepo.Update(ctx, &d)
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// getOwnDraft returns the draft of the token user.
+// Others' drafts are treated as not existing because nobody but the owner can see them.
+func getOwnDraft(ctx context.Context, userRepo *repository.UserRepository, draftRepo *repository.DraftRepository, tokenUserName string, draftID int64) (draft model.Draft, err error) {
+	// check userName in token exists
+	user, err := userRepo.GetUserWhereName(ctx, tokenUserName)
+	if err != nil {
+		if err == repository.ErrNotExistsData {
+			err = ErrTokenInvalidNotExistingUserName
+		}
+		return
+	}
+
+	draft, err = draftRepo.GetWhereID(ctx, draftID)
+	if err != nil {
+		if err == repository.ErrNotExistsData {
+			err = ErrNotExistsData
+		}
+		return
+	}
+	if draft.UserID != user.ID {
+		err = ErrNotExistsData
+		return
+	}
+	return
+}
@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+	"github.com/gold-kou/ToeBeans/backend/app/lib"
+)
+
+const (
+	draftRetentionDefault = 30 * 24 * time.Hour
+	draftCleanUpBatchSize = 100
+)
+
+var draftRetention time.Duration
+
+func init() {
+	d, e := strconv.Atoi(os.Getenv("DRAFT_RETENTION_DAY"))
+	if e != nil || d <= 0 {
+		draftRetention = draftRetentionDefault
+	} else {
+		draftRetention = time.Duration(d) * 24 * time.Hour
+	}
+}
+
+type CleanUpDraftsUseCaseInterface interface {
+	CleanUpDraftsUseCase() error
+}
+
+type CleanUpDrafts struct {
+	tx                 mysql.DBTransaction
+	draftRepo          *repository.DraftRepository
+	objectDeletionRepo *repository.ObjectDeletionRepository
+}
+
+func NewCleanUpDrafts(tx mysql.DBTransaction, draftRepo *repository.DraftRepository, objectDeletionRepo *repository.ObjectDeletionRepository) *CleanUpDrafts {
+	return &CleanUpDrafts{
+		tx:                 tx,
+		draftRepo:          draftRepo,
+		objectDeletionRepo: objectDeletionRepo,
+	}
+}
+
+// CleanUpDraftsUseCase deletes the drafts not edited for DRAFT_RETENTION_DAY days with their images.
+func (c *CleanUpDrafts) CleanUpDraftsUseCase(ctx context.Context) error {
+	threshold := lib.NowFunc().Add(-draftRetention)
+	for {
+		drafts, err := c.draftRepo.GetUpdatedBefore(ctx, threshold, draftCleanUpBatchSize)
+		if err != nil {
+			return err
+		}
+		if len(drafts) == 0 {
+			return nil
+		}
+
+		var deletions []model.ObjectDeletion
+		err = c.tx.Do(ctx, func(ctx context.Context) error {
+			for _, d := range drafts {
+				if err := c.draftRepo.DeleteWhereID(ctx, d.ID); err != nil {
+					return err
+				}
+				ds, err := enqueueObjectDeletions(ctx, c.objectDeletionRepo, bucketPosting, []string{d.ObjectKey})
+				if err != nil {
+					return err
+				}
+				deletions = append(deletions, ds...)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		deleteObjects(ctx, c.tx, c.objectDeletionRepo, deletions)
+
+		if len(drafts) < draftCleanUpBatchSize {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+)
+
+type GetDraftsUseCaseInterface interface {
+	GetDraftsUseCase() ([]model.Draft, error)
+}
+
+type GetDrafts struct {
+	tokenUserName string
+	userRepo      *repository.UserRepository
+	draftRepo     *repository.DraftRepository
+}
+
+func NewGetDrafts(tokenUserName string, userRepo *repository.UserRepository, draftRepo *repository.DraftRepository) *GetDrafts {
+	return &GetDrafts{
+		tokenUserName: tokenUserName,
+		userRepo:      userRepo,
+		draftRepo:     draftRepo,
+	}
+}
+
+// GetDraftsUseCase returns the drafts of the request user, the last edited first.
+func (d *GetDrafts) GetDraftsUseCase(ctx context.Context) (drafts []model.Draft, err error) {
+	// check userName in token exists
+	user, err := d.userRepo.GetUserWhereName(ctx, d.tokenUserName)
+	if err != nil {
+		if err == repository.ErrNotExistsData {
+			err = ErrTokenInvalidNotExistingUserName
+		}
+		return
+	}
+	return d.draftRepo.GetWhereUserID(ctx, user.ID)
+}
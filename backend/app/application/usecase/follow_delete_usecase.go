@@ -21,15 +21,17 @@ type DeleteFollow struct {
 	followedUserName string
 	userRepo         *repository.UserRepository
 	followRepo       *repository.FollowRepository
+	userCounterRepo  *repository.UserCounterRepository
 }
 
-func NewDeleteFollow(tx mysql.DBTransaction, followUserName, followedUserName string, userRepo *repository.UserRepository, followRepo *repository.FollowRepository) *DeleteFollow {
+func NewDeleteFollow(tx mysql.DBTransaction, followUserName, followedUserName string, userRepo *repository.UserRepository, followRepo *repository.FollowRepository, userCounterRepo *repository.UserCounterRepository) *DeleteFollow {
 	return &DeleteFollow{
 		tx:               tx,
 		followUserName:   followUserName,
 		followedUserName: followedUserName,
 		userRepo:         userRepo,
 		followRepo:       followRepo,
+		userCounterRepo:  userCounterRepo,
 	}
 }
 
@@ -64,6 +66,16 @@ func (follow *DeleteFollow) DeleteFollowUseCase(ctx context.Context) error {
 	err = follow.tx.Do(ctx, func(ctx context.Context) error {
 		err := follow.followRepo.DeleteWhereBothUserIDs(ctx, followingUser.ID, followedUser.ID)
 		if err != nil {
+			// deleted by another request in the meantime
+			if err == repository.ErrNotExistsData {
+				return ErrDeleteNotExistsFollow
+			}
+			return err
+		}
+		if err := follow.userCounterRepo.Add(ctx, &model.UserCounter{UserID: followingUser.ID, FollowCount: -1}); err != nil {
+			return err
+		}
+		if err := follow.userCounterRepo.Add(ctx, &model.UserCounter{UserID: followedUser.ID, FollowedCount: -1}); err != nil {
 			return err
 		}
 		return nil
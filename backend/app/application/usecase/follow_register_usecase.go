@@ -24,10 +24,11 @@ type RegisterFollow struct {
 	followedUserName string
 	userRepo         *repository.UserRepository
 	followRepo       *repository.FollowRepository
+	userCounterRepo  *repository.UserCounterRepository
 	notificationRepo *repository.NotificationRepository
 }
 
-func NewRegisterFollow(tx mysql.DBTransaction, tokenUserID int64, tokenUserName string, followedUserName string, userRepo *repository.UserRepository, followRepo *repository.FollowRepository, notificationRepo *repository.NotificationRepository) *RegisterFollow {
+func NewRegisterFollow(tx mysql.DBTransaction, tokenUserID int64, tokenUserName string, followedUserName string, userRepo *repository.UserRepository, followRepo *repository.FollowRepository, userCounterRepo *repository.UserCounterRepository, notificationRepo *repository.NotificationRepository) *RegisterFollow {
 	return &RegisterFollow{
 		tx:               tx,
 		tokenUserID:      tokenUserID,
@@ -35,6 +36,7 @@ func NewRegisterFollow(tx mysql.DBTransaction, tokenUserID int64, tokenUserName
 		followedUserName: followedUserName,
 		userRepo:         userRepo,
 		followRepo:       followRepo,
+		userCounterRepo:  userCounterRepo,
 		notificationRepo: notificationRepo,
 	}
 }
@@ -69,6 +71,12 @@ func (follow *RegisterFollow) RegisterFollowUseCase(ctx context.Context) error {
 			}
 			return err
 		}
+		if err := follow.userCounterRepo.Add(ctx, &model.UserCounter{UserID: follow.tokenUserID, FollowCount: 1}); err != nil {
+			return err
+		}
+		if err := follow.userCounterRepo.Add(ctx, &model.UserCounter{UserID: followedUser.ID, FollowedCount: 1}); err != nil {
+			return err
+		}
 
 		// TODO notification
 		// if follow.userName != follow.reqRegisterFollow.FollowedUserName {
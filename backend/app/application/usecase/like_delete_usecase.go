@@ -16,22 +16,26 @@ type DeleteLikeUseCaseInterface interface {
 }
 
 type DeleteLike struct {
-	tx            mysql.DBTransaction
-	tokenUserName string
-	postingID     int64
-	userRepo      *repository.UserRepository
-	postingRepo   *repository.PostingRepository
-	likeRepo      *repository.LikeRepository
+	tx                 mysql.DBTransaction
+	tokenUserName      string
+	postingID          int64
+	userRepo           *repository.UserRepository
+	postingRepo        *repository.PostingRepository
+	likeRepo           *repository.LikeRepository
+	postingCounterRepo *repository.PostingCounterRepository
+	userCounterRepo    *repository.UserCounterRepository
 }
 
-func NewDeleteLike(tx mysql.DBTransaction, tokenUserName string, postingID int64, userRepo *repository.UserRepository, postingRepo *repository.PostingRepository, likeRepo *repository.LikeRepository) *DeleteLike {
+func NewDeleteLike(tx mysql.DBTransaction, tokenUserName string, postingID int64, userRepo *repository.UserRepository, postingRepo *repository.PostingRepository, likeRepo *repository.LikeRepository, postingCounterRepo *repository.PostingCounterRepository, userCounterRepo *repository.UserCounterRepository) *DeleteLike {
 	return &DeleteLike{
-		tx:            tx,
-		tokenUserName: tokenUserName,
-		postingID:     postingID,
-		userRepo:      userRepo,
-		postingRepo:   postingRepo,
-		likeRepo:      likeRepo,
+		tx:                 tx,
+		tokenUserName:      tokenUserName,
+		postingID:          postingID,
+		userRepo:           userRepo,
+		postingRepo:        postingRepo,
+		likeRepo:           likeRepo,
+		postingCounterRepo: postingCounterRepo,
+		userCounterRepo:    userCounterRepo,
 	}
 }
 
@@ -53,8 +57,26 @@ func (like *DeleteLike) DeleteLikeUseCase(ctx context.Context) error {
 		return err
 	}
 
+	p, err := like.postingRepo.GetWhereID(ctx, like.postingID)
+	if err != nil {
+		return err
+	}
+
 	err = like.tx.Do(ctx, func(ctx context.Context) error {
 		if err := like.likeRepo.DeleteWhereUserIDPostingID(ctx, user.ID, like.postingID); err != nil {
+			// deleted by another request in the meantime
+			if err == repository.ErrNotExistsData {
+				return ErrDeleteNotExistsLike
+			}
+			return err
+		}
+		if err := like.postingCounterRepo.Add(ctx, &model.PostingCounter{PostingID: p.ID, LikedCount: -1}); err != nil {
+			return err
+		}
+		if err := like.userCounterRepo.Add(ctx, &model.UserCounter{UserID: user.ID, LikeCount: -1}); err != nil {
+			return err
+		}
+		if err := like.userCounterRepo.Add(ctx, &model.UserCounter{UserID: p.UserID, LikedCount: -1}); err != nil {
 			return err
 		}
 		return nil
@@ -7,6 +7,7 @@ import (
 	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
 	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
 	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+	"github.com/gold-kou/ToeBeans/backend/app/lib"
 )
 
 var ErrLikeYourPosting = errors.New("you can't like your posting")
@@ -17,26 +18,30 @@ type RegisterLikeUseCaseInterface interface {
 }
 
 type RegisterLike struct {
-	tx               mysql.DBTransaction
-	tokenUserID      int64
-	tokenUserName    string
-	postingID        int
-	userRepo         *repository.UserRepository
-	postingRepo      *repository.PostingRepository
-	likeRepo         *repository.LikeRepository
-	notificationRepo *repository.NotificationRepository
+	tx                 mysql.DBTransaction
+	tokenUserID        int64
+	tokenUserName      string
+	postingID          int
+	userRepo           *repository.UserRepository
+	postingRepo        *repository.PostingRepository
+	likeRepo           *repository.LikeRepository
+	postingCounterRepo *repository.PostingCounterRepository
+	userCounterRepo    *repository.UserCounterRepository
+	notificationRepo   *repository.NotificationRepository
 }
 
-func NewRegisterLike(tx mysql.DBTransaction, tokenUserID int64, tokenUserName string, postingID int, userRepo *repository.UserRepository, postingRepo *repository.PostingRepository, likeRepo *repository.LikeRepository, notificationRepo *repository.NotificationRepository) *RegisterLike {
+func NewRegisterLike(tx mysql.DBTransaction, tokenUserID int64, tokenUserName string, postingID int, userRepo *repository.UserRepository, postingRepo *repository.PostingRepository, likeRepo *repository.LikeRepository, postingCounterRepo *repository.PostingCounterRepository, userCounterRepo *repository.UserCounterRepository, notificationRepo *repository.NotificationRepository) *RegisterLike {
 	return &RegisterLike{
-		tx:               tx,
-		tokenUserID:      tokenUserID,
-		tokenUserName:    tokenUserName,
-		postingID:        postingID,
-		userRepo:         userRepo,
-		postingRepo:      postingRepo,
-		likeRepo:         likeRepo,
-		notificationRepo: notificationRepo,
+		tx:                 tx,
+		tokenUserID:        tokenUserID,
+		tokenUserName:      tokenUserName,
+		postingID:          postingID,
+		userRepo:           userRepo,
+		postingRepo:        postingRepo,
+		likeRepo:           likeRepo,
+		postingCounterRepo: postingCounterRepo,
+		userCounterRepo:    userCounterRepo,
+		notificationRepo:   notificationRepo,
 	}
 }
 
@@ -52,8 +57,15 @@ func (like *RegisterLike) RegisterLikeUseCase(ctx context.Context) error {
 
 	p, err := like.postingRepo.GetWhereID(ctx, int64(like.postingID))
 	if err != nil {
+		if err == repository.ErrNotExistsData {
+			return ErrNotExistsData
+		}
 		return err
 	}
+	// others can't see a scheduled posting yet
+	if p.IsScheduled(lib.NowFunc()) {
+		return ErrNotExistsData
+	}
 
 	if like.tokenUserID == p.UserID {
 		return ErrLikeYourPosting
@@ -70,6 +82,15 @@ func (like *RegisterLike) RegisterLikeUseCase(ctx context.Context) error {
 			}
 			return err
 		}
+		if err := like.postingCounterRepo.Add(ctx, &model.PostingCounter{PostingID: p.ID, LikedCount: 1}); err != nil {
+			return err
+		}
+		if err := like.userCounterRepo.Add(ctx, &model.UserCounter{UserID: like.tokenUserID, LikeCount: 1}); err != nil {
+			return err
+		}
+		if err := like.userCounterRepo.Add(ctx, &model.UserCounter{UserID: p.UserID, LikedCount: 1}); err != nil {
+			return err
+		}
 
 		// TODO notification
 		// if like.userName != p.UserName {
@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+	"github.com/gold-kou/ToeBeans/backend/app/lib"
+)
+
+type GetLikeUsersUseCaseInterface interface {
+	GetLikeUsersUseCase() ([]model.Like, []model.User, map[int64]bool, error)
+}
+
+type GetLikeUsers struct {
+	tx            mysql.DBTransaction
+	tokenUserName string
+	postingID     int64
+	cursor        model.Cursor
+	limit         int8
+	userRepo      *repository.UserRepository
+	postingRepo   *repository.PostingRepository
+	likeRepo      *repository.LikeRepository
+	followRepo    *repository.FollowRepository
+}
+
+func NewGetLikeUsers(tx mysql.DBTransaction, tokenUserName string, postingID int64, cursor model.Cursor, limit int8, userRepo *repository.UserRepository, postingRepo *repository.PostingRepository, likeRepo *repository.LikeRepository, followRepo *repository.FollowRepository) *GetLikeUsers {
+	return &GetLikeUsers{
+		tx:            tx,
+		tokenUserName: tokenUserName,
+		postingID:     postingID,
+		cursor:        cursor,
+		limit:         limit,
+		userRepo:      userRepo,
+		postingRepo:   postingRepo,
+		likeRepo:      likeRepo,
+		followRepo:    followRepo,
+	}
+}
+
+// GetLikeUsersUseCase returns the likes of the posting, the users who liked it in the same order and which of them the token user follows.
+func (l *GetLikeUsers) GetLikeUsersUseCase(ctx context.Context) (likes []model.Like, users []model.User, followed map[int64]bool, err error) {
+	// check userName in token exists
+	tokenUser, err := l.userRepo.GetUserWhereName(ctx, l.tokenUserName)
+	if err != nil {
+		if err == repository.ErrNotExistsData {
+			err = ErrTokenInvalidNotExistingUserName
+			return
+		}
+		return
+	}
+
+	// check postingID exists
+	posting, err := l.postingRepo.GetWhereID(ctx, l.postingID)
+	if err != nil {
+		if err == repository.ErrNotExistsData {
+			err = ErrNotExistsData
+			return
+		}
+		return
+	}
+	// others' scheduled postings don't exist for them
+	if posting.UserID != tokenUser.ID && posting.IsScheduled(lib.NowFunc()) {
+		err = ErrNotExistsData
+		return
+	}
+
+	likes, users, err = l.likeRepo.GetWithUsersWherePostingID(ctx, l.cursor, l.limit, l.postingID)
+	if err != nil {
+		return
+	}
+
+	userIDs := make([]int64, 0, len(users))
+	for _, u := range users {
+		userIDs = append(userIDs, u.ID)
+	}
+	followed, err = l.followRepo.GetFollowedUserIDsWhereFollowingUserID(ctx, tokenUser.ID, userIDs)
+	return
+}
@@ -16,15 +16,19 @@ type GetNotifications struct {
 	tx               mysql.DBTransaction
 	tokenUserName    string
 	visitedName      string
+	cursor           model.Cursor
+	limit            int8
 	userRepo         *repository.UserRepository
 	notificationRepo *repository.NotificationRepository
 }
 
-func NewGetNotifications(tx mysql.DBTransaction, tokenUserName, visitedName string, userRepo *repository.UserRepository, notificationRepo *repository.NotificationRepository) *GetNotifications {
+func NewGetNotifications(tx mysql.DBTransaction, tokenUserName, visitedName string, cursor model.Cursor, limit int8, userRepo *repository.UserRepository, notificationRepo *repository.NotificationRepository) *GetNotifications {
 	return &GetNotifications{
 		tx:               tx,
 		tokenUserName:    tokenUserName,
 		visitedName:      visitedName,
+		cursor:           cursor,
+		limit:            limit,
 		userRepo:         userRepo,
 		notificationRepo: notificationRepo,
 	}
@@ -37,7 +41,7 @@ func (n *GetNotifications) GetNotificationsUseCase(ctx context.Context) (notific
 		return
 	}
 
-	notifications, err = n.notificationRepo.GetNotifications(ctx, visitedUser.ID)
+	notifications, err = n.notificationRepo.GetNotifications(ctx, n.cursor, n.limit, visitedUser.ID)
 	if err != nil {
 		if err == repository.ErrNotExistsData {
 			err = ErrNotExistsData
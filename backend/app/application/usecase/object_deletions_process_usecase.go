@@ -0,0 +1,141 @@
+package usecase
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/aws"
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+	"github.com/gold-kou/ToeBeans/backend/app/lib"
+	"github.com/gold-kou/ToeBeans/backend/app/lib/imaging"
+)
+
+const (
+	objectDeletionBatchSize = 100
+	objectDeletionRetryBase = time.Minute
+	objectDeletionRetryMax  = 24 * time.Hour
+)
+
+type ProcessObjectDeletionsUseCaseInterface interface {
+	ProcessObjectDeletionsUseCase() error
+}
+
+type ProcessObjectDeletions struct {
+	tx                 mysql.DBTransaction
+	objectDeletionRepo *repository.ObjectDeletionRepository
+}
+
+func NewProcessObjectDeletions(tx mysql.DBTransaction, objectDeletionRepo *repository.ObjectDeletionRepository) *ProcessObjectDeletions {
+	return &ProcessObjectDeletions{
+		tx:                 tx,
+		objectDeletionRepo: objectDeletionRepo,
+	}
+}
+
+// ProcessObjectDeletionsUseCase retries the deletions which failed right after their commit.
+func (d *ProcessObjectDeletions) ProcessObjectDeletionsUseCase(ctx context.Context) error {
+	deletions, err := d.objectDeletionRepo.GetDue(ctx, lib.NowFunc(), objectDeletionBatchSize)
+	if err != nil {
+		return err
+	}
+	deleteObjects(ctx, d.tx, d.objectDeletionRepo, deletions)
+	return nil
+}
+
+// enqueueObjectDeletions queues the objects in the transaction of ctx, so that they are deleted if and only if the rows referring to them are.
+func enqueueObjectDeletions(ctx context.Context, objectDeletionRepo *repository.ObjectDeletionRepository, bucket string, keys []string) ([]model.ObjectDeletion, error) {
+	var deletions []model.ObjectDeletion
+	for _, key := range keys {
+		d := model.ObjectDeletion{
+			Bucket:        bucket,
+			ObjectKey:     key,
+			NextAttemptAt: lib.NowFunc(),
+		}
+		if err := objectDeletionRepo.Create(ctx, &d); err != nil {
+			return nil, err
+		}
+		deletions = append(deletions, d)
+	}
+	return deletions, nil
+}
+
+// deleteObjects deletes the objects from S3 and dequeues them.
+// Keys are derived from the content, so an object may be referred to again by a row registered after it was queued.
+// Each deletion is locked while the references are checked and such an object is only dequeued.
+// A failure is only logged because the rows are already gone. The deletion stays queued and the job retries it later.
+func deleteObjects(ctx context.Context, tx mysql.DBTransaction, objectDeletionRepo *repository.ObjectDeletionRepository, deletions []model.ObjectDeletion) {
+	for _, d := range deletions {
+		err := tx.Do(ctx, func(ctx context.Context) error {
+			queued, err := objectDeletionRepo.GetWhereIDForUpdate(ctx, d.ID)
+			if err != nil {
+				// already processed by another instance
+				if err == repository.ErrNotExistsData {
+					return nil
+				}
+				return err
+			}
+			referenced, err := objectReferenced(ctx, objectDeletionRepo, queued)
+			if err != nil {
+				return err
+			}
+			if !referenced {
+				if err := aws.DeleteObject(queued.Bucket, queued.ObjectKey); err != nil {
+					log.Println(err)
+					return objectDeletionRepo.UpdateRetryWhereID(ctx, lib.NowFunc().Add(objectDeletionBackoff(queued.Attempts)), queued.ID)
+				}
+			}
+			return objectDeletionRepo.DeleteWhereID(ctx, queued.ID)
+		})
+		if err != nil {
+			log.Println(err)
+		}
+	}
+}
+
+// objectReferenced reports whether a row refers to the object of the deletion.
+func objectReferenced(ctx context.Context, objectDeletionRepo *repository.ObjectDeletionRepository, d model.ObjectDeletion) (bool, error) {
+	switch d.Bucket {
+	case bucketPosting:
+		return objectDeletionRepo.ExistsPostingObjectReference(ctx, postingImageBaseKey(d.ObjectKey), d.ObjectKey)
+	case bucketIcons:
+		return objectDeletionRepo.ExistsIconObjectReference(ctx, d.ObjectKey)
+	}
+	return false, nil
+}
+
+// postingImageBaseKey returns the key in posting_images which the key of the variant was derived from.
+// A key of no variant is returned as is.
+func postingImageBaseKey(key string) string {
+	for _, v := range imaging.PostingVariants {
+		if suffix := imaging.VariantKey("", v); strings.HasSuffix(key, suffix) {
+			return strings.TrimSuffix(key, suffix)
+		}
+	}
+	return key
+}
+
+// objectDeletionBackoff doubles the wait for every failed attempt up to objectDeletionRetryMax.
+func objectDeletionBackoff(attempts int) time.Duration {
+	wait := objectDeletionRetryBase
+	for i := 0; i < attempts && wait < objectDeletionRetryMax; i++ {
+		wait *= 2
+	}
+	if wait > objectDeletionRetryMax {
+		wait = objectDeletionRetryMax
+	}
+	return wait
+}
+
+// imageObjectKeys returns the keys of all the variants stored under the base key.
+// The base key itself is included because images uploaded before variants existed were stored under it.
+func imageObjectKeys(baseKey string, variants []imaging.Variant) []string {
+	keys := []string{baseKey}
+	for _, v := range variants {
+		keys = append(keys, imaging.VariantKey(baseKey, v))
+	}
+	return keys
+}
@@ -0,0 +1,185 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/aws"
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+	"github.com/gold-kou/ToeBeans/backend/app/lib/imaging"
+)
+
+type MigrateObjectKeysUseCaseInterface interface {
+	MigrateObjectKeysUseCase() (model.ObjectKeyMigrationReport, error)
+}
+
+type MigrateObjectKeys struct {
+	tx                 mysql.DBTransaction
+	userRepo           *repository.UserRepository
+	postingRepo        *repository.PostingRepository
+	postingImageRepo   *repository.PostingImageRepository
+	objectDeletionRepo *repository.ObjectDeletionRepository
+}
+
+func NewMigrateObjectKeys(tx mysql.DBTransaction, userRepo *repository.UserRepository, postingRepo *repository.PostingRepository, postingImageRepo *repository.PostingImageRepository, objectDeletionRepo *repository.ObjectDeletionRepository) *MigrateObjectKeys {
+	return &MigrateObjectKeys{
+		tx:                 tx,
+		userRepo:           userRepo,
+		postingRepo:        postingRepo,
+		postingImageRepo:   postingImageRepo,
+		objectDeletionRepo: objectDeletionRepo,
+	}
+}
+
+// MigrateObjectKeysUseCase moves the posting images and icons stored under the old keys to the keys derived from their content.
+// Every object is copied first, then its row is switched to the new key and finally the old objects are deleted.
+// Migrated rows are skipped, so it can be run again after a failure.
+func (m *MigrateObjectKeys) MigrateObjectKeysUseCase(ctx context.Context) (report model.ObjectKeyMigrationReport, err error) {
+	images, err := m.postingImageRepo.GetWhereObjectKeyUnprefixed(ctx)
+	if err != nil {
+		return
+	}
+	for _, image := range images {
+		if err := m.migrateImage(ctx, image); err != nil {
+			log.Printf("posting image %d: %v", image.ID, err)
+			report.Failed++
+			continue
+		}
+		report.Images++
+	}
+
+	users, err := m.userRepo.GetWhereIconSet(ctx)
+	if err != nil {
+		return
+	}
+	for _, u := range users {
+		if strings.Contains(objectKeyFromURL(u.Icon, bucketIcons), "/") {
+			continue
+		}
+		if err := m.migrateIcon(ctx, u); err != nil {
+			log.Printf("icon of user %d: %v", u.ID, err)
+			report.Failed++
+			continue
+		}
+		report.Icons++
+	}
+	return report, nil
+}
+
+func (m *MigrateObjectKeys) migrateImage(ctx context.Context, image model.PostingImage) error {
+	p, err := m.postingRepo.GetWhereID(ctx, image.PostingID)
+	if err != nil {
+		return err
+	}
+	prefix := strconv.FormatInt(p.UserID, 10)
+
+	var newKey string
+	oldKeys := imageObjectKeys(image.ObjectKey, imaging.PostingVariants)
+	if imaging.VariantURL(image.ImageURL, imaging.VariantThumb) == image.ImageURL {
+		// uploaded before variants existed, so they are made from the original
+		data, err := aws.GetObject(bucketPosting, image.ObjectKey)
+		if err != nil {
+			return err
+		}
+		processed, err := imaging.Process(bytes.NewReader(data), imaging.PostingVariants)
+		if err != nil {
+			return err
+		}
+		for _, v := range processed {
+			if v.Variant == imaging.VariantFull {
+				newKey = imaging.ContentKey(prefix, v.Data)
+			}
+		}
+		for _, v := range processed {
+			if _, err := aws.UploadObject(bucketPosting, imaging.VariantKey(newKey, v.Variant), bytes.NewReader(v.Data), imaging.ContentType, imaging.CacheControl); err != nil {
+				return err
+			}
+		}
+	} else {
+		data, err := aws.GetObject(bucketPosting, imaging.VariantKey(image.ObjectKey, imaging.VariantFull))
+		if err != nil {
+			return err
+		}
+		newKey = imaging.ContentKey(prefix, data)
+		for _, v := range imaging.PostingVariants {
+			if err := aws.CopyObject(bucketPosting, imaging.VariantKey(image.ObjectKey, v), imaging.VariantKey(newKey, v), imaging.ContentType, imaging.CacheControl); err != nil {
+				return err
+			}
+		}
+	}
+	newURL := strings.TrimSuffix(image.ImageURL, objectKeyFromURL(image.ImageURL, bucketPosting)) + imaging.VariantKey(newKey, imaging.VariantFull)
+
+	var deletions []model.ObjectDeletion
+	err = m.tx.Do(ctx, func(ctx context.Context) error {
+		err := m.postingImageRepo.UpdateObjectWhereID(ctx, newURL, newKey, image.ID)
+		if err != nil {
+			return err
+		}
+		if image.Position == 0 {
+			if err = m.postingRepo.UpdateImageURLWhereID(ctx, newURL, image.PostingID); err != nil {
+				return err
+			}
+		}
+		// uploads in the same second overwrote each other, so another posting may still refer to the old objects
+		shared, err := m.postingImageRepo.ExistsObjectKeyInOtherPostings(ctx, image.ObjectKey, image.PostingID)
+		if err != nil || shared {
+			return err
+		}
+		deletions, err = enqueueObjectDeletions(ctx, m.objectDeletionRepo, bucketPosting, oldKeys)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	deleteObjects(ctx, m.tx, m.objectDeletionRepo, deletions)
+	return nil
+}
+
+func (m *MigrateObjectKeys) migrateIcon(ctx context.Context, u model.User) error {
+	oldKey := objectKeyFromURL(u.Icon, bucketIcons)
+	data, err := aws.GetObject(bucketIcons, oldKey)
+	if err != nil {
+		return err
+	}
+	prefix := strconv.FormatInt(u.ID, 10)
+
+	var newKey string
+	if strings.HasSuffix(oldKey, "_"+imaging.VariantIcon.Name+imaging.Extension) {
+		newKey = imaging.VariantKey(imaging.ContentKey(prefix, data), imaging.VariantIcon)
+		if err := aws.CopyObject(bucketIcons, oldKey, newKey, imaging.ContentType, imaging.CacheControl); err != nil {
+			return err
+		}
+	} else {
+		// uploaded before icons were shrunk, so it is made from the original
+		processed, err := imaging.Process(bytes.NewReader(data), imaging.IconVariants)
+		if err != nil {
+			return err
+		}
+		icon := processed[0]
+		newKey = imaging.VariantKey(imaging.ContentKey(prefix, icon.Data), icon.Variant)
+		if _, err := aws.UploadObject(bucketIcons, newKey, bytes.NewReader(icon.Data), imaging.ContentType, imaging.CacheControl); err != nil {
+			return err
+		}
+	}
+	newURL := strings.TrimSuffix(u.Icon, oldKey) + newKey
+
+	var deletions []model.ObjectDeletion
+	err = m.tx.Do(ctx, func(ctx context.Context) error {
+		err := m.userRepo.UpdateIconWhereID(ctx, newURL, u.ID)
+		if err != nil {
+			return err
+		}
+		deletions, err = enqueueObjectDeletions(ctx, m.objectDeletionRepo, bucketIcons, []string{oldKey})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	deleteObjects(ctx, m.tx, m.objectDeletionRepo, deletions)
+	return nil
+}
@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+	"log"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/aws"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+)
+
+type MakeObjectsPrivateUseCaseInterface interface {
+	MakeObjectsPrivateUseCase() ([]model.ObjectACLReport, error)
+}
+
+type MakeObjectsPrivate struct{}
+
+func NewMakeObjectsPrivate() *MakeObjectsPrivate {
+	return &MakeObjectsPrivate{}
+}
+
+// MakeObjectsPrivateUseCase blocks the public access to the posting and icon buckets and drops the public-read ACL
+// which the objects uploaded before the buckets became private still have.
+// Blocking the public access hides them at once. The ACLs are dropped too so that they stay private
+// even if the block is removed by mistake.
+func (m *MakeObjectsPrivate) MakeObjectsPrivateUseCase(ctx context.Context) (reports []model.ObjectACLReport, err error) {
+	for _, bucket := range []string{bucketPosting, bucketIcons} {
+		if err = aws.BlockPublicAccess(bucket); err != nil {
+			return
+		}
+		var objects []model.StoredObject
+		objects, err = aws.ListObjects(bucket)
+		if err != nil {
+			return
+		}
+		report := model.ObjectACLReport{Bucket: bucket}
+		for _, o := range objects {
+			if err := aws.PutObjectACLPrivate(bucket, o.Key); err != nil {
+				log.Printf("%s/%s: %v", bucket, o.Key, err)
+				report.Failed++
+				continue
+			}
+			report.Objects++
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
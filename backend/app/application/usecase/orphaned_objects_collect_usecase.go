@@ -0,0 +1,165 @@
+package usecase
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/aws"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+	"github.com/gold-kou/ToeBeans/backend/app/lib"
+	"github.com/gold-kou/ToeBeans/backend/app/lib/imaging"
+)
+
+type CollectOrphanedObjectsUseCaseInterface interface {
+	CollectOrphanedObjectsUseCase() ([]model.OrphanedObjectsReport, error)
+}
+
+type CollectOrphanedObjects struct {
+	dryRun           bool
+	gracePeriod      time.Duration
+	userRepo         *repository.UserRepository
+	postingRepo      *repository.PostingRepository
+	postingImageRepo *repository.PostingImageRepository
+	catRepo          *repository.CatRepository
+	draftRepo        *repository.DraftRepository
+}
+
+func NewCollectOrphanedObjects(dryRun bool, gracePeriod time.Duration, userRepo *repository.UserRepository, postingRepo *repository.PostingRepository, postingImageRepo *repository.PostingImageRepository, catRepo *repository.CatRepository, draftRepo *repository.DraftRepository) *CollectOrphanedObjects {
+	return &CollectOrphanedObjects{
+		dryRun:           dryRun,
+		gracePeriod:      gracePeriod,
+		userRepo:         userRepo,
+		postingRepo:      postingRepo,
+		postingImageRepo: postingImageRepo,
+		catRepo:          catRepo,
+		draftRepo:        draftRepo,
+	}
+}
+
+// CollectOrphanedObjectsUseCase finds objects in the posting and icon buckets which no row refers to and deletes them unless it is a dry run.
+// Objects are uploaded before their rows are committed, so the ones newer than the grace period are kept.
+func (c *CollectOrphanedObjects) CollectOrphanedObjectsUseCase(ctx context.Context) ([]model.OrphanedObjectsReport, error) {
+	// the references are read before listing, so an object committed in between is still in the grace period
+	postingKeys, err := c.postingObjectKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	iconKeys, err := c.iconObjectKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var reports []model.OrphanedObjectsReport
+	for _, b := range []struct {
+		bucket string
+		keys   map[string]bool
+	}{
+		{bucket: bucketPosting, keys: postingKeys},
+		{bucket: bucketIcons, keys: iconKeys},
+	} {
+		report, err := c.collect(b.bucket, b.keys)
+		if err != nil {
+			return nil, err
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
+
+func (c *CollectOrphanedObjects) collect(bucket string, referenced map[string]bool) (report model.OrphanedObjectsReport, err error) {
+	report.Bucket = bucket
+	objects, err := aws.ListObjects(bucket)
+	if err != nil {
+		return
+	}
+	threshold := lib.NowFunc().Add(-c.gracePeriod)
+	for _, o := range objects {
+		report.Scanned++
+		switch {
+		case referenced[o.Key]:
+			report.Referenced++
+		case o.LastModified.After(threshold):
+			report.InGracePeriod++
+		default:
+			report.Orphans = append(report.Orphans, o)
+			report.OrphanedBytes += o.Size
+		}
+	}
+	if c.dryRun {
+		return
+	}
+	for _, o := range report.Orphans {
+		if err := aws.DeleteObject(bucket, o.Key); err != nil {
+			report.Failed++
+			continue
+		}
+		report.Deleted++
+	}
+	return
+}
+
+// postingObjectKeys returns the keys of every variant of every posting image.
+func (c *CollectOrphanedObjects) postingObjectKeys(ctx context.Context) (map[string]bool, error) {
+	keys := map[string]bool{}
+	objectKeys, err := c.postingImageRepo.GetObjectKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, k := range objectKeys {
+		for _, key := range imageObjectKeys(k, imaging.PostingVariants) {
+			keys[key] = true
+		}
+	}
+	// the cover images of postings made before posting_images existed are referred to only by postings
+	imageURLs, err := c.postingRepo.GetImageURLs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, u := range imageURLs {
+		for _, v := range imaging.PostingVariants {
+			keys[objectKeyFromURL(imaging.VariantURL(u, v), bucketPosting)] = true
+		}
+	}
+	// the uploads in drafts are kept until the drafts are published or discarded
+	draftKeys, err := c.draftRepo.GetObjectKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, k := range draftKeys {
+		keys[k] = true
+	}
+	return keys, nil
+}
+
+func (c *CollectOrphanedObjects) iconObjectKeys(ctx context.Context) (map[string]bool, error) {
+	keys := map[string]bool{}
+	users, err := c.userRepo.GetWhereIconSet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, u := range users {
+		keys[objectKeyFromURL(u.Icon, bucketIcons)] = true
+	}
+	// cat icons share the bucket with user icons
+	cats, err := c.catRepo.GetWhereIconSet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, cat := range cats {
+		keys[objectKeyFromURL(cat.Icon, bucketIcons)] = true
+	}
+	return keys, nil
+}
+
+// objectKeyFromURL returns the key of the object at the URL, which is path-style on minio and virtual-hosted-style on S3.
+func objectKeyFromURL(rawURL, bucket string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	key := strings.TrimPrefix(u.Path, "/")
+	return strings.TrimPrefix(key, strings.Trim(bucket, "/")+"/")
+}
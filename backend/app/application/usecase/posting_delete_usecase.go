@@ -3,9 +3,10 @@ package usecase
 import (
 	"context"
 
-	"github.com/gold-kou/ToeBeans/backend/app/adapter/aws"
 	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
 	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+	"github.com/gold-kou/ToeBeans/backend/app/lib/imaging"
 )
 
 type DeletePostingUseCaseInterface interface {
@@ -13,20 +14,38 @@ type DeletePostingUseCaseInterface interface {
 }
 
 type DeletePosting struct {
-	tx            mysql.DBTransaction
-	postingID     int64
-	tokenUserName string
-	userRepo      *repository.UserRepository
-	postingRepo   *repository.PostingRepository
+	tx                    mysql.DBTransaction
+	postingID             int64
+	tokenUserName         string
+	userRepo              *repository.UserRepository
+	postingRepo           *repository.PostingRepository
+	postingImageRepo      *repository.PostingImageRepository
+	postingTagRepo        *repository.PostingTagRepository
+	postingCatRepo        *repository.PostingCatRepository
+	bookmarkRepo          *repository.BookmarkRepository
+	collectionPostingRepo *repository.CollectionPostingRepository
+	postingViewRepo       *repository.PostingViewRepository
+	postingCounterRepo    *repository.PostingCounterRepository
+	userCounterRepo       *repository.UserCounterRepository
+	objectDeletionRepo    *repository.ObjectDeletionRepository
 }
 
-func NewDeletePosting(tx mysql.DBTransaction, postingID int64, tokenUserName string, userRepo *repository.UserRepository, postingRepo *repository.PostingRepository) *DeletePosting {
+func NewDeletePosting(tx mysql.DBTransaction, postingID int64, tokenUserName string, userRepo *repository.UserRepository, postingRepo *repository.PostingRepository, postingImageRepo *repository.PostingImageRepository, postingTagRepo *repository.PostingTagRepository, postingCatRepo *repository.PostingCatRepository, bookmarkRepo *repository.BookmarkRepository, collectionPostingRepo *repository.CollectionPostingRepository, postingViewRepo *repository.PostingViewRepository, postingCounterRepo *repository.PostingCounterRepository, userCounterRepo *repository.UserCounterRepository, objectDeletionRepo *repository.ObjectDeletionRepository) *DeletePosting {
 	return &DeletePosting{
-		tx:            tx,
-		postingID:     postingID,
-		tokenUserName: tokenUserName,
-		userRepo:      userRepo,
-		postingRepo:   postingRepo,
+		tx:                    tx,
+		postingID:             postingID,
+		tokenUserName:         tokenUserName,
+		userRepo:              userRepo,
+		postingRepo:           postingRepo,
+		postingImageRepo:      postingImageRepo,
+		postingTagRepo:        postingTagRepo,
+		postingCatRepo:        postingCatRepo,
+		bookmarkRepo:          bookmarkRepo,
+		collectionPostingRepo: collectionPostingRepo,
+		postingViewRepo:       postingViewRepo,
+		postingCounterRepo:    postingCounterRepo,
+		userCounterRepo:       userCounterRepo,
+		objectDeletionRepo:    objectDeletionRepo,
 	}
 }
 
@@ -48,13 +67,65 @@ func (posting *DeletePosting) DeletePostingUseCase(ctx context.Context) error {
 		return err
 	}
 
-	err = aws.DeleteObject(bucketPosting, p.Title)
+	images, err := posting.postingImageRepo.GetWherePostingID(ctx, posting.postingID)
 	if err != nil {
 		return err
 	}
 
+	var deletions []model.ObjectDeletion
 	err = posting.tx.Do(ctx, func(ctx context.Context) error {
-		err := posting.postingRepo.DeleteWhereID(ctx, posting.postingID)
+		for _, image := range images {
+			shared, err := posting.postingImageRepo.ExistsObjectKeyInOtherPostings(ctx, image.ObjectKey, posting.postingID)
+			if err != nil {
+				return err
+			}
+			if shared {
+				continue
+			}
+			d, err := enqueueObjectDeletions(ctx, posting.objectDeletionRepo, bucketPosting, imageObjectKeys(image.ObjectKey, imaging.PostingVariants))
+			if err != nil {
+				return err
+			}
+			deletions = append(deletions, d...)
+		}
+		err := posting.postingImageRepo.DeleteWherePostingID(ctx, posting.postingID)
+		if err != nil {
+			return err
+		}
+		err = posting.postingTagRepo.DeleteWherePostingID(ctx, posting.postingID)
+		if err != nil {
+			return err
+		}
+		err = posting.postingCatRepo.DeleteWherePostingID(ctx, posting.postingID)
+		if err != nil {
+			return err
+		}
+		err = posting.bookmarkRepo.DeleteWherePostingID(ctx, posting.postingID)
+		if err != nil {
+			return err
+		}
+		// the posting disappears from every collection including others'
+		err = posting.collectionPostingRepo.DeleteWherePostingID(ctx, posting.postingID)
+		if err != nil {
+			return err
+		}
+		err = posting.postingViewRepo.DeleteWherePostingID(ctx, posting.postingID)
+		if err != nil {
+			return err
+		}
+		err = posting.postingCounterRepo.DeleteWherePostingID(ctx, posting.postingID)
+		if err != nil {
+			return err
+		}
+		err = posting.postingRepo.DeleteWhereID(ctx, posting.postingID)
+		if err != nil {
+			return err
+		}
+		// a scheduled posting is not counted until it is published
+		if p.PublishAt != nil {
+			return nil
+		}
+		err = posting.userCounterRepo.Add(ctx, &model.UserCounter{UserID: user.ID, PostingCount: -1})
 		if err != nil {
 			return err
 		}
@@ -63,5 +134,8 @@ func (posting *DeletePosting) DeletePostingUseCase(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+
+	// the photos must not stay public once the posting is gone
+	deleteObjects(ctx, posting.tx, posting.objectDeletionRepo, deletions)
 	return nil
 }
@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+)
+
+// PostingCommentsLimit is the number of comments returned with a posting. The rest are got from GET /comments.
+const PostingCommentsLimit = 20
+
+type GetPostingUseCaseInterface interface {
+	GetPostingUseCase() (*model.PostingDetail, error)
+}
+
+type GetPosting struct {
+	tx                mysql.DBTransaction
+	tokenUserName     string
+	postingID         int64
+	userRepo          *repository.UserRepository
+	postingRepo       *repository.PostingRepository
+	postingImageRepo  *repository.PostingImageRepository
+	commentRepo       *repository.CommentRepository
+	catRepo           *repository.CatRepository
+	postingViewBuffer *repository.PostingViewBuffer
+}
+
+func NewGetPosting(tx mysql.DBTransaction, tokenUserName string, postingID int64, userRepo *repository.UserRepository, postingRepo *repository.PostingRepository, postingImageRepo *repository.PostingImageRepository, commentRepo *repository.CommentRepository, catRepo *repository.CatRepository, postingViewBuffer *repository.PostingViewBuffer) *GetPosting {
+	return &GetPosting{
+		tx:                tx,
+		tokenUserName:     tokenUserName,
+		postingID:         postingID,
+		userRepo:          userRepo,
+		postingRepo:       postingRepo,
+		postingImageRepo:  postingImageRepo,
+		commentRepo:       commentRepo,
+		catRepo:           catRepo,
+		postingViewBuffer: postingViewBuffer,
+	}
+}
+
+// GetPostingUseCase returns the posting with the first page of its comments and the names of their users.
+func (p *GetPosting) GetPostingUseCase(ctx context.Context) (posting model.PostingDetail, comments []model.Comment, commentUserNames []string, err error) {
+	// check userName in token exists
+	tokenUser, err := p.userRepo.GetUserWhereName(ctx, p.tokenUserName)
+	if err != nil {
+		if err == repository.ErrNotExistsData {
+			err = ErrTokenInvalidNotExistingUserName
+			return
+		}
+		return
+	}
+
+	posting, err = p.postingRepo.GetDetailWhereID(ctx, p.postingID, tokenUser.ID)
+	if err != nil {
+		if err == repository.ErrNotExistsData {
+			err = ErrNotExistsData
+			return
+		}
+		return
+	}
+
+	posting.Images, err = p.postingImageRepo.GetWherePostingID(ctx, posting.ID)
+	if err != nil {
+		return
+	}
+
+	posting.Cats, err = p.catRepo.GetWherePostingID(ctx, posting.ID)
+	if err != nil {
+		return
+	}
+
+	comments, commentUserNames, err = p.commentRepo.GetLatestWithUserNamesWherePostingID(ctx, PostingCommentsLimit, posting.ID)
+	if err != nil {
+		return
+	}
+
+	recordPostingViews(p.postingViewBuffer, model.PostingViewKindDetail, tokenUser.ID, posting.Posting)
+	return
+}
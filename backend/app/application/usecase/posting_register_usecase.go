@@ -1,32 +1,61 @@
 package usecase
 
 import (
+	"bytes"
 	"context"
-	"encoding/base64"
 	"errors"
+	"io"
 	"os"
+	"strconv"
 	"strings"
-
-	"github.com/google/uuid"
+	"time"
 
 	"github.com/gold-kou/ToeBeans/backend/app"
 	"github.com/gold-kou/ToeBeans/backend/app/adapter/aws"
-	"github.com/gold-kou/ToeBeans/backend/app/adapter/gcp"
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/classifier"
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/http/helper"
 	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
 	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
 	modelHTTP "github.com/gold-kou/ToeBeans/backend/app/domain/model/http"
 	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
 	"github.com/gold-kou/ToeBeans/backend/app/lib"
+	"github.com/gold-kou/ToeBeans/backend/app/lib/hashtag"
+	"github.com/gold-kou/ToeBeans/backend/app/lib/imaging"
 )
 
 var ErrNotCatImage = errors.New("you can post only a cat image")
+var ErrDuplicateImage = errors.New("you have already posted the same image recently")
+var ErrUploadNotFound = errors.New("the upload doesn't exist")
+var ErrNotOwnedCat = errors.New("you can tag a posting only with your cats")
+
+const (
+	duplicateImageWindowDefault      = 30 * 24 * time.Hour
+	duplicateImageMaxDistanceDefault = 5
+)
+
 var bucketPosting string
+var duplicateImageWindow time.Duration
+var duplicateImageMaxDistance int
 
 func init() {
 	bucketPosting = os.Getenv("S3_BUCKET_POSTINGS")
 	if bucketPosting == "" {
 		panic("S3_BUCKET_POSTINGS is unset")
 	}
+
+	w, e := strconv.Atoi(os.Getenv("DUPLICATE_IMAGE_WINDOW_DAY"))
+	if e != nil || w <= 0 {
+		duplicateImageWindow = duplicateImageWindowDefault
+	} else {
+		duplicateImageWindow = time.Duration(w) * 24 * time.Hour
+	}
+
+	d, e := strconv.Atoi(os.Getenv("DUPLICATE_IMAGE_MAX_DISTANCE"))
+	if e != nil || d < 0 {
+		duplicateImageMaxDistance = duplicateImageMaxDistanceDefault
+	} else {
+		duplicateImageMaxDistance = d
+	}
 }
 
 type RegisterPostingUseCaseInterface interface {
@@ -38,18 +67,38 @@ type RegisterPosting struct {
 	tokenUserID        int64
 	tokenUserName      string
 	reqRegisterPosting *modelHTTP.RequestRegisterPosting
+	images             []io.Reader
+	imageClassifier    classifier.ImageClassifier
 	userRepo           *repository.UserRepository
 	postingRepo        *repository.PostingRepository
+	postingImageRepo   *repository.PostingImageRepository
+	tagRepo            *repository.TagRepository
+	postingTagRepo     *repository.PostingTagRepository
+	catRepo            *repository.CatRepository
+	postingCatRepo     *repository.PostingCatRepository
+	draftRepo          *repository.DraftRepository
+	userCounterRepo    *repository.UserCounterRepository
+	objectDeletionRepo *repository.ObjectDeletionRepository
 }
 
-func NewRegisterPosting(tx mysql.DBTransaction, tokenUserID int64, tokenUserName string, reqRegisterPosting *modelHTTP.RequestRegisterPosting, userRepo *repository.UserRepository, postingRepo *repository.PostingRepository) *RegisterPosting {
+func NewRegisterPosting(tx mysql.DBTransaction, tokenUserID int64, tokenUserName string, reqRegisterPosting *modelHTTP.RequestRegisterPosting, images []io.Reader, imageClassifier classifier.ImageClassifier, userRepo *repository.UserRepository, postingRepo *repository.PostingRepository, postingImageRepo *repository.PostingImageRepository, tagRepo *repository.TagRepository, postingTagRepo *repository.PostingTagRepository, catRepo *repository.CatRepository, postingCatRepo *repository.PostingCatRepository, draftRepo *repository.DraftRepository, userCounterRepo *repository.UserCounterRepository, objectDeletionRepo *repository.ObjectDeletionRepository) *RegisterPosting {
 	return &RegisterPosting{
 		tx:                 tx,
 		tokenUserID:        tokenUserID,
 		tokenUserName:      tokenUserName,
 		reqRegisterPosting: reqRegisterPosting,
+		images:             images,
+		imageClassifier:    imageClassifier,
 		userRepo:           userRepo,
 		postingRepo:        postingRepo,
+		postingImageRepo:   postingImageRepo,
+		tagRepo:            tagRepo,
+		postingTagRepo:     postingTagRepo,
+		catRepo:            catRepo,
+		postingCatRepo:     postingCatRepo,
+		draftRepo:          draftRepo,
+		userCounterRepo:    userCounterRepo,
+		objectDeletionRepo: objectDeletionRepo,
 	}
 }
 
@@ -63,82 +112,249 @@ func (posting *RegisterPosting) RegisterPostingUseCase(ctx context.Context) erro
 		return err
 	}
 
-	// base64 decode
-	decodedImg, err := base64.StdEncoding.DecodeString(posting.reqRegisterPosting.Image)
-	if err != nil {
-		return ErrDecodeImage
+	if err = checkOwnCats(ctx, posting.catRepo, posting.tokenUserID, posting.reqRegisterPosting.CatIDs); err != nil {
+		return err
 	}
 
-	// save decoded file
-	u, err := uuid.NewRandom()
-	if err != nil {
-		return err
+	images := posting.images
+	if len(posting.reqRegisterPosting.UploadKeys) > 0 {
+		images, err = posting.openUploads()
+		if err != nil {
+			return err
+		}
 	}
-	filePath := "image" + u.String() + ".jpg"
-	file, err := os.Create(filePath)
-	if err != nil {
-		return err
+
+	// every image must pass the cat and duplicate checks before anything is uploaded
+	var processedImages [][]imaging.Processed
+	var dHashes []uint64
+	var altText string
+	for i, image := range images {
+		processed, dHash, labels, err := posting.processImage(ctx, image)
+		if err != nil {
+			return err
+		}
+		processedImages = append(processedImages, processed)
+		dHashes = append(dHashes, dHash)
+		// without an alt text from the user, the cover image is described by its labels
+		if i == 0 {
+			altText = classifier.AltText(labels)
+		}
 	}
-	defer file.Close()
-	if _, err := file.Write(decodedImg); err != nil {
-		return err
+	if a := strings.TrimSpace(posting.reqRegisterPosting.AltText); a != "" {
+		altText = a
 	}
-	if err := file.Sync(); err != nil {
-		return err
+
+	// put files to s3
+	// keys are derived from the content under the user's prefix, so uploads never overwrite another image
+	var imageURLs, imageKeys []string
+	for _, processed := range processedImages {
+		var imageKey string
+		for _, p := range processed {
+			if p.Variant == imaging.VariantFull {
+				imageKey = imaging.ContentKey(strconv.FormatInt(posting.tokenUserID, 10), p.Data)
+			}
+		}
+		var imageURL string
+		for _, p := range processed {
+			o, err := aws.UploadObject(bucketPosting, imaging.VariantKey(imageKey, p.Variant), bytes.NewReader(p.Data), imaging.ContentType, imaging.CacheControl)
+			if err != nil {
+				return err
+			}
+			if p.Variant == imaging.VariantFull {
+				imageURL = o.Location
+			}
+		}
+		if app.IsLocal() {
+			imageURL = strings.Replace(imageURL, "minio", "localhost", 1)
+		}
+		imageURLs = append(imageURLs, imageURL)
+		imageKeys = append(imageKeys, imageKey)
 	}
-	// delete file
-	defer func() {
-		_ = os.Remove(filePath)
-	}()
 
-	// check cat or not
-	if app.IsProduction() {
-		labels, err := gcp.DetectLabels(filePath)
+	// INSERT
+	var deletions []model.ObjectDeletion
+	err = posting.tx.Do(ctx, func(ctx context.Context) error {
+		p := model.Posting{
+			UserID:    posting.tokenUserID,
+			Title:     posting.reqRegisterPosting.Title,
+			ImageURL:  imageURLs[0],
+			AltText:   altText,
+			PublishAt: posting.reqRegisterPosting.PublishAt,
+		}
+		err = posting.postingRepo.Create(ctx, &p)
 		if err != nil {
 			return err
 		}
-		for i, l := range labels {
-			if l == "Cat" || strings.Contains(l, "cat") {
-				break
+		// a scheduled posting is counted when it is published
+		if p.PublishAt == nil {
+			err = posting.userCounterRepo.Add(ctx, &model.UserCounter{UserID: posting.tokenUserID, PostingCount: 1})
+			if err != nil {
+				return err
 			}
-			if i == len(labels)-1 {
-				return ErrNotCatImage
+		}
+		for i, imageURL := range imageURLs {
+			pi := model.PostingImage{
+				PostingID: p.ID,
+				Position:  int8(i),
+				ImageURL:  imageURL,
+				ObjectKey: imageKeys[i],
+				DHash:     &dHashes[i],
+			}
+			err = posting.postingImageRepo.Create(ctx, &pi)
+			if err != nil {
+				return err
+			}
+		}
+		if err = registerPostingTags(ctx, posting.tagRepo, posting.postingTagRepo, p.ID, p.Title); err != nil {
+			return err
+		}
+		if err = registerPostingCats(ctx, posting.postingCatRepo, p.ID, posting.reqRegisterPosting.CatIDs); err != nil {
+			return err
+		}
+		// the drafts of the uploads are published
+		if err = posting.draftRepo.DeleteWhereUserIDObjectKeys(ctx, posting.tokenUserID, posting.reqRegisterPosting.UploadKeys); err != nil {
+			return err
+		}
+		// the uploads have been copied as the variants
+		deletions, err = enqueueObjectDeletions(ctx, posting.objectDeletionRepo, bucketPosting, posting.reqRegisterPosting.UploadKeys)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	deleteObjects(ctx, posting.tx, posting.objectDeletionRepo, deletions)
+	return nil
+}
+
+// openUploads reads the images put with presigned URLs.
+// The size and the type are checked again because the client may have put anything to the URL.
+func (posting *RegisterPosting) openUploads() ([]io.Reader, error) {
+	var images []io.Reader
+	for _, key := range posting.reqRegisterPosting.UploadKeys {
+		if !isUploadKeyOf(key, posting.tokenUserID) {
+			return nil, ErrUploadNotFound
+		}
+		o, err := aws.HeadObject(bucketPosting, key)
+		if err != nil {
+			if err == aws.ErrObjectNotFound {
+				return nil, ErrUploadNotFound
 			}
+			return nil, err
 		}
+		if o.Size > helper.ImageMaxByte {
+			return nil, helper.ErrImageTooLarge
+		}
+		data, err := aws.GetObject(bucketPosting, key)
+		if err != nil {
+			return nil, err
+		}
+		image, err := helper.NewImageReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		images = append(images, image)
 	}
+	return images, nil
+}
 
-	// put file to s3
-	savedFile, err := os.Open(filePath)
+// processImage strips metadata of the image, makes variants, checks the image is a cat
+// and rejects it when the user posted a near-duplicate recently. The labels seen in the image are returned too.
+func (posting *RegisterPosting) processImage(ctx context.Context, image io.Reader) ([]imaging.Processed, uint64, []model.Label, error) {
+	processed, err := imaging.Process(image, imaging.PostingVariants)
 	if err != nil {
-		return err
+		if err == imaging.ErrUnsupportedFormat || err == imaging.ErrTooManyPixels {
+			return nil, 0, nil, ErrDecodeImage
+		}
+		return nil, 0, nil, err
+	}
+	var thumb, full imaging.Processed
+	for _, p := range processed {
+		switch p.Variant {
+		case imaging.VariantThumb:
+			thumb = p
+		case imaging.VariantFull:
+			full = p
+		}
 	}
-	defer savedFile.Close()
 
-	key := lib.NowFunc().Format(lib.DateTimeFormatNoSeparator) + "_" + posting.tokenUserName
-	o, err := aws.UploadObject(bucketPosting, key, savedFile)
+	// check duplicate or not. the thumbnail is enough to hash and the cheapest to decode.
+	dHash, err := imaging.DHash(thumb.Data)
 	if err != nil {
-		return err
+		return nil, 0, nil, err
+	}
+	duplicate, err := posting.postingImageRepo.ExistsSimilarWhereUserID(ctx, dHash, duplicateImageMaxDistance, lib.NowFunc().Add(-duplicateImageWindow), posting.tokenUserID)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	if duplicate {
+		return nil, 0, nil, ErrDuplicateImage
 	}
 
-	// INSERT
-	if app.IsLocal() {
-		o.Location = strings.Replace(o.Location, "minio", "localhost", 1)
+	// check cat or not
+	labels, err := posting.imageClassifier.DetectLabels(ctx, bytes.NewReader(full.Data))
+	if err != nil {
+		return nil, 0, nil, err
 	}
-	err = posting.tx.Do(ctx, func(ctx context.Context) error {
-		p := model.Posting{
-			UserID:   posting.tokenUserID,
-			Title:    posting.reqRegisterPosting.Title,
-			ImageURL: o.Location,
-			// ImageURL: "http://localhost:9000/toebeans-postings/20200101000000_testUser1",
+	if !classifier.IsCat(labels) {
+		return nil, 0, nil, ErrNotCatImage
+	}
+	return processed, dHash, labels, nil
+}
+
+// registerPostingTags links the posting to the hashtags in the title. Tags are created on first use.
+func registerPostingTags(ctx context.Context, tagRepo *repository.TagRepository, postingTagRepo *repository.PostingTagRepository, postingID int64, title string) error {
+	for _, name := range hashtag.Extract(title) {
+		t := model.Tag{Name: name}
+		if err := tagRepo.Upsert(ctx, &t); err != nil {
+			return err
 		}
-		err = posting.postingRepo.Create(ctx, &p)
-		if err != nil {
+		pt := model.PostingTag{
+			PostingID: postingID,
+			TagID:     t.ID,
+		}
+		if err := postingTagRepo.Create(ctx, &pt); err != nil {
 			return err
 		}
+	}
+	return nil
+}
+
+// checkOwnCats returns ErrNotOwnedCat unless all the cats belong to the user.
+func checkOwnCats(ctx context.Context, catRepo *repository.CatRepository, userID int64, catIDs []int64) error {
+	if len(catIDs) == 0 {
 		return nil
-	})
+	}
+	cats, err := catRepo.GetWhereUserID(ctx, userID)
 	if err != nil {
 		return err
 	}
+	owned := make(map[int64]bool, len(cats))
+	for _, c := range cats {
+		owned[c.ID] = true
+	}
+	for _, id := range catIDs {
+		if !owned[id] {
+			return ErrNotOwnedCat
+		}
+	}
+	return nil
+}
+
+// registerPostingCats links the posting to the cats. The same cat is linked once even if it is repeated.
+func registerPostingCats(ctx context.Context, postingCatRepo *repository.PostingCatRepository, postingID int64, catIDs []int64) error {
+	registered := make(map[int64]bool, len(catIDs))
+	for _, id := range catIDs {
+		if registered[id] {
+			continue
+		}
+		pc := model.PostingCat{
+			PostingID: postingID,
+			CatID:     id,
+		}
+		if err := postingCatRepo.Create(ctx, &pc); err != nil {
+			return err
+		}
+		registered[id] = true
+	}
 	return nil
 }
@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+	"github.com/gold-kou/ToeBeans/backend/app/lib"
+)
+
+// a comment takes more effort than a like
+const (
+	popularLikeWeight    = 1
+	popularCommentWeight = 2
+)
+
+// popularPeriod counts the reactions within window, and a reaction is worth half after every halfLife.
+type popularPeriod struct {
+	name     string
+	window   time.Duration
+	halfLife time.Duration
+}
+
+var popularPeriods = []popularPeriod{
+	{name: model.PopularPeriodDay, window: 24 * time.Hour, halfLife: 6 * time.Hour},
+	{name: model.PopularPeriodWeek, window: 7 * 24 * time.Hour, halfLife: 2 * 24 * time.Hour},
+	// zero window means since the service started
+	{name: model.PopularPeriodAll, halfLife: 30 * 24 * time.Hour},
+}
+
+type RefreshPostingScoresUseCaseInterface interface {
+	RefreshPostingScoresUseCase() error
+}
+
+type RefreshPostingScores struct {
+	tx               mysql.DBTransaction
+	postingScoreRepo *repository.PostingScoreRepository
+}
+
+func NewRefreshPostingScores(tx mysql.DBTransaction, postingScoreRepo *repository.PostingScoreRepository) *RefreshPostingScores {
+	return &RefreshPostingScores{
+		tx:               tx,
+		postingScoreRepo: postingScoreRepo,
+	}
+}
+
+// RefreshPostingScoresUseCase recomputes the scores of every period.
+// Each period is replaced in a transaction so that the popular postings never look empty while it runs.
+func (r *RefreshPostingScores) RefreshPostingScoresUseCase(ctx context.Context) error {
+	now := lib.NowFunc()
+	for _, p := range popularPeriods {
+		since := time.Unix(0, 0)
+		if p.window > 0 {
+			since = now.Add(-p.window)
+		}
+		err := r.tx.Do(ctx, func(ctx context.Context) error {
+			if err := r.postingScoreRepo.DeleteWherePeriod(ctx, p.name); err != nil {
+				return err
+			}
+			return r.postingScoreRepo.CreateFromReactions(ctx, p.name, since, now, p.halfLife, popularLikeWeight, popularCommentWeight)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+	"github.com/gold-kou/ToeBeans/backend/app/lib"
+)
+
+// the number of the days returned by default and at most
+const (
+	PostingStatsDaysDefault = 30
+	PostingStatsDaysMax     = 90
+)
+
+var ErrNotPostingStatsOwner = errors.New("you can see only the stats of your posting")
+
+type GetPostingStatsUseCaseInterface interface {
+	GetPostingStatsUseCase() ([]model.PostingDailyStats, error)
+}
+
+type GetPostingStats struct {
+	tx              mysql.DBTransaction
+	tokenUserName   string
+	postingID       int64
+	days            int
+	userRepo        *repository.UserRepository
+	postingRepo     *repository.PostingRepository
+	postingViewRepo *repository.PostingViewRepository
+}
+
+func NewGetPostingStats(tx mysql.DBTransaction, tokenUserName string, postingID int64, days int, userRepo *repository.UserRepository, postingRepo *repository.PostingRepository, postingViewRepo *repository.PostingViewRepository) *GetPostingStats {
+	return &GetPostingStats{
+		tx:              tx,
+		tokenUserName:   tokenUserName,
+		postingID:       postingID,
+		days:            days,
+		userRepo:        userRepo,
+		postingRepo:     postingRepo,
+		postingViewRepo: postingViewRepo,
+	}
+}
+
+// GetPostingStatsUseCase returns the stats of the last days including today in the order of date.
+// Dates when nothing happened are filled with zero. Views still in the buffer are not counted yet.
+func (p *GetPostingStats) GetPostingStatsUseCase(ctx context.Context) (stats []model.PostingDailyStats, err error) {
+	// check userName in token exists
+	user, err := p.userRepo.GetUserWhereName(ctx, p.tokenUserName)
+	if err != nil {
+		if err == repository.ErrNotExistsData {
+			err = ErrTokenInvalidNotExistingUserName
+			return
+		}
+		return
+	}
+
+	posting, err := p.postingRepo.GetWhereID(ctx, p.postingID)
+	if err != nil {
+		if err == repository.ErrNotExistsData {
+			err = ErrNotExistsData
+			return
+		}
+		return
+	}
+	if posting.UserID != user.ID {
+		// others' scheduled postings don't exist for them
+		if posting.IsScheduled(lib.NowFunc()) {
+			err = ErrNotExistsData
+			return
+		}
+		err = ErrNotPostingStatsOwner
+		return
+	}
+
+	now := lib.NowFunc()
+	since := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location()).AddDate(0, 0, -(p.days - 1))
+	counted, err := p.postingViewRepo.GetDailyStatsWherePostingID(ctx, p.postingID, since)
+	if err != nil {
+		return
+	}
+	byDate := make(map[string]model.PostingDailyStats, len(counted))
+	for _, s := range counted {
+		byDate[s.Date.Format("2006-01-02")] = s
+	}
+
+	for i := 0; i < p.days; i++ {
+		date := since.AddDate(0, 0, i)
+		s := byDate[date.Format("2006-01-02")]
+		s.Date = date
+		stats = append(stats, s)
+	}
+	return
+}
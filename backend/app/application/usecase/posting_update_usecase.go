@@ -0,0 +1,210 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/aws"
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/classifier"
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+	modelHTTP "github.com/gold-kou/ToeBeans/backend/app/domain/model/http"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+	"github.com/gold-kou/ToeBeans/backend/app/lib"
+	"github.com/gold-kou/ToeBeans/backend/app/lib/hashtag"
+)
+
+var ErrNotPostingOwner = errors.New("you can edit only your posting")
+var ErrAlreadyPublished = errors.New("the posting has already been published")
+
+type UpdatePostingUseCaseInterface interface {
+	UpdatePostingUseCase() error
+}
+
+type UpdatePosting struct {
+	tx                      mysql.DBTransaction
+	postingID               int64
+	tokenUserName           string
+	reqUpdatePosting        *modelHTTP.RequestUpdatePosting
+	imageClassifier         classifier.ImageClassifier
+	userRepo                *repository.UserRepository
+	postingRepo             *repository.PostingRepository
+	postingTitleHistoryRepo *repository.PostingTitleHistoryRepository
+	tagRepo                 *repository.TagRepository
+	postingTagRepo          *repository.PostingTagRepository
+	catRepo                 *repository.CatRepository
+	postingCatRepo          *repository.PostingCatRepository
+}
+
+func NewUpdatePosting(tx mysql.DBTransaction, postingID int64, tokenUserName string, reqUpdatePosting *modelHTTP.RequestUpdatePosting, imageClassifier classifier.ImageClassifier, userRepo *repository.UserRepository, postingRepo *repository.PostingRepository, postingTitleHistoryRepo *repository.PostingTitleHistoryRepository, tagRepo *repository.TagRepository, postingTagRepo *repository.PostingTagRepository, catRepo *repository.CatRepository, postingCatRepo *repository.PostingCatRepository) *UpdatePosting {
+	return &UpdatePosting{
+		tx:                      tx,
+		postingID:               postingID,
+		tokenUserName:           tokenUserName,
+		reqUpdatePosting:        reqUpdatePosting,
+		imageClassifier:         imageClassifier,
+		userRepo:                userRepo,
+		postingRepo:             postingRepo,
+		postingTitleHistoryRepo: postingTitleHistoryRepo,
+		tagRepo:                 tagRepo,
+		postingTagRepo:          postingTagRepo,
+		catRepo:                 catRepo,
+		postingCatRepo:          postingCatRepo,
+	}
+}
+
+func (posting *UpdatePosting) UpdatePostingUseCase(ctx context.Context) error {
+	// check userName in token exists
+	user, err := posting.userRepo.GetUserWhereName(ctx, posting.tokenUserName)
+	if err != nil {
+		if err == repository.ErrNotExistsData {
+			return ErrTokenInvalidNotExistingUserName
+		}
+		return err
+	}
+
+	p, err := posting.postingRepo.GetWhereID(ctx, posting.postingID)
+	if err != nil {
+		if err == repository.ErrNotExistsData {
+			return ErrNotExistsData
+		}
+		return err
+	}
+	if p.UserID != user.ID {
+		return ErrNotPostingOwner
+	}
+
+	reschedule := posting.reqUpdatePosting.PublishAt != nil
+	if reschedule && !p.IsScheduled(lib.NowFunc()) {
+		return ErrAlreadyPublished
+	}
+
+	retag := posting.reqUpdatePosting.CatIDs != nil
+	if retag {
+		if err := checkOwnCats(ctx, posting.catRepo, user.ID, *posting.reqUpdatePosting.CatIDs); err != nil {
+			return err
+		}
+	}
+
+	var altText string
+	describe := posting.reqUpdatePosting.AltText != nil
+	if describe {
+		altText = strings.TrimSpace(*posting.reqUpdatePosting.AltText)
+		// an empty alt text puts back the default made from the cover image
+		if altText == "" {
+			altText, err = describePostingImage(ctx, posting.imageClassifier, p.ImageURL)
+			if err != nil {
+				return err
+			}
+		}
+		describe = altText != p.AltText
+	}
+
+	// nothing to record
+	if p.Title == posting.reqUpdatePosting.Title && !reschedule && !retag && !describe {
+		return nil
+	}
+
+	err = posting.tx.Do(ctx, func(ctx context.Context) error {
+		if reschedule {
+			if err := posting.postingRepo.UpdatePublishAtWhereID(ctx, *posting.reqUpdatePosting.PublishAt, p.ID); err != nil {
+				return err
+			}
+		}
+		if retag {
+			if err := posting.postingCatRepo.DeleteWherePostingID(ctx, p.ID); err != nil {
+				return err
+			}
+			if err := registerPostingCats(ctx, posting.postingCatRepo, p.ID, *posting.reqUpdatePosting.CatIDs); err != nil {
+				return err
+			}
+		}
+		if describe {
+			if err := posting.postingRepo.UpdateAltTextWhereID(ctx, altText, p.ID); err != nil {
+				return err
+			}
+		}
+		if p.Title == posting.reqUpdatePosting.Title {
+			return nil
+		}
+
+		// keep the old title so that moderators can see what was posted originally
+		h := model.PostingTitleHistory{
+			PostingID: p.ID,
+			Title:     p.Title,
+		}
+		if err := posting.postingTitleHistoryRepo.Create(ctx, &h); err != nil {
+			return err
+		}
+		if err := posting.postingRepo.UpdateTitleWhereID(ctx, posting.reqUpdatePosting.Title, lib.NowFunc(), p.ID); err != nil {
+			return err
+		}
+
+		// hashtags follow the new title
+		return updatePostingTags(ctx, posting.tagRepo, posting.postingTagRepo, p.ID, p.Title, posting.reqUpdatePosting.Title)
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// updatePostingTags links only the tags added to the title and unlinks only the removed ones.
+// The kept links stay as they are because trending tags are counted by when they were linked.
+func updatePostingTags(ctx context.Context, tagRepo *repository.TagRepository, postingTagRepo *repository.PostingTagRepository, postingID int64, oldTitle, newTitle string) error {
+	oldTags := hashtag.Extract(oldTitle)
+	newTags := hashtag.Extract(newTitle)
+	kept := make(map[string]bool, len(newTags))
+	for _, name := range newTags {
+		kept[name] = true
+	}
+	linked := make(map[string]bool, len(oldTags))
+	for _, name := range oldTags {
+		linked[name] = true
+		if kept[name] {
+			continue
+		}
+		t, err := tagRepo.GetWhereName(ctx, name)
+		if err != nil {
+			if err == repository.ErrNotExistsData {
+				continue
+			}
+			return err
+		}
+		if err := postingTagRepo.DeleteWherePostingIDTagID(ctx, postingID, t.ID); err != nil {
+			return err
+		}
+	}
+	for _, name := range newTags {
+		if linked[name] {
+			continue
+		}
+		t := model.Tag{Name: name}
+		if err := tagRepo.Upsert(ctx, &t); err != nil {
+			return err
+		}
+		pt := model.PostingTag{
+			PostingID: postingID,
+			TagID:     t.ID,
+		}
+		if err := postingTagRepo.Create(ctx, &pt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// describePostingImage makes the default alt text of a posting from the labels of its cover image put to s3.
+func describePostingImage(ctx context.Context, imageClassifier classifier.ImageClassifier, imageURL string) (string, error) {
+	data, err := aws.GetObject(bucketPosting, objectKeyFromURL(imageURL, bucketPosting))
+	if err != nil {
+		return "", err
+	}
+	labels, err := imageClassifier.DetectLabels(ctx, bytes.NewReader(data))
+	if err != nil {
+		return "", err
+	}
+	return classifier.AltText(labels), nil
+}
@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+	"github.com/gold-kou/ToeBeans/backend/app/lib"
+)
+
+const postingViewWindowDefault = 30 * time.Minute
+
+// postingViewWindow is how long the views of a posting by the same user count as one
+var postingViewWindow time.Duration
+
+func init() {
+	m, e := strconv.Atoi(os.Getenv("POSTING_VIEW_WINDOW_MINUTE"))
+	if e != nil || m <= 0 {
+		postingViewWindow = postingViewWindowDefault
+	} else {
+		postingViewWindow = time.Duration(m) * time.Minute
+	}
+}
+
+type FlushPostingViewsUseCaseInterface interface {
+	FlushPostingViewsUseCase() error
+}
+
+type FlushPostingViews struct {
+	postingViewRepo   *repository.PostingViewRepository
+	postingViewBuffer *repository.PostingViewBuffer
+}
+
+func NewFlushPostingViews(postingViewRepo *repository.PostingViewRepository, postingViewBuffer *repository.PostingViewBuffer) *FlushPostingViews {
+	return &FlushPostingViews{
+		postingViewRepo:   postingViewRepo,
+		postingViewBuffer: postingViewBuffer,
+	}
+}
+
+// FlushPostingViewsUseCase writes the buffered views batch by batch until the buffer is empty.
+// The batch which failed is put back to be written next time.
+func (f *FlushPostingViews) FlushPostingViewsUseCase(ctx context.Context) error {
+	for {
+		views := f.postingViewBuffer.DrainBatch()
+		if len(views) == 0 {
+			return nil
+		}
+		if err := f.postingViewRepo.CreateIgnoringDuplicates(ctx, views); err != nil {
+			f.postingViewBuffer.Add(views...)
+			return err
+		}
+	}
+}
+
+// recordPostingViews buffers the views of the postings by the user. The views of the user's own postings are not counted.
+func recordPostingViews(postingViewBuffer *repository.PostingViewBuffer, kind string, userID int64, postings ...model.Posting) {
+	windowStart := lib.NowFunc().Truncate(postingViewWindow)
+	views := make([]model.PostingView, 0, len(postings))
+	for _, p := range postings {
+		if p.UserID == userID {
+			continue
+		}
+		views = append(views, model.PostingView{PostingID: p.ID, UserID: userID, Kind: kind, WindowStart: windowStart})
+	}
+	postingViewBuffer.Add(views...)
+}
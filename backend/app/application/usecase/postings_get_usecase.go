@@ -2,7 +2,6 @@ package usecase
 
 import (
 	"context"
-	"time"
 
 	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
 	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
@@ -14,26 +13,32 @@ type GetPostingsUseCaseInterface interface {
 }
 
 type GetPostings struct {
-	tx             mysql.DBTransaction
-	tokenUserName  string
-	sinceAt        time.Time
-	limit          int8
-	targetUserName string
-	userRepo       *repository.UserRepository
-	postingRepo    *repository.PostingRepository
-	likeRepo       *repository.LikeRepository
+	tx                 mysql.DBTransaction
+	tokenUserName      string
+	cursor             model.Cursor
+	limit              int8
+	targetUserName     string
+	userRepo           *repository.UserRepository
+	postingRepo        *repository.PostingRepository
+	likeRepo           *repository.LikeRepository
+	postingCounterRepo *repository.PostingCounterRepository
+	postingImageRepo   *repository.PostingImageRepository
+	postingViewBuffer  *repository.PostingViewBuffer
 }
 
-func NewGetPostings(tx mysql.DBTransaction, tokenUserName string, sinceAt time.Time, limit int8, targetUserName string, userRepo *repository.UserRepository, postingRepo *repository.PostingRepository, likeRepo *repository.LikeRepository) *GetPostings {
+func NewGetPostings(tx mysql.DBTransaction, tokenUserName string, cursor model.Cursor, limit int8, targetUserName string, userRepo *repository.UserRepository, postingRepo *repository.PostingRepository, likeRepo *repository.LikeRepository, postingCounterRepo *repository.PostingCounterRepository, postingImageRepo *repository.PostingImageRepository, postingViewBuffer *repository.PostingViewBuffer) *GetPostings {
 	return &GetPostings{
-		tx:             tx,
-		tokenUserName:  tokenUserName,
-		sinceAt:        sinceAt,
-		limit:          limit,
-		targetUserName: targetUserName,
-		userRepo:       userRepo,
-		postingRepo:    postingRepo,
-		likeRepo:       likeRepo,
+		tx:                 tx,
+		tokenUserName:      tokenUserName,
+		cursor:             cursor,
+		limit:              limit,
+		targetUserName:     targetUserName,
+		userRepo:           userRepo,
+		postingRepo:        postingRepo,
+		likeRepo:           likeRepo,
+		postingCounterRepo: postingCounterRepo,
+		postingImageRepo:   postingImageRepo,
+		postingViewBuffer:  postingViewBuffer,
 	}
 }
 
@@ -70,7 +75,7 @@ func (p *GetPostings) GetPostingsUseCase(ctx context.Context) (postings []model.
 		return
 	}
 
-	postings, err = p.postingRepo.GetPostings(ctx, p.sinceAt, p.limit, targetUser.ID)
+	postings, err = p.postingRepo.GetPostings(ctx, p.cursor, p.limit, targetUser.ID)
 	if err != nil {
 		if err == repository.ErrNotExistsData {
 			// not error
@@ -79,21 +84,41 @@ func (p *GetPostings) GetPostingsUseCase(ctx context.Context) (postings []model.
 		return
 	}
 
+	userNames, likedCounts, err = fillPostings(ctx, p.userRepo, p.postingCounterRepo, p.postingImageRepo, postings)
+	if err != nil {
+		return
+	}
+
+	recordPostingViews(p.postingViewBuffer, model.PostingViewKindImpression, tokenUser.ID, postings...)
+	return
+}
+
+// fillPostings sets the images of each posting and returns the names of the posting users and the liked counts in the same order as postings.
+func fillPostings(ctx context.Context, userRepo *repository.UserRepository, postingCounterRepo *repository.PostingCounterRepository, postingImageRepo *repository.PostingImageRepository, postings []model.Posting) (userNames []string, likedCounts []int64, err error) {
+	postingIDs := make([]int64, 0, len(postings))
 	for _, posting := range postings {
+		postingIDs = append(postingIDs, posting.ID)
+	}
+	counters, err := postingCounterRepo.GetWherePostingIDs(ctx, postingIDs)
+	if err != nil {
+		return
+	}
+
+	for i, posting := range postings {
 		var user model.User
-		user, err = p.userRepo.GetUserWhereID(ctx, posting.UserID)
+		user, err = userRepo.GetUserWhereID(ctx, posting.UserID)
 		if err != nil {
 			// ここでのnot exists errorは500エラー
 			return
 		}
 		userNames = append(userNames, user.Name)
 
-		var likedCount int64
-		likedCount, err = p.likeRepo.GetLikedCountWherePostingID(ctx, posting.ID)
+		likedCounts = append(likedCounts, counters[posting.ID].LikedCount)
+
+		postings[i].Images, err = postingImageRepo.GetWherePostingID(ctx, posting.ID)
 		if err != nil {
 			return
 		}
-		likedCounts = append(likedCounts, likedCount)
 	}
 	return
 }
@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+)
+
+type GetPopularPostingsUseCaseInterface interface {
+	GetPopularPostingsUseCase() ([]model.Posting, error)
+}
+
+type GetPopularPostings struct {
+	tx                 mysql.DBTransaction
+	tokenUserName      string
+	period             string
+	limit              int8
+	offset             int
+	userRepo           *repository.UserRepository
+	postingRepo        *repository.PostingRepository
+	likeRepo           *repository.LikeRepository
+	postingCounterRepo *repository.PostingCounterRepository
+	postingImageRepo   *repository.PostingImageRepository
+	postingViewBuffer  *repository.PostingViewBuffer
+}
+
+func NewGetPopularPostings(tx mysql.DBTransaction, tokenUserName string, period string, limit int8, offset int, userRepo *repository.UserRepository, postingRepo *repository.PostingRepository, likeRepo *repository.LikeRepository, postingCounterRepo *repository.PostingCounterRepository, postingImageRepo *repository.PostingImageRepository, postingViewBuffer *repository.PostingViewBuffer) *GetPopularPostings {
+	return &GetPopularPostings{
+		tx:                 tx,
+		tokenUserName:      tokenUserName,
+		period:             period,
+		limit:              limit,
+		offset:             offset,
+		userRepo:           userRepo,
+		postingRepo:        postingRepo,
+		likeRepo:           likeRepo,
+		postingCounterRepo: postingCounterRepo,
+		postingImageRepo:   postingImageRepo,
+		postingViewBuffer:  postingViewBuffer,
+	}
+}
+
+// GetPopularPostingsUseCase reads the scores computed by RefreshPostingScoresUseCase, so it doesn't aggregate likes by itself.
+func (p *GetPopularPostings) GetPopularPostingsUseCase(ctx context.Context) (postings []model.Posting, userNames []string, likedCounts []int64, likes []model.Like, err error) {
+	// check userName in token exists
+	tokenUser, err := p.userRepo.GetUserWhereName(ctx, p.tokenUserName)
+	if err != nil {
+		if err == repository.ErrNotExistsData {
+			err = ErrTokenInvalidNotExistingUserName
+			return
+		}
+		return
+	}
+
+	likes, err = p.likeRepo.GetWhereUserID(ctx, tokenUser.ID)
+	if err != nil {
+		return
+	}
+
+	postings, err = p.postingRepo.GetPopular(ctx, p.period, p.limit, p.offset)
+	if err != nil {
+		return
+	}
+
+	userNames, likedCounts, err = fillPostings(ctx, p.userRepo, p.postingCounterRepo, p.postingImageRepo, postings)
+	if err != nil {
+		return
+	}
+
+	recordPostingViews(p.postingViewBuffer, model.PostingViewKindImpression, tokenUser.ID, postings...)
+	return
+}
@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+)
+
+type GetScheduledPostingsUseCaseInterface interface {
+	GetScheduledPostingsUseCase() (*model.Posting, error)
+}
+
+type GetScheduledPostings struct {
+	tx                 mysql.DBTransaction
+	tokenUserName      string
+	userRepo           *repository.UserRepository
+	postingRepo        *repository.PostingRepository
+	postingCounterRepo *repository.PostingCounterRepository
+	postingImageRepo   *repository.PostingImageRepository
+}
+
+func NewGetScheduledPostings(tx mysql.DBTransaction, tokenUserName string, userRepo *repository.UserRepository, postingRepo *repository.PostingRepository, postingCounterRepo *repository.PostingCounterRepository, postingImageRepo *repository.PostingImageRepository) *GetScheduledPostings {
+	return &GetScheduledPostings{
+		tx:                 tx,
+		tokenUserName:      tokenUserName,
+		userRepo:           userRepo,
+		postingRepo:        postingRepo,
+		postingCounterRepo: postingCounterRepo,
+		postingImageRepo:   postingImageRepo,
+	}
+}
+
+// GetScheduledPostingsUseCase returns the postings of the request user which are not published yet.
+func (p *GetScheduledPostings) GetScheduledPostingsUseCase(ctx context.Context) (postings []model.Posting, userNames []string, likedCounts []int64, err error) {
+	// check userName in token exists
+	tokenUser, err := p.userRepo.GetUserWhereName(ctx, p.tokenUserName)
+	if err != nil {
+		if err == repository.ErrNotExistsData {
+			err = ErrTokenInvalidNotExistingUserName
+			return
+		}
+		return
+	}
+
+	postings, err = p.postingRepo.GetScheduledWhereUserID(ctx, tokenUser.ID)
+	if err != nil {
+		return
+	}
+
+	userNames, likedCounts, err = fillPostings(ctx, p.userRepo, p.postingCounterRepo, p.postingImageRepo, postings)
+	return
+}
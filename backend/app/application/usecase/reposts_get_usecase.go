@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+)
+
+type GetRepostsUseCaseInterface interface {
+	GetRepostsUseCase() ([]model.SimilarImage, error)
+}
+
+type GetReposts struct {
+	tx               mysql.DBTransaction
+	postingID        int64
+	maxDistance      int
+	postingRepo      *repository.PostingRepository
+	postingImageRepo *repository.PostingImageRepository
+}
+
+// NewGetReposts makes the use case for moderators. A negative maxDistance means DUPLICATE_IMAGE_MAX_DISTANCE.
+func NewGetReposts(tx mysql.DBTransaction, postingID int64, maxDistance int, postingRepo *repository.PostingRepository, postingImageRepo *repository.PostingImageRepository) *GetReposts {
+	if maxDistance < 0 {
+		maxDistance = duplicateImageMaxDistance
+	}
+	return &GetReposts{
+		tx:               tx,
+		postingID:        postingID,
+		maxDistance:      maxDistance,
+		postingRepo:      postingRepo,
+		postingImageRepo: postingImageRepo,
+	}
+}
+
+// GetRepostsUseCase returns images of other users which look like an image of the posting.
+func (r *GetReposts) GetRepostsUseCase(ctx context.Context) (images []model.SimilarImage, err error) {
+	_, err = r.postingRepo.GetWhereID(ctx, r.postingID)
+	if err != nil {
+		if err == repository.ErrNotExistsData {
+			err = ErrNotExistsData
+			return
+		}
+		return
+	}
+	return r.postingImageRepo.GetSimilarOfOtherUsers(ctx, r.maxDistance, r.postingID)
+}
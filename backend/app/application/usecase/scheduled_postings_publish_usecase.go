@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+	"github.com/gold-kou/ToeBeans/backend/app/lib"
+)
+
+const scheduledPostingsPublishBatchSize = 100
+
+type PublishScheduledPostingsUseCaseInterface interface {
+	PublishScheduledPostingsUseCase() error
+}
+
+type PublishScheduledPostings struct {
+	tx              mysql.DBTransaction
+	postingRepo     *repository.PostingRepository
+	userCounterRepo *repository.UserCounterRepository
+}
+
+func NewPublishScheduledPostings(tx mysql.DBTransaction, postingRepo *repository.PostingRepository, userCounterRepo *repository.UserCounterRepository) *PublishScheduledPostings {
+	return &PublishScheduledPostings{
+		tx:              tx,
+		postingRepo:     postingRepo,
+		userCounterRepo: userCounterRepo,
+	}
+}
+
+// PublishScheduledPostingsUseCase marks the scheduled postings whose publish_at has come as published and counts them in the counters of their users.
+// They are shown to everyone as soon as publish_at comes, so only the posting counts lag behind until this runs.
+func (s *PublishScheduledPostings) PublishScheduledPostingsUseCase(ctx context.Context) error {
+	now := lib.NowFunc()
+	for {
+		postings, err := s.postingRepo.GetDueScheduled(ctx, now, scheduledPostingsPublishBatchSize)
+		if err != nil {
+			return err
+		}
+		for _, p := range postings {
+			err = s.tx.Do(ctx, func(ctx context.Context) error {
+				published, err := s.postingRepo.UpdatePublishedWhereID(ctx, p.ID)
+				if err != nil {
+					return err
+				}
+				// deleted or published by another run in the meantime
+				if !published {
+					return nil
+				}
+				return s.userCounterRepo.Add(ctx, &model.UserCounter{UserID: p.UserID, PostingCount: 1})
+			})
+			if err != nil {
+				return err
+			}
+		}
+		if len(postings) < scheduledPostingsPublishBatchSize {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+)
+
+const (
+	SearchTypePosting = "posting"
+	SearchTypeUser    = "user"
+)
+
+type SearchUseCaseInterface interface {
+	SearchUseCase() ([]model.Posting, []model.User, error)
+}
+
+type Search struct {
+	tx                 mysql.DBTransaction
+	tokenUserName      string
+	query              string
+	searchType         string
+	limit              int8
+	offset             int
+	userRepo           *repository.UserRepository
+	postingRepo        *repository.PostingRepository
+	likeRepo           *repository.LikeRepository
+	postingCounterRepo *repository.PostingCounterRepository
+	postingImageRepo   *repository.PostingImageRepository
+}
+
+func NewSearch(tx mysql.DBTransaction, tokenUserName string, query string, searchType string, limit int8, offset int, userRepo *repository.UserRepository, postingRepo *repository.PostingRepository, likeRepo *repository.LikeRepository, postingCounterRepo *repository.PostingCounterRepository, postingImageRepo *repository.PostingImageRepository) *Search {
+	return &Search{
+		tx:                 tx,
+		tokenUserName:      tokenUserName,
+		query:              query,
+		searchType:         searchType,
+		limit:              limit,
+		offset:             offset,
+		userRepo:           userRepo,
+		postingRepo:        postingRepo,
+		likeRepo:           likeRepo,
+		postingCounterRepo: postingCounterRepo,
+		postingImageRepo:   postingImageRepo,
+	}
+}
+
+// SearchUseCase searches postings and users. An empty searchType means both.
+func (s *Search) SearchUseCase(ctx context.Context) (postings []model.Posting, userNames []string, likedCounts []int64, likes []model.Like, users []model.User, err error) {
+	// check userName in token exists
+	tokenUser, err := s.userRepo.GetUserWhereName(ctx, s.tokenUserName)
+	if err != nil {
+		if err == repository.ErrNotExistsData {
+			err = ErrTokenInvalidNotExistingUserName
+			return
+		}
+		return
+	}
+
+	if s.searchType == "" || s.searchType == SearchTypePosting {
+		likes, err = s.likeRepo.GetWhereUserID(ctx, tokenUser.ID)
+		if err != nil {
+			if err == repository.ErrNotExistsData {
+				// not error
+				err = nil
+			}
+			return
+		}
+
+		postings, err = s.postingRepo.Search(ctx, s.query, s.limit, s.offset)
+		if err != nil {
+			return
+		}
+
+		userNames, likedCounts, err = fillPostings(ctx, s.userRepo, s.postingCounterRepo, s.postingImageRepo, postings)
+		if err != nil {
+			return
+		}
+	}
+
+	if s.searchType == "" || s.searchType == SearchTypeUser {
+		users, err = s.userRepo.Search(ctx, s.query, s.limit, s.offset)
+		if err != nil {
+			return
+		}
+	}
+	return
+}
@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"log"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/aws"
+	"github.com/gold-kou/ToeBeans/backend/app/lib"
+)
+
+const signedURLExpiresDefault = 15 * time.Minute
+
+// URLs are signed at the start of the window they are requested in, so that the same object gets the same URL
+// within a window and browsers can reuse their cache. They are valid for signedURLExpires after the window ends.
+const signedURLWindowsPerExpires = 3
+
+var signedURLExpires time.Duration
+
+func init() {
+	m, e := strconv.Atoi(os.Getenv("SIGNED_URL_EXPIRES_MINUTE"))
+	if e != nil || m <= 0 {
+		signedURLExpires = signedURLExpiresDefault
+	} else {
+		signedURLExpires = time.Duration(m) * time.Minute
+	}
+}
+
+// SignPostingImageURL returns a short-lived URL to get the posting image stored at rawURL.
+// Buckets are private, so the stored URLs must never be sent to clients as they are.
+func SignPostingImageURL(rawURL string) string {
+	return signObjectURL(bucketPosting, rawURL)
+}
+
+// SignIconURL returns a short-lived URL to get the icon stored at rawURL.
+func SignIconURL(rawURL string) string {
+	return signObjectURL(bucketIcons, rawURL)
+}
+
+// SignDraftImageKey returns a short-lived URL to get the image of a draft, which is kept as it was uploaded.
+func SignDraftImageKey(key string) string {
+	signedAt, expires := signingWindow()
+	signed, err := aws.PresignGetObject(bucketPosting, key, signedAt, expires)
+	if err != nil {
+		log.Println(err)
+		return ""
+	}
+	return signed
+}
+
+func signObjectURL(bucket, rawURL string) string {
+	// values which don't point to an object (e.g. the unset icon) are returned as they are
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return rawURL
+	}
+	signedAt, expires := signingWindow()
+	signed, err := aws.PresignGetObject(bucket, objectKeyFromURL(rawURL, bucket), signedAt, expires)
+	if err != nil {
+		log.Println(err)
+		return ""
+	}
+	return signed
+}
+
+// signingWindow returns when the current window started and how long a URL signed then must be valid for.
+func signingWindow() (signedAt time.Time, expires time.Duration) {
+	window := signedURLExpires / signedURLWindowsPerExpires
+	signedAt = lib.NowFunc().Truncate(window)
+	return signedAt, signedURLExpires + window
+}
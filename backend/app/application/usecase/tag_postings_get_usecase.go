@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+	"github.com/gold-kou/ToeBeans/backend/app/lib/hashtag"
+)
+
+type GetTagPostingsUseCaseInterface interface {
+	GetTagPostingsUseCase() ([]model.Posting, error)
+}
+
+type GetTagPostings struct {
+	tx                 mysql.DBTransaction
+	tokenUserName      string
+	tag                string
+	cursor             model.Cursor
+	limit              int8
+	userRepo           *repository.UserRepository
+	postingRepo        *repository.PostingRepository
+	likeRepo           *repository.LikeRepository
+	postingCounterRepo *repository.PostingCounterRepository
+	postingImageRepo   *repository.PostingImageRepository
+	tagRepo            *repository.TagRepository
+}
+
+func NewGetTagPostings(tx mysql.DBTransaction, tokenUserName string, tag string, cursor model.Cursor, limit int8, userRepo *repository.UserRepository, postingRepo *repository.PostingRepository, likeRepo *repository.LikeRepository, postingCounterRepo *repository.PostingCounterRepository, postingImageRepo *repository.PostingImageRepository, tagRepo *repository.TagRepository) *GetTagPostings {
+	return &GetTagPostings{
+		tx:                 tx,
+		tokenUserName:      tokenUserName,
+		tag:                tag,
+		cursor:             cursor,
+		limit:              limit,
+		userRepo:           userRepo,
+		postingRepo:        postingRepo,
+		likeRepo:           likeRepo,
+		postingCounterRepo: postingCounterRepo,
+		postingImageRepo:   postingImageRepo,
+		tagRepo:            tagRepo,
+	}
+}
+
+func (p *GetTagPostings) GetTagPostingsUseCase(ctx context.Context) (postings []model.Posting, userNames []string, likedCounts []int64, likes []model.Like, err error) {
+	// check userName in token exists
+	tokenUser, err := p.userRepo.GetUserWhereName(ctx, p.tokenUserName)
+	if err != nil {
+		if err == repository.ErrNotExistsData {
+			err = ErrTokenInvalidNotExistingUserName
+			return
+		}
+		return
+	}
+
+	// a tag nobody has used yet simply has no postings
+	tag, err := p.tagRepo.GetWhereName(ctx, hashtag.Normalize(p.tag))
+	if err != nil {
+		if err == repository.ErrNotExistsData {
+			err = nil
+		}
+		return
+	}
+
+	likes, err = p.likeRepo.GetWhereUserID(ctx, tokenUser.ID)
+	if err != nil {
+		if err == repository.ErrNotExistsData {
+			// not error
+			err = nil
+		}
+		return
+	}
+
+	postings, err = p.postingRepo.GetPostingsWhereTagID(ctx, p.cursor, p.limit, tag.ID)
+	if err != nil {
+		return
+	}
+
+	userNames, likedCounts, err = fillPostings(ctx, p.userRepo, p.postingCounterRepo, p.postingImageRepo, postings)
+	return
+}
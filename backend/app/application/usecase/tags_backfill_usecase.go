@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+	"github.com/gold-kou/ToeBeans/backend/app/lib/hashtag"
+)
+
+const tagBackfillBatchSize = 500
+
+type BackfillTagsUseCaseInterface interface {
+	BackfillTagsUseCase() (int, error)
+}
+
+type BackfillTags struct {
+	tx             mysql.DBTransaction
+	postingRepo    *repository.PostingRepository
+	tagRepo        *repository.TagRepository
+	postingTagRepo *repository.PostingTagRepository
+}
+
+func NewBackfillTags(tx mysql.DBTransaction, postingRepo *repository.PostingRepository, tagRepo *repository.TagRepository, postingTagRepo *repository.PostingTagRepository) *BackfillTags {
+	return &BackfillTags{
+		tx:             tx,
+		postingRepo:    postingRepo,
+		tagRepo:        tagRepo,
+		postingTagRepo: postingTagRepo,
+	}
+}
+
+// BackfillTagsUseCase links the hashtags in the titles of the postings which have no tags linked and returns how many postings got tags.
+// The links are dated when the postings were created, so that the old postings don't show up in the trending tags.
+// Each posting is tagged in its own transaction, so it can be run again after a failure.
+func (b *BackfillTags) BackfillTagsUseCase(ctx context.Context) (tagged int, err error) {
+	var lastID int64
+	for {
+		var postings []model.Posting
+		postings, err = b.postingRepo.GetUntaggedAfterID(ctx, lastID, tagBackfillBatchSize)
+		if err != nil {
+			return
+		}
+		for _, p := range postings {
+			names := hashtag.Extract(p.Title)
+			if len(names) == 0 {
+				continue
+			}
+			err = b.tx.Do(ctx, func(ctx context.Context) error {
+				for _, name := range names {
+					t := model.Tag{Name: name}
+					if err := b.tagRepo.Upsert(ctx, &t); err != nil {
+						return err
+					}
+					pt := model.PostingTag{
+						PostingID: p.ID,
+						TagID:     t.ID,
+						CreatedAt: p.CreatedAt,
+					}
+					if err := b.postingTagRepo.CreateWithCreatedAt(ctx, &pt); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+			if err != nil {
+				return
+			}
+			tagged++
+		}
+		if len(postings) < tagBackfillBatchSize {
+			return
+		}
+		lastID = postings[len(postings)-1].ID
+	}
+}
@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+	"github.com/gold-kou/ToeBeans/backend/app/lib"
+)
+
+const trendingTagsWindowDefault = 24 * time.Hour
+
+var trendingTagsWindow time.Duration
+
+func init() {
+	w, e := time.ParseDuration(os.Getenv("TRENDING_TAGS_WINDOW_HOUR") + "h")
+	if e != nil || w <= 0 {
+		trendingTagsWindow = trendingTagsWindowDefault
+	} else {
+		trendingTagsWindow = w
+	}
+}
+
+type GetTrendingTagsUseCaseInterface interface {
+	GetTrendingTagsUseCase() ([]model.TagCount, error)
+}
+
+type GetTrendingTags struct {
+	tx            mysql.DBTransaction
+	tokenUserName string
+	limit         int8
+	userRepo      *repository.UserRepository
+	tagRepo       *repository.TagRepository
+}
+
+func NewGetTrendingTags(tx mysql.DBTransaction, tokenUserName string, limit int8, userRepo *repository.UserRepository, tagRepo *repository.TagRepository) *GetTrendingTags {
+	return &GetTrendingTags{
+		tx:            tx,
+		tokenUserName: tokenUserName,
+		limit:         limit,
+		userRepo:      userRepo,
+		tagRepo:       tagRepo,
+	}
+}
+
+// GetTrendingTagsUseCase counts how many postings used each tag within the last TRENDING_TAGS_WINDOW_HOUR hours.
+func (t *GetTrendingTags) GetTrendingTagsUseCase(ctx context.Context) (tagCounts []model.TagCount, err error) {
+	// check userName in token exists
+	_, err = t.userRepo.GetUserWhereName(ctx, t.tokenUserName)
+	if err != nil {
+		if err == repository.ErrNotExistsData {
+			err = ErrTokenInvalidNotExistingUserName
+			return
+		}
+		return
+	}
+
+	tagCounts, err = t.tagRepo.GetTrending(ctx, lib.NowFunc().Add(-trendingTagsWindow), t.limit)
+	return
+}
@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+)
+
+type GetTimelineUseCaseInterface interface {
+	GetTimelineUseCase() ([]model.Posting, error)
+}
+
+type GetTimeline struct {
+	tx                mysql.DBTransaction
+	tokenUserName     string
+	cursor            model.Cursor
+	limit             int8
+	userRepo          *repository.UserRepository
+	postingRepo       *repository.PostingRepository
+	postingImageRepo  *repository.PostingImageRepository
+	postingViewBuffer *repository.PostingViewBuffer
+}
+
+func NewGetTimeline(tx mysql.DBTransaction, tokenUserName string, cursor model.Cursor, limit int8, userRepo *repository.UserRepository, postingRepo *repository.PostingRepository, postingImageRepo *repository.PostingImageRepository, postingViewBuffer *repository.PostingViewBuffer) *GetTimeline {
+	return &GetTimeline{
+		tx:                tx,
+		tokenUserName:     tokenUserName,
+		cursor:            cursor,
+		limit:             limit,
+		userRepo:          userRepo,
+		postingRepo:       postingRepo,
+		postingImageRepo:  postingImageRepo,
+		postingViewBuffer: postingViewBuffer,
+	}
+}
+
+// GetTimelineUseCase returns the postings of the users whom the token user follows in the same shape as GetPostingsUseCase.
+func (t *GetTimeline) GetTimelineUseCase(ctx context.Context) (postings []model.Posting, userNames []string, likedCounts []int64, likes []model.Like, err error) {
+	// check userName in token exists
+	tokenUser, err := t.userRepo.GetUserWhereName(ctx, t.tokenUserName)
+	if err != nil {
+		if err == repository.ErrNotExistsData {
+			err = ErrTokenInvalidNotExistingUserName
+			return
+		}
+		return
+	}
+
+	timeline, err := t.postingRepo.GetTimeline(ctx, t.cursor, t.limit, tokenUser.ID)
+	if err != nil {
+		return
+	}
+	for _, tp := range timeline {
+		p := tp.Posting
+		p.Images, err = t.postingImageRepo.GetWherePostingID(ctx, p.ID)
+		if err != nil {
+			return
+		}
+		postings = append(postings, p)
+		userNames = append(userNames, tp.UserName)
+		likedCounts = append(likedCounts, tp.LikedCount)
+		if tp.Liked {
+			likes = append(likes, model.Like{UserID: tokenUser.ID, PostingID: p.ID})
+		}
+	}
+
+	recordPostingViews(t.postingViewBuffer, model.PostingViewKindImpression, tokenUser.ID, postings...)
+	return
+}
@@ -0,0 +1,76 @@
+package usecase
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/aws"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+	modelHTTP "github.com/gold-kou/ToeBeans/backend/app/domain/model/http"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+	"github.com/gold-kou/ToeBeans/backend/app/lib"
+)
+
+// uploaded images are read only by the server, so they are kept apart from the posted images.
+// the ones never posted are removed by the orphaned object collector.
+const uploadKeyPrefix = "uploads/"
+
+const uploadURLExpires = 15 * time.Minute
+
+type RegisterUploadUseCaseInterface interface {
+	RegisterUploadUseCase() (model.Upload, error)
+}
+
+type RegisterUpload struct {
+	tokenUserName     string
+	reqRegisterUpload *modelHTTP.RequestRegisterUpload
+	userRepo          *repository.UserRepository
+}
+
+func NewRegisterUpload(tokenUserName string, reqRegisterUpload *modelHTTP.RequestRegisterUpload, userRepo *repository.UserRepository) *RegisterUpload {
+	return &RegisterUpload{
+		tokenUserName:     tokenUserName,
+		reqRegisterUpload: reqRegisterUpload,
+		userRepo:          userRepo,
+	}
+}
+
+// RegisterUploadUseCase issues a presigned URL to put an image of the requested type and size to the posting bucket.
+func (upload *RegisterUpload) RegisterUploadUseCase(ctx context.Context) (model.Upload, error) {
+	// check userName in token exists
+	u, err := upload.userRepo.GetUserWhereName(ctx, upload.tokenUserName)
+	if err != nil {
+		if err == repository.ErrNotExistsData {
+			return model.Upload{}, ErrTokenInvalidNotExistingUserName
+		}
+		return model.Upload{}, err
+	}
+
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return model.Upload{}, err
+	}
+	key := userUploadKeyPrefix(u.ID) + id.String()
+	url, err := aws.PresignPutObject(bucketPosting, key, upload.reqRegisterUpload.ContentType, upload.reqRegisterUpload.Size, uploadURLExpires)
+	if err != nil {
+		return model.Upload{}, err
+	}
+	return model.Upload{
+		Key:       key,
+		URL:       url,
+		ExpiresAt: lib.NowFunc().Add(uploadURLExpires),
+	}, nil
+}
+
+// userUploadKeyPrefix scopes uploads by user so that nobody can post an image uploaded by another user.
+func userUploadKeyPrefix(userID int64) string {
+	return uploadKeyPrefix + strconv.FormatInt(userID, 10) + "/"
+}
+
+func isUploadKeyOf(key string, userID int64) bool {
+	return strings.HasPrefix(key, userUploadKeyPrefix(userID)) && !strings.Contains(strings.TrimPrefix(key, userUploadKeyPrefix(userID)), "/")
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
 	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+	"github.com/gold-kou/ToeBeans/backend/app/lib/imaging"
 )
 
 type DeleteUserUseCaseInterface interface {
@@ -14,26 +15,50 @@ type DeleteUserUseCaseInterface interface {
 }
 
 type DeleteUser struct {
-	tx                mysql.DBTransaction
-	userName          string
-	userRepo          *repository.UserRepository
-	passwordResetRepo *repository.PasswordResetRepository
-	postingRepo       *repository.PostingRepository
-	likeRepo          *repository.LikeRepository
-	commentRepo       *repository.CommentRepository
-	followRepo        *repository.FollowRepository
+	tx                    mysql.DBTransaction
+	userName              string
+	userRepo              *repository.UserRepository
+	passwordResetRepo     *repository.PasswordResetRepository
+	postingRepo           *repository.PostingRepository
+	likeRepo              *repository.LikeRepository
+	commentRepo           *repository.CommentRepository
+	followRepo            *repository.FollowRepository
+	postingImageRepo      *repository.PostingImageRepository
+	postingTagRepo        *repository.PostingTagRepository
+	catRepo               *repository.CatRepository
+	postingCatRepo        *repository.PostingCatRepository
+	bookmarkRepo          *repository.BookmarkRepository
+	collectionRepo        *repository.CollectionRepository
+	collectionPostingRepo *repository.CollectionPostingRepository
+	postingViewRepo       *repository.PostingViewRepository
+	draftRepo             *repository.DraftRepository
+	postingCounterRepo    *repository.PostingCounterRepository
+	userCounterRepo       *repository.UserCounterRepository
+	objectDeletionRepo    *repository.ObjectDeletionRepository
 }
 
-func NewDeleteUser(tx mysql.DBTransaction, userName string, userRepo *repository.UserRepository, passwordResetRepo *repository.PasswordResetRepository, postingRepo *repository.PostingRepository, likeRepo *repository.LikeRepository, commentRepo *repository.CommentRepository, followRepo *repository.FollowRepository) *DeleteUser {
+func NewDeleteUser(tx mysql.DBTransaction, userName string, userRepo *repository.UserRepository, passwordResetRepo *repository.PasswordResetRepository, postingRepo *repository.PostingRepository, likeRepo *repository.LikeRepository, commentRepo *repository.CommentRepository, followRepo *repository.FollowRepository, postingImageRepo *repository.PostingImageRepository, postingTagRepo *repository.PostingTagRepository, catRepo *repository.CatRepository, postingCatRepo *repository.PostingCatRepository, bookmarkRepo *repository.BookmarkRepository, collectionRepo *repository.CollectionRepository, collectionPostingRepo *repository.CollectionPostingRepository, postingViewRepo *repository.PostingViewRepository, draftRepo *repository.DraftRepository, postingCounterRepo *repository.PostingCounterRepository, userCounterRepo *repository.UserCounterRepository, objectDeletionRepo *repository.ObjectDeletionRepository) *DeleteUser {
 	return &DeleteUser{
-		tx:                tx,
-		userName:          userName,
-		userRepo:          userRepo,
-		passwordResetRepo: passwordResetRepo,
-		postingRepo:       postingRepo,
-		likeRepo:          likeRepo,
-		commentRepo:       commentRepo,
-		followRepo:        followRepo,
+		tx:                    tx,
+		userName:              userName,
+		userRepo:              userRepo,
+		passwordResetRepo:     passwordResetRepo,
+		postingRepo:           postingRepo,
+		likeRepo:              likeRepo,
+		commentRepo:           commentRepo,
+		followRepo:            followRepo,
+		postingImageRepo:      postingImageRepo,
+		postingTagRepo:        postingTagRepo,
+		catRepo:               catRepo,
+		postingCatRepo:        postingCatRepo,
+		bookmarkRepo:          bookmarkRepo,
+		collectionRepo:        collectionRepo,
+		collectionPostingRepo: collectionPostingRepo,
+		postingViewRepo:       postingViewRepo,
+		draftRepo:             draftRepo,
+		postingCounterRepo:    postingCounterRepo,
+		userCounterRepo:       userCounterRepo,
+		objectDeletionRepo:    objectDeletionRepo,
 	}
 }
 
@@ -47,9 +72,67 @@ func (user *DeleteUser) DeleteUserUseCase(ctx context.Context) error {
 		return err
 	}
 
+	images, err := user.postingImageRepo.GetWhereUserID(ctx, u.ID)
+	if err != nil {
+		return err
+	}
+
+	cats, err := user.catRepo.GetWhereUserID(ctx, u.ID)
+	if err != nil {
+		return err
+	}
+
+	drafts, err := user.draftRepo.GetWhereUserID(ctx, u.ID)
+	if err != nil {
+		return err
+	}
+
+	// the counters of others change as the likes, the comments and the follows of the user are deleted at once
+	reactedPostingIDs, err := user.postingCounterRepo.GetPostingIDsReactedByUserID(ctx, u.ID)
+	if err != nil {
+		return err
+	}
+	relatedUserIDs, err := user.userCounterRepo.GetUserIDsRelatedToUserID(ctx, u.ID)
+	if err != nil {
+		return err
+	}
+
+	var deletions []model.ObjectDeletion
 	err = user.tx.Do(ctx, func(ctx context.Context) error {
 		// TODO notification delete
 
+		for _, image := range images {
+			d, err := enqueueObjectDeletions(ctx, user.objectDeletionRepo, bucketPosting, imageObjectKeys(image.ObjectKey, imaging.PostingVariants))
+			if err != nil {
+				return err
+			}
+			deletions = append(deletions, d...)
+		}
+		if u.Icon != iconUnset {
+			d, err := enqueueObjectDeletions(ctx, user.objectDeletionRepo, bucketIcons, []string{objectKeyFromURL(u.Icon, bucketIcons)})
+			if err != nil {
+				return err
+			}
+			deletions = append(deletions, d...)
+		}
+		for _, c := range cats {
+			if c.Icon == iconUnset {
+				continue
+			}
+			d, err := enqueueObjectDeletions(ctx, user.objectDeletionRepo, bucketIcons, []string{objectKeyFromURL(c.Icon, bucketIcons)})
+			if err != nil {
+				return err
+			}
+			deletions = append(deletions, d...)
+		}
+		for _, dr := range drafts {
+			d, err := enqueueObjectDeletions(ctx, user.objectDeletionRepo, bucketPosting, []string{dr.ObjectKey})
+			if err != nil {
+				return err
+			}
+			deletions = append(deletions, d...)
+		}
+
 		err = user.likeRepo.DeleteWhereUserID(ctx, u.ID)
 		if err != nil {
 			return err
@@ -76,6 +159,79 @@ func (user *DeleteUser) DeleteUserUseCase(ctx context.Context) error {
 			return err
 		}
 
+		err = user.postingImageRepo.DeleteWhereInPostingIDs(ctx, u.ID)
+		if err != nil {
+			return err
+		}
+
+		err = user.postingTagRepo.DeleteWhereInPostingIDs(ctx, u.ID)
+		if err != nil {
+			return err
+		}
+
+		err = user.postingCatRepo.DeleteWhereInPostingIDs(ctx, u.ID)
+		if err != nil {
+			return err
+		}
+
+		err = user.catRepo.DeleteWhereUserID(ctx, u.ID)
+		if err != nil {
+			return err
+		}
+
+		err = user.bookmarkRepo.DeleteWhereUserID(ctx, u.ID)
+		if err != nil {
+			return err
+		}
+
+		// 削除対象ユーザの投稿に対するブックマークを削除する
+		err = user.bookmarkRepo.DeleteWhereInPostingIDs(ctx, u.ID)
+		if err != nil {
+			return err
+		}
+
+		err = user.collectionPostingRepo.DeleteWhereInCollectionIDs(ctx, u.ID)
+		if err != nil {
+			return err
+		}
+
+		// 削除対象ユーザの投稿を他のユーザのコレクションからも外す
+		err = user.collectionPostingRepo.DeleteWhereInPostingIDs(ctx, u.ID)
+		if err != nil {
+			return err
+		}
+
+		err = user.collectionRepo.DeleteWhereUserID(ctx, u.ID)
+		if err != nil {
+			return err
+		}
+
+		err = user.postingViewRepo.DeleteWhereUserID(ctx, u.ID)
+		if err != nil {
+			return err
+		}
+
+		// 削除対象ユーザの投稿の閲覧記録を削除する
+		err = user.postingViewRepo.DeleteWhereInPostingIDs(ctx, u.ID)
+		if err != nil {
+			return err
+		}
+
+		err = user.draftRepo.DeleteWhereUserID(ctx, u.ID)
+		if err != nil {
+			return err
+		}
+
+		err = user.postingCounterRepo.DeleteWhereInPostingIDs(ctx, u.ID)
+		if err != nil {
+			return err
+		}
+
+		err = user.userCounterRepo.DeleteWhereUserID(ctx, u.ID)
+		if err != nil {
+			return err
+		}
+
 		err = user.postingRepo.DeleteWhereUserID(ctx, u.ID)
 		if err != nil {
 			return err
@@ -90,10 +246,24 @@ func (user *DeleteUser) DeleteUserUseCase(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
+
+		// the postings and the user deleted above are not recounted
+		err = user.postingCounterRepo.Recount(ctx, reactedPostingIDs)
+		if err != nil {
+			return err
+		}
+
+		err = user.userCounterRepo.Recount(ctx, relatedUserIDs)
+		if err != nil {
+			return err
+		}
 		return nil
 	})
 	if err != nil {
 		return err
 	}
+
+	// the photos and the icons must not stay public once the user is gone
+	deleteObjects(ctx, user.tx, user.objectDeletionRepo, deletions)
 	return nil
 }
@@ -13,30 +13,30 @@ type GetUserUseCaseInterface interface {
 }
 
 type GetUser struct {
-	tx             mysql.DBTransaction
-	tokenUserName  string
-	targetUserName string
-	userRepo       *repository.UserRepository
-	postingRepo    *repository.PostingRepository
-	likeRepo       *repository.LikeRepository
-	followRepo     *repository.FollowRepository
+	tx              mysql.DBTransaction
+	tokenUserName   string
+	targetUserName  string
+	userRepo        *repository.UserRepository
+	userCounterRepo *repository.UserCounterRepository
+	catRepo         *repository.CatRepository
+	collectionRepo  *repository.CollectionRepository
 }
 
-func NewGetUser(tx mysql.DBTransaction, tokenUserName, targetUserName string, userRepo *repository.UserRepository, postingRepo *repository.PostingRepository, likeRepo *repository.LikeRepository, followRepo *repository.FollowRepository) *GetUser {
+func NewGetUser(tx mysql.DBTransaction, tokenUserName, targetUserName string, userRepo *repository.UserRepository, userCounterRepo *repository.UserCounterRepository, catRepo *repository.CatRepository, collectionRepo *repository.CollectionRepository) *GetUser {
 	return &GetUser{
-		tx:             tx,
-		tokenUserName:  tokenUserName,
-		targetUserName: targetUserName,
-		userRepo:       userRepo,
-		postingRepo:    postingRepo,
-		likeRepo:       likeRepo,
-		followRepo:     followRepo,
+		tx:              tx,
+		tokenUserName:   tokenUserName,
+		targetUserName:  targetUserName,
+		userRepo:        userRepo,
+		userCounterRepo: userCounterRepo,
+		catRepo:         catRepo,
+		collectionRepo:  collectionRepo,
 	}
 }
 
-func (user *GetUser) GetUserUseCase(ctx context.Context) (u model.User, postingCount, likeCount, likedCount, followCount, followedCount int64, err error) {
+func (user *GetUser) GetUserUseCase(ctx context.Context) (u model.User, postingCount, likeCount, likedCount, followCount, followedCount int64, cats []model.Cat, collections []model.Collection, err error) {
 	// check userName in token exists
-	_, err = user.userRepo.GetUserWhereName(ctx, user.tokenUserName)
+	tokenUser, err := user.userRepo.GetUserWhereName(ctx, user.tokenUserName)
 	if err != nil {
 		if err == repository.ErrNotExistsData {
 			err = ErrTokenInvalidNotExistingUserName
@@ -52,27 +52,27 @@ func (user *GetUser) GetUserUseCase(ctx context.Context) (u model.User, postingC
 		return
 	}
 
-	postingCount, err = user.postingRepo.GetCountWhereUserID(ctx, u.ID)
+	counter, err := user.userCounterRepo.GetWhereUserID(ctx, u.ID)
 	if err != nil {
 		return
 	}
+	postingCount = counter.PostingCount
+	likeCount = counter.LikeCount
+	likedCount = counter.LikedCount
+	followCount = counter.FollowCount
+	followedCount = counter.FollowedCount
 
-	likeCount, err = user.likeRepo.GetLikeCountWhereUserID(ctx, u.ID)
+	cats, err = user.catRepo.GetWhereUserID(ctx, u.ID)
 	if err != nil {
 		return
 	}
 
-	likedCount, err = user.likeRepo.GetLikedCountWhereUserID(ctx, u.ID)
-	if err != nil {
-		return
+	// the private collections are shown only to the owner
+	if tokenUser.ID == u.ID {
+		collections, err = user.collectionRepo.GetWhereUserID(ctx, u.ID)
+	} else {
+		collections, err = user.collectionRepo.GetPublicWhereUserID(ctx, u.ID)
 	}
-
-	followCount, err = user.followRepo.GetFollowCountWhereUserID(ctx, u.ID)
-	if err != nil {
-		return
-	}
-
-	followedCount, err = user.followRepo.GetFollowedCountWhereUserID(ctx, u.ID)
 	if err != nil {
 		return
 	}
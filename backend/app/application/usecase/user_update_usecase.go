@@ -1,14 +1,16 @@
 package usecase
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/gold-kou/ToeBeans/backend/app"
 	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
-	"github.com/google/uuid"
+	"github.com/gold-kou/ToeBeans/backend/app/lib/imaging"
 
 	"golang.org/x/crypto/bcrypt"
 
@@ -18,6 +20,9 @@ import (
 	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
 )
 
+// default of users.icon until the user sets one
+const iconUnset = "UNKNOWN"
+
 var bucketIcons string
 
 func init() {
@@ -32,24 +37,26 @@ type UpdateUserUseCaseInterface interface {
 }
 
 type UpdateUser struct {
-	tx            mysql.DBTransaction
-	userName      string
-	reqUpdateUser *modelHTTP.RequestUpdateUser
-	userRepo      *repository.UserRepository
+	tx                 mysql.DBTransaction
+	userName           string
+	reqUpdateUser      *modelHTTP.RequestUpdateUser
+	userRepo           *repository.UserRepository
+	objectDeletionRepo *repository.ObjectDeletionRepository
 }
 
-func NewUpdateUser(tx mysql.DBTransaction, userName string, reqUpdateUser *modelHTTP.RequestUpdateUser, userRepo *repository.UserRepository) *UpdateUser {
+func NewUpdateUser(tx mysql.DBTransaction, userName string, reqUpdateUser *modelHTTP.RequestUpdateUser, userRepo *repository.UserRepository, objectDeletionRepo *repository.ObjectDeletionRepository) *UpdateUser {
 	return &UpdateUser{
-		tx:            tx,
-		userName:      userName,
-		reqUpdateUser: reqUpdateUser,
-		userRepo:      userRepo,
+		tx:                 tx,
+		userName:           userName,
+		reqUpdateUser:      reqUpdateUser,
+		userRepo:           userRepo,
+		objectDeletionRepo: objectDeletionRepo,
 	}
 }
 
 func (user *UpdateUser) UpdateUserUseCase(ctx context.Context) error {
 	// check user exists
-	_, err := user.userRepo.GetUserWhereName(ctx, user.userName)
+	u, err := user.userRepo.GetUserWhereName(ctx, user.userName)
 	if err != nil {
 		if err == repository.ErrNotExistsData {
 			return ErrNotExitsUser
@@ -70,51 +77,32 @@ func (user *UpdateUser) UpdateUserUseCase(ctx context.Context) error {
 	}
 	// the case of icon
 	if user.reqUpdateUser.Icon != "" {
-		// base64 decode
-		decodedImg, err := base64.StdEncoding.DecodeString(user.reqUpdateUser.Icon)
-		if err != nil {
-			return ErrDecodeImage
-		}
-
-		// save decoded file
-		u, err := uuid.NewRandom()
+		location, key, err := uploadIcon(strconv.FormatInt(u.ID, 10), user.reqUpdateUser.Icon)
 		if err != nil {
 			return err
 		}
-		filePath := "image" + u.String() + ".jpg"
-		file, err := os.Create(filePath)
-		if err != nil {
-			return err
-		}
-		defer file.Close()
-		if _, err := file.Write(decodedImg); err != nil {
-			return err
-		}
-		if err := file.Sync(); err != nil {
-			return err
-		}
-		// delete file
-		defer func() {
-			_ = os.Remove(filePath)
-		}()
 
-		savedFile, err := os.Open(filePath)
-		if err != nil {
-			return err
-		}
-		defer savedFile.Close()
-		o, err := aws.UploadObject(bucketIcons, user.userName, savedFile)
-		if err != nil {
+		// the old icon is no longer referred to once the new one is committed
+		var deletions []model.ObjectDeletion
+		err = user.tx.Do(ctx, func(ctx context.Context) error {
+			err := user.userRepo.UpdateIconWhereName(ctx, location, user.userName)
+			if err != nil {
+				return err
+			}
+			if u.Icon == iconUnset {
+				return nil
+			}
+			oldKey := objectKeyFromURL(u.Icon, bucketIcons)
+			if oldKey == key {
+				return nil
+			}
+			deletions, err = enqueueObjectDeletions(ctx, user.objectDeletionRepo, bucketIcons, []string{oldKey})
 			return err
-		}
-
-		if app.IsLocal() {
-			o.Location = strings.Replace(o.Location, "minio", "localhost", 1)
-		}
-		err = user.userRepo.UpdateIconWhereName(ctx, o.Location, user.userName)
+		})
 		if err != nil {
 			return err
 		}
+		deleteObjects(ctx, user.tx, user.objectDeletionRepo, deletions)
 	}
 	// the case of self introduction
 	if user.reqUpdateUser.SelfIntroduction != "" {
@@ -125,3 +113,32 @@ func (user *UpdateUser) UpdateUserUseCase(ctx context.Context) error {
 	}
 	return nil
 }
+
+// uploadIcon decodes the base64 encoded icon, strips its metadata, shrinks it
+// and puts it to the icons bucket under a key derived from the content under the prefix.
+func uploadIcon(prefix, encodedIcon string) (location, key string, err error) {
+	decodedImg, err := base64.StdEncoding.DecodeString(encodedIcon)
+	if err != nil {
+		return "", "", ErrDecodeImage
+	}
+
+	processed, err := imaging.Process(bytes.NewReader(decodedImg), imaging.IconVariants)
+	if err != nil {
+		if err == imaging.ErrUnsupportedFormat || err == imaging.ErrTooManyPixels {
+			return "", "", ErrDecodeImage
+		}
+		return "", "", err
+	}
+	icon := processed[0]
+	key = imaging.VariantKey(imaging.ContentKey(prefix, icon.Data), icon.Variant)
+	o, err := aws.UploadObject(bucketIcons, key, bytes.NewReader(icon.Data), imaging.ContentType, imaging.CacheControl)
+	if err != nil {
+		return "", "", err
+	}
+
+	location = o.Location
+	if app.IsLocal() {
+		location = strings.Replace(location, "minio", "localhost", 1)
+	}
+	return location, key, nil
+}
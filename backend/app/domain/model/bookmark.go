@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+type Bookmark struct {
+	ID        int64
+	UserID    int64
+	PostingID int64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// BookmarkedPosting is a posting in the bookmarks with when it was bookmarked, which decides the order of the bookmarks.
+type BookmarkedPosting struct {
+	Posting
+	BookmarkID   int64
+	BookmarkedAt time.Time
+}
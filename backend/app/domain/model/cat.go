@@ -0,0 +1,24 @@
+package model
+
+import "time"
+
+type Cat struct {
+	ID     int64
+	UserID int64
+	Name   string
+	Breed  string
+	// nil when the owner doesn't know it
+	Birthday  *time.Time
+	Icon      string
+	Bio       string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type PostingCat struct {
+	ID        int64
+	PostingID int64
+	CatID     int64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
@@ -0,0 +1,21 @@
+package model
+
+import "time"
+
+type Collection struct {
+	ID        int64
+	UserID    int64
+	Name      string
+	IsPublic  bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type CollectionPosting struct {
+	ID           int64
+	CollectionID int64
+	PostingID    int64
+	Position     int
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
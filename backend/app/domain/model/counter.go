@@ -0,0 +1,27 @@
+package model
+
+import "time"
+
+// PostingCounter is the numbers of the likes and the comments of the posting.
+type PostingCounter struct {
+	ID           int64
+	PostingID    int64
+	LikedCount   int64
+	CommentCount int64
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// UserCounter is the numbers of the postings, the likes and the follows of the user.
+// PostingCount includes the scheduled postings not published yet.
+type UserCounter struct {
+	ID            int64
+	UserID        int64
+	PostingCount  int64
+	LikeCount     int64
+	LikedCount    int64
+	FollowCount   int64
+	FollowedCount int64
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
@@ -0,0 +1,14 @@
+package model
+
+import "time"
+
+// Cursor points at the last item of a page. Lists are ordered by (created_at, id), and the next page starts right after the cursor.
+type Cursor struct {
+	CreatedAt time.Time
+	ID        int64
+}
+
+// IsZero reports whether the cursor points at nothing, which means the first page.
+func (c Cursor) IsZero() bool {
+	return c.CreatedAt.IsZero() && c.ID == 0
+}
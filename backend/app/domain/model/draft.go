@@ -0,0 +1,17 @@
+package model
+
+import "time"
+
+// Draft is an uploaded image with a title which only the owner can see until it is published as a posting.
+type Draft struct {
+	ID     int64
+	UserID int64
+	// may be empty until the draft is published
+	Title string
+	// built from the labels of the image when published without it
+	AltText string
+	// the key returned by POST /uploads
+	ObjectKey string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
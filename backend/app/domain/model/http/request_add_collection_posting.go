@@ -0,0 +1,5 @@
+package http
+
+type RequestAddCollectionPosting struct {
+	PostingID int64 `json:"posting_id"`
+}
@@ -0,0 +1,10 @@
+package http
+
+type RequestRegisterCat struct {
+	Name  string `json:"name"`
+	Breed string `json:"breed,omitempty"`
+	// YYYY-MM-DD
+	Birthday string `json:"birthday,omitempty"`
+	Icon     string `json:"icon,omitempty"`
+	Bio      string `json:"bio,omitempty"`
+}
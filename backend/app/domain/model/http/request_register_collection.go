@@ -0,0 +1,6 @@
+package http
+
+type RequestRegisterCollection struct {
+	Name     string `json:"name"`
+	IsPublic bool   `json:"is_public"`
+}
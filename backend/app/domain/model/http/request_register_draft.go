@@ -0,0 +1,9 @@
+package http
+
+type RequestRegisterDraft struct {
+	// the key returned by POST /uploads
+	UploadKey string `json:"upload_key"`
+	// may be empty until the draft is published
+	Title   string `json:"title,omitempty"`
+	AltText string `json:"alt_text,omitempty"`
+}
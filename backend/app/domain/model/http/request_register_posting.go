@@ -1,6 +1,17 @@
 package http
 
+import "time"
+
 type RequestRegisterPosting struct {
-	Title string `json:"title"`
-	Image string `json:"image"`
+	Title  string   `json:"title"`
+	Image  string   `json:"image,omitempty"`
+	Images []string `json:"images,omitempty"`
+	// read by screen readers. built from the labels of the cover image when empty
+	AltText string `json:"alt_text,omitempty"`
+	// keys returned by POST /uploads, used instead of image and images
+	UploadKeys []string `json:"upload_keys,omitempty"`
+	// publishes the posting at the time instead of now
+	PublishAt *time.Time `json:"publish_at,omitempty"`
+	// the owner's cats in the images
+	CatIDs []int64 `json:"cat_ids,omitempty"`
 }
@@ -0,0 +1,6 @@
+package http
+
+type RequestRegisterUpload struct {
+	ContentType string `json:"content_type"`
+	Size        int64  `json:"size"`
+}
@@ -0,0 +1,6 @@
+package http
+
+// RequestReorderCollectionPostings lists every posting in the collection in the new order.
+type RequestReorderCollectionPostings struct {
+	PostingIDs []int64 `json:"posting_ids"`
+}
@@ -0,0 +1,11 @@
+package http
+
+// RequestUpdateCat replaces the profile of the cat. Only the icon is left as it is when omitted.
+type RequestUpdateCat struct {
+	Name  string `json:"name"`
+	Breed string `json:"breed,omitempty"`
+	// YYYY-MM-DD
+	Birthday string `json:"birthday,omitempty"`
+	Icon     string `json:"icon,omitempty"`
+	Bio      string `json:"bio,omitempty"`
+}
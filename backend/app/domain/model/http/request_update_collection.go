@@ -0,0 +1,7 @@
+package http
+
+// RequestUpdateCollection replaces the name and the visibility of the collection.
+type RequestUpdateCollection struct {
+	Name     string `json:"name"`
+	IsPublic bool   `json:"is_public"`
+}
@@ -0,0 +1,7 @@
+package http
+
+// RequestUpdateDraft replaces the title and the alt text of the draft. The image can't be replaced.
+type RequestUpdateDraft struct {
+	Title   string `json:"title"`
+	AltText string `json:"alt_text"`
+}
@@ -0,0 +1,13 @@
+package http
+
+import "time"
+
+type RequestUpdatePosting struct {
+	Title string `json:"title"`
+	// replaces the alt text. it is left as it is when omitted
+	AltText *string `json:"alt_text,omitempty"`
+	// reschedules the posting which is not published yet
+	PublishAt *time.Time `json:"publish_at,omitempty"`
+	// replaces the cats the posting is tagged with. they are left as they are when omitted, and an empty list removes all
+	CatIDs *[]int64 `json:"cat_ids,omitempty"`
+}
@@ -0,0 +1,16 @@
+package http
+
+import (
+	"time"
+)
+
+type ResponseGetCat struct {
+	CatId     int64     `json:"cat_id"`
+	UserName  string    `json:"user_name"`
+	Name      string    `json:"name"`
+	Breed     string    `json:"breed"`
+	Birthday  string    `json:"birthday,omitempty"`
+	Icon      string    `json:"icon"`
+	Bio       string    `json:"bio"`
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -0,0 +1,13 @@
+package http
+
+import (
+	"time"
+)
+
+type ResponseGetCollection struct {
+	CollectionId int64     `json:"collection_id"`
+	UserName     string    `json:"user_name"`
+	Name         string    `json:"name"`
+	IsPublic     bool      `json:"is_public"`
+	CreatedAt    time.Time `json:"created_at"`
+}
@@ -0,0 +1,6 @@
+package http
+
+type ResponseGetCollectionDetail struct {
+	ResponseGetCollection
+	Postings []ResponseGetPosting `json:"postings"`
+}
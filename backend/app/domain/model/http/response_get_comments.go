@@ -1,6 +1,7 @@
 package http
 
 type ResponseGetComments struct {
-	PostingId int64                `json:"posting_id,omitempty"`
-	Comments  []ResponseGetComment `json:"comments,omitempty"`
+	PostingId  int64                `json:"posting_id,omitempty"`
+	Comments   []ResponseGetComment `json:"comments,omitempty"`
+	NextCursor string               `json:"next_cursor,omitempty"`
 }
@@ -0,0 +1,14 @@
+package http
+
+import (
+	"time"
+)
+
+type ResponseGetDraft struct {
+	DraftId   int64     `json:"draft_id"`
+	Title     string    `json:"title"`
+	AltText   string    `json:"alt_text"`
+	ImageUrl  string    `json:"image_url"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
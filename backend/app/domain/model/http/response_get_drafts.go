@@ -0,0 +1,5 @@
+package http
+
+type ResponseGetDrafts struct {
+	Drafts []ResponseGetDraft `json:"drafts"`
+}
@@ -0,0 +1,12 @@
+package http
+
+import (
+	"time"
+)
+
+type ResponseGetLikeUser struct {
+	UserName string    `json:"user_name"`
+	Icon     string    `json:"icon"`
+	IsFollow bool      `json:"is_follow"`
+	LikedAt  time.Time `json:"liked_at"`
+}
@@ -0,0 +1,7 @@
+package http
+
+type ResponseGetLikeUsers struct {
+	PostingId  int64                 `json:"posting_id,omitempty"`
+	Users      []ResponseGetLikeUser `json:"users,omitempty"`
+	NextCursor string                `json:"next_cursor,omitempty"`
+}
@@ -3,4 +3,5 @@ package http
 type ResponseGetNotifications struct {
 	VisitedName string                    `json:"visited_name,omitempty"`
 	Actions     []ResponseGetNotification `json:"actions,omitempty"`
+	NextCursor  string                    `json:"next_cursor,omitempty"`
 }
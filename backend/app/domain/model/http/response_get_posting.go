@@ -5,11 +5,16 @@ import (
 )
 
 type ResponseGetPosting struct {
-	PostingId  int64     `json:"posting_id"`
-	UserName   string    `json:"user_name"`
-	UploadedAt time.Time `json:"uploaded_at"`
-	Title      string    `json:"title"`
-	ImageUrl   string    `json:"image_url,omitempty"`
-	LikedCount int64     `json:"liked_count"`
-	Liked      bool      `json:"liked"`
+	PostingId  int64                         `json:"posting_id"`
+	UserName   string                        `json:"user_name"`
+	UploadedAt time.Time                     `json:"uploaded_at"`
+	Title      string                        `json:"title"`
+	ImageUrl   string                        `json:"image_url,omitempty"`
+	ImageUrls  ResponseGetPostingImageUrls   `json:"image_urls"`
+	Images     []ResponseGetPostingImageUrls `json:"images"`
+	AltText    string                        `json:"alt_text"`
+	EditedAt   *time.Time                    `json:"edited_at,omitempty"`
+	PublishAt  *time.Time                    `json:"publish_at,omitempty"`
+	LikedCount int64                         `json:"liked_count"`
+	Liked      bool                          `json:"liked"`
 }
@@ -0,0 +1,9 @@
+package http
+
+type ResponseGetPostingDailyStats struct {
+	Date        string `json:"date"`
+	Impressions int64  `json:"impressions"`
+	Views       int64  `json:"views"`
+	Likes       int64  `json:"likes"`
+	Comments    int64  `json:"comments"`
+}
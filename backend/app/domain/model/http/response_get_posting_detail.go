@@ -0,0 +1,10 @@
+package http
+
+type ResponseGetPostingDetail struct {
+	ResponseGetPosting
+	UserIcon           string               `json:"user_icon"`
+	CommentCount       int64                `json:"comment_count"`
+	Comments           []ResponseGetComment `json:"comments"`
+	CommentsNextCursor string               `json:"comments_next_cursor,omitempty"`
+	Cats               []ResponseGetCat     `json:"cats"`
+}
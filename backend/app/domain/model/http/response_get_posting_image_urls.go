@@ -0,0 +1,7 @@
+package http
+
+type ResponseGetPostingImageUrls struct {
+	Thumb string `json:"thumb"`
+	Feed  string `json:"feed"`
+	Full  string `json:"full"`
+}
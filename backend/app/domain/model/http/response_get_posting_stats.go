@@ -0,0 +1,6 @@
+package http
+
+type ResponseGetPostingStats struct {
+	PostingId int64                          `json:"posting_id"`
+	Daily     []ResponseGetPostingDailyStats `json:"daily"`
+}
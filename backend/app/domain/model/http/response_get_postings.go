@@ -2,4 +2,6 @@ package http
 
 type ResponseGetPostings struct {
 	Postings []ResponseGetPosting `json:"postings"`
+	// empty on the last page
+	NextCursor string `json:"next_cursor,omitempty"`
 }
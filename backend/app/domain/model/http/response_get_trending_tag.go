@@ -0,0 +1,6 @@
+package http
+
+type ResponseGetTrendingTag struct {
+	Name  string `json:"name"`
+	Count int64  `json:"count"`
+}
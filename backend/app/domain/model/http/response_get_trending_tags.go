@@ -0,0 +1,5 @@
+package http
+
+type ResponseGetTrendingTags struct {
+	Tags []ResponseGetTrendingTag `json:"tags"`
+}
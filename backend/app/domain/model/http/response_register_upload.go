@@ -0,0 +1,9 @@
+package http
+
+import "time"
+
+type ResponseRegisterUpload struct {
+	UploadKey string    `json:"upload_key"`
+	UploadURL string    `json:"upload_url"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
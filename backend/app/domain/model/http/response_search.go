@@ -0,0 +1,6 @@
+package http
+
+type ResponseSearch struct {
+	Postings []ResponseGetPosting `json:"postings"`
+	Users    []ResponseSearchUser `json:"users"`
+}
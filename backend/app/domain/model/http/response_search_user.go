@@ -0,0 +1,7 @@
+package http
+
+type ResponseSearchUser struct {
+	UserName         string `json:"user_name"`
+	Icon             string `json:"icon"`
+	SelfIntroduction string `json:"self_introduction"`
+}
@@ -1,12 +1,18 @@
 package http
 
 import (
+	"errors"
 	"fmt"
 	"regexp"
+	"strings"
+	"time"
 	"unicode"
 
 	validation "github.com/go-ozzo/ozzo-validation"
 	"github.com/go-ozzo/ozzo-validation/is"
+
+	"github.com/gold-kou/ToeBeans/backend/app/lib"
+	"github.com/gold-kou/ToeBeans/backend/app/lib/hashtag"
 )
 
 const (
@@ -14,6 +20,10 @@ const (
 	MinVarcharLength  = 2
 	MaxVarcharLength  = 255
 	UUIDLength        = 36
+	MaxPostingImages  = 10
+	MaxPostingCats    = 10
+	// reordering sends every posting in a collection at once
+	MaxCollectionPostings = 100
 
 	/* #nosec */
 	errMsgPasswordValidation = "Your password must be at least 8 characters long, contain at least one number and have a mixture of uppercase and lowercase letters"
@@ -50,13 +60,171 @@ func (req *RequestResetPassword) ValidateParam() error {
 	return validation.ValidateStruct(req, fieldRules...)
 }
 
+// postingTitleFormatRules are for a title which is not empty
+var postingTitleFormatRules = []validation.Rule{
+	validation.Length(MinVarcharLength, MaxVarcharLength),
+	// _ is allowed only in hashtags such as #cat_nap
+	validation.NewStringRule(func(s string) bool { return !strings.Contains(hashtag.Remove(s), "_") }, "must not contain _ outside hashtags"),
+}
+
+// postingTitleRules are shared by registering and updating a posting
+var postingTitleRules = append([]validation.Rule{validation.Required}, postingTitleFormatRules...)
+
+// isFuturePublishAt is for publish_at, which is optional
+func isFuturePublishAt(v interface{}) error {
+	t, _ := v.(*time.Time)
+	if t != nil && !t.After(lib.NowFunc()) {
+		return errors.New("must be a future time")
+	}
+	return nil
+}
+
+// postingCatIDsRules are for the cats a posting is tagged with, which are optional
+var postingCatIDsRules = []validation.Rule{
+	validation.Length(0, MaxPostingCats),
+	validation.Each(validation.Min(int64(1))),
+}
+
+func isValidPostingCatIDs(v interface{}) error {
+	catIDs, _ := v.(*[]int64)
+	if catIDs == nil {
+		return nil
+	}
+	return validation.Validate(*catIDs, postingCatIDsRules...)
+}
+
 func (req *RequestRegisterPosting) ValidateParam() error {
 	var fieldRules []*validation.FieldRules
-	fieldRules = append(fieldRules, validation.Field(&req.Title, validation.Required, validation.Length(MinVarcharLength, MaxVarcharLength)),
-		validation.Field(&req.Image, validation.Required))
+	fieldRules = append(fieldRules, validation.Field(&req.Title, postingTitleRules...),
+		validation.Field(&req.AltText, validation.Length(0, MaxVarcharLength)),
+		validation.Field(&req.PublishAt, validation.By(isFuturePublishAt)),
+		validation.Field(&req.CatIDs, postingCatIDsRules...))
+	// image is for a single image posting, images is for a multiple images posting
+	// and upload_keys is for images put to the bucket with presigned URLs
+	switch {
+	case len(req.UploadKeys) > 0:
+		fieldRules = append(fieldRules, validation.Field(&req.Image, validation.NewStringRule(func(s string) bool { return s == "" }, "must be blank when upload_keys is set")),
+			validation.Field(&req.Images, validation.By(func(v interface{}) error {
+				if len(v.([]string)) > 0 {
+					return errors.New("must be blank when upload_keys is set")
+				}
+				return nil
+			})),
+			validation.Field(&req.UploadKeys, validation.Length(1, MaxPostingImages), validation.Each(validation.Required, validation.Length(1, MaxVarcharLength))))
+	case len(req.Images) == 0:
+		fieldRules = append(fieldRules, validation.Field(&req.Image, validation.Required))
+	default:
+		fieldRules = append(fieldRules, validation.Field(&req.Image, validation.NewStringRule(func(s string) bool { return s == "" }, "must be blank when images is set")),
+			validation.Field(&req.Images, validation.Length(1, MaxPostingImages), validation.Each(validation.Required)))
+	}
+	return validation.ValidateStruct(req, fieldRules...)
+}
+
+// ValidateFields validates the fields other than images.
+// It is used by multipart requests whose image is streamed instead of being set to Image.
+func (req *RequestRegisterPosting) ValidateFields() error {
+	var fieldRules []*validation.FieldRules
+	fieldRules = append(fieldRules, validation.Field(&req.Title, postingTitleRules...),
+		validation.Field(&req.AltText, validation.Length(0, MaxVarcharLength)),
+		validation.Field(&req.PublishAt, validation.By(isFuturePublishAt)),
+		validation.Field(&req.CatIDs, postingCatIDsRules...))
+	return validation.ValidateStruct(req, fieldRules...)
+}
+
+func (req *RequestRegisterUpload) ValidateParam() error {
+	var fieldRules []*validation.FieldRules
+	fieldRules = append(fieldRules, validation.Field(&req.ContentType, validation.Required, validation.In("image/jpeg", "image/png", "image/webp")),
+		validation.Field(&req.Size, validation.Required, validation.Min(int64(1))))
 	return validation.ValidateStruct(req, fieldRules...)
 }
 
+func (req *RequestUpdatePosting) ValidateParam() error {
+	var fieldRules []*validation.FieldRules
+	fieldRules = append(fieldRules, validation.Field(&req.Title, postingTitleRules...),
+		validation.Field(&req.AltText, validation.Length(0, MaxVarcharLength)),
+		validation.Field(&req.PublishAt, validation.By(isFuturePublishAt)),
+		validation.Field(&req.CatIDs, validation.By(isValidPostingCatIDs)))
+	return validation.ValidateStruct(req, fieldRules...)
+}
+
+// CatBirthdayLayout is the format of birthday in requests and responses
+const CatBirthdayLayout = "2006-01-02"
+
+// isPastCatBirthday is for birthday, which is optional
+func isPastCatBirthday(v interface{}) error {
+	s, _ := v.(string)
+	if s == "" {
+		return nil
+	}
+	t, err := time.ParseInLocation(CatBirthdayLayout, s, time.Local)
+	if err != nil {
+		return errors.New("must be a valid date as YYYY-MM-DD")
+	}
+	if t.After(lib.NowFunc()) {
+		return errors.New("must not be a future date")
+	}
+	return nil
+}
+
+// catProfileRules returns the rules shared by registering and updating a cat
+func catProfileRules(name, breed, birthday, bio *string) []*validation.FieldRules {
+	return []*validation.FieldRules{
+		validation.Field(name, validation.Required, validation.Length(1, MaxVarcharLength)),
+		validation.Field(breed, validation.Length(0, MaxVarcharLength)),
+		validation.Field(birthday, validation.By(isPastCatBirthday)),
+		validation.Field(bio, validation.Length(0, MaxVarcharLength)),
+	}
+}
+
+func (req *RequestRegisterCat) ValidateParam() error {
+	return validation.ValidateStruct(req, catProfileRules(&req.Name, &req.Breed, &req.Birthday, &req.Bio)...)
+}
+
+func (req *RequestUpdateCat) ValidateParam() error {
+	return validation.ValidateStruct(req, catProfileRules(&req.Name, &req.Breed, &req.Birthday, &req.Bio)...)
+}
+
+// collectionRules returns the rules shared by registering and updating a collection
+func collectionRules(name *string) []*validation.FieldRules {
+	return []*validation.FieldRules{
+		validation.Field(name, validation.Required, validation.Length(1, MaxVarcharLength)),
+	}
+}
+
+func (req *RequestRegisterCollection) ValidateParam() error {
+	return validation.ValidateStruct(req, collectionRules(&req.Name)...)
+}
+
+func (req *RequestUpdateCollection) ValidateParam() error {
+	return validation.ValidateStruct(req, collectionRules(&req.Name)...)
+}
+
+func (req *RequestAddCollectionPosting) ValidateParam() error {
+	return validation.ValidateStruct(req, validation.Field(&req.PostingID, validation.Required, validation.Min(int64(1))))
+}
+
+func (req *RequestReorderCollectionPostings) ValidateParam() error {
+	return validation.ValidateStruct(req, validation.Field(&req.PostingIDs, validation.Length(0, MaxCollectionPostings), validation.Each(validation.Min(int64(1)))))
+}
+
+// draftRules returns the rules shared by registering and updating a draft.
+// The title may be empty until the draft is published, when the rules of a posting are checked.
+func draftRules(title, altText *string) []*validation.FieldRules {
+	return []*validation.FieldRules{
+		validation.Field(title, postingTitleFormatRules...),
+		validation.Field(altText, validation.Length(0, MaxVarcharLength)),
+	}
+}
+
+func (req *RequestRegisterDraft) ValidateParam() error {
+	fieldRules := append(draftRules(&req.Title, &req.AltText), validation.Field(&req.UploadKey, validation.Required, validation.Length(1, MaxVarcharLength)))
+	return validation.ValidateStruct(req, fieldRules...)
+}
+
+func (req *RequestUpdateDraft) ValidateParam() error {
+	return validation.ValidateStruct(req, draftRules(&req.Title, &req.AltText)...)
+}
+
 func (e *RequestSendPasswordResetEmail) ValidateParam() error {
 	var fieldRules []*validation.FieldRules
 	fieldRules = append(fieldRules, validation.Field(&e.Email, validation.Required, is.Email, validation.Length(MinVarcharLength, MaxVarcharLength)))
@@ -0,0 +1,7 @@
+package model
+
+// Label is what an image classifier sees in an image. Score is the confidence in [0, 1].
+type Label struct {
+	Description string
+	Score       float32
+}
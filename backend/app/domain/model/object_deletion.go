@@ -0,0 +1,14 @@
+package model
+
+import "time"
+
+// ObjectDeletion is an S3 object waiting to be deleted.
+type ObjectDeletion struct {
+	ID            int64
+	Bucket        string
+	ObjectKey     string
+	Attempts      int
+	NextAttemptAt time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
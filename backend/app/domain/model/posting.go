@@ -3,10 +3,22 @@ package model
 import "time"
 
 type Posting struct {
-	ID        int64
-	UserID    int64
-	Title     string
-	ImageURL  string
+	ID       int64
+	UserID   int64
+	Title    string
+	ImageURL string
+	// read by screen readers instead of the images
+	AltText   string
+	EditedAt  *time.Time
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	// set when the posting is scheduled. CreatedAt is the same so that it is ordered by the time published.
+	PublishAt *time.Time
+	// ordered by position. ImageURL is the same as the first one.
+	Images []PostingImage
+}
+
+// IsScheduled reports whether the posting is still waiting to be published at now.
+func (p Posting) IsScheduled(now time.Time) bool {
+	return p.PublishAt != nil && p.PublishAt.After(now)
 }
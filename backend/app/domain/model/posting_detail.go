@@ -0,0 +1,14 @@
+package model
+
+// PostingDetail is a posting with what is shown on its own page, which are got in the same query.
+type PostingDetail struct {
+	Posting
+	UserName     string
+	UserIcon     string
+	LikedCount   int64
+	CommentCount int64
+	// whether the request user likes it
+	Liked bool
+	// cats the posting is tagged with
+	Cats []Cat
+}
@@ -0,0 +1,25 @@
+package model
+
+import "time"
+
+type PostingImage struct {
+	ID        int64
+	PostingID int64
+	Position  int8
+	ImageURL  string
+	// key of the S3 object. keys of the variants are derived from it.
+	ObjectKey string
+	// perceptual hash to find near-duplicates. nil for images uploaded before it was introduced.
+	DHash     *uint64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// SimilarImage is an image which looks like another one. Distance is the Hamming distance of their DHash.
+type SimilarImage struct {
+	PostingID int64
+	UserName  string
+	ImageURL  string
+	Distance  int
+	CreatedAt time.Time
+}
@@ -0,0 +1,20 @@
+package model
+
+import "time"
+
+// periods of the popular postings
+const (
+	PopularPeriodDay  = "day"
+	PopularPeriodWeek = "week"
+	PopularPeriodAll  = "all"
+)
+
+// PostingScore is how popular the posting is within the period. It is recomputed periodically.
+type PostingScore struct {
+	ID        int64
+	PostingID int64
+	Period    string
+	Score     float64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
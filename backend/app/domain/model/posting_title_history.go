@@ -0,0 +1,11 @@
+package model
+
+import "time"
+
+type PostingTitleHistory struct {
+	ID        int64
+	PostingID int64
+	Title     string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
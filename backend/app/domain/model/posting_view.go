@@ -0,0 +1,31 @@
+package model
+
+import "time"
+
+// kinds of the posting views
+const (
+	// PostingViewKindImpression is counted when the posting is shown in a feed
+	PostingViewKindImpression = "impression"
+	// PostingViewKindDetail is counted when the posting is opened
+	PostingViewKindDetail = "detail"
+)
+
+// PostingView is the views of the posting by the user within the window starting at WindowStart, which count as one.
+type PostingView struct {
+	ID          int64
+	PostingID   int64
+	UserID      int64
+	Kind        string
+	WindowStart time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// PostingDailyStats is what happened to a posting on the date.
+type PostingDailyStats struct {
+	Date        time.Time
+	Impressions int64
+	Views       int64
+	Likes       int64
+	Comments    int64
+}
@@ -0,0 +1,43 @@
+package model
+
+import "time"
+
+// StoredObject is an object in an S3 bucket.
+type StoredObject struct {
+	Key          string
+	Size         int64
+	LastModified time.Time
+}
+
+// OrphanedObjectsReport summarizes a garbage collection of a bucket.
+type OrphanedObjectsReport struct {
+	Bucket string
+	// all the objects in the bucket
+	Scanned int
+	// objects referenced by any row
+	Referenced int
+	// unreferenced objects kept because they may belong to a transaction in progress
+	InGracePeriod int
+	// unreferenced objects older than the grace period
+	Orphans       []StoredObject
+	OrphanedBytes int64
+	// always 0 in a dry run
+	Deleted int
+	Failed  int
+}
+
+// ObjectKeyMigrationReport summarizes a migration of objects to the keys derived from their content.
+type ObjectKeyMigrationReport struct {
+	Images int
+	Icons  int
+	// left as they were. running the migration again retries them.
+	Failed int
+}
+
+// ObjectACLReport summarizes making the objects of a bucket private.
+type ObjectACLReport struct {
+	Bucket  string
+	Objects int
+	// left as they were. running it again retries them.
+	Failed int
+}
@@ -0,0 +1,24 @@
+package model
+
+import "time"
+
+type Tag struct {
+	ID        int64
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type PostingTag struct {
+	ID        int64
+	PostingID int64
+	TagID     int64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// TagCount is how many times the tag was used.
+type TagCount struct {
+	Name  string
+	Count int64
+}
@@ -0,0 +1,10 @@
+package model
+
+// TimelinePosting is a posting on the home timeline with what is shown along with it, which are got in the same query.
+type TimelinePosting struct {
+	Posting
+	UserName   string
+	LikedCount int64
+	// whether the timeline owner likes it
+	Liked bool
+}
@@ -0,0 +1,10 @@
+package model
+
+import "time"
+
+// Upload is a place in the bucket which a client puts an image to directly. URL is presigned and valid until ExpiresAt.
+type Upload struct {
+	Key       string
+	URL       string
+	ExpiresAt time.Time
+}
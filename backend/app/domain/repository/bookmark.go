@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/go-sql-driver/mysql"
+
+	m "github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+)
+
+type BookmarkRepositoryInterface interface {
+	Create(ctx context.Context, bookmark *model.Bookmark) (err error)
+	GetWhereUserIDPostingID(ctx context.Context, userID int64, postingID int64) (bookmark model.Bookmark, err error)
+	DeleteWhereUserIDPostingID(ctx context.Context, userID int64, postingID int64) (err error)
+	DeleteWherePostingID(ctx context.Context, postingID int64) (err error)
+	DeleteWhereUserID(ctx context.Context, userID int64) (err error)
+	DeleteWhereInPostingIDs(ctx context.Context, userID int64) (err error)
+}
+
+type BookmarkRepository struct {
+	db *sql.DB
+}
+
+func NewBookmarkRepository(db *sql.DB) *BookmarkRepository {
+	return &BookmarkRepository{
+		db: db,
+	}
+}
+
+func (r *BookmarkRepository) Create(ctx context.Context, bookmark *model.Bookmark) (err error) {
+	q := "INSERT INTO `bookmarks` (`user_id`, `posting_id`) VALUES (?, ?)"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, bookmark.UserID, bookmark.PostingID)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, bookmark.UserID, bookmark.PostingID)
+	}
+	mysqlErr, ok := err.(*mysql.MySQLError)
+	if ok && mysqlErr.Number == 1062 {
+		return ErrDuplicateData
+	}
+	return
+}
+
+func (r *BookmarkRepository) GetWhereUserIDPostingID(ctx context.Context, userID, postingID int64) (bookmark model.Bookmark, err error) {
+	q := "SELECT `id`, `user_id`, `posting_id`, `created_at`, `updated_at` FROM `bookmarks` WHERE `user_id` = ? AND `posting_id` = ?"
+	err = r.db.QueryRowContext(ctx, q, userID, postingID).Scan(&bookmark.ID, &bookmark.UserID, &bookmark.PostingID, &bookmark.CreatedAt, &bookmark.UpdatedAt)
+	if err == sql.ErrNoRows {
+		err = ErrNotExistsData
+		return
+	}
+	return
+}
+
+func (r *BookmarkRepository) DeleteWhereUserIDPostingID(ctx context.Context, userID, postingID int64) (err error) {
+	q := "DELETE FROM `bookmarks` WHERE `user_id` = ? AND `posting_id` = ?"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, userID, postingID)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, userID, postingID)
+	}
+	return
+}
+
+func (r *BookmarkRepository) DeleteWherePostingID(ctx context.Context, postingID int64) (err error) {
+	q := "DELETE FROM `bookmarks` WHERE `posting_id` = ?"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, postingID)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, postingID)
+	}
+	return
+}
+
+func (r *BookmarkRepository) DeleteWhereUserID(ctx context.Context, userID int64) (err error) {
+	q := "DELETE FROM `bookmarks` WHERE `user_id` = ?"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, userID)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, userID)
+	}
+	return
+}
+
+// DeleteWhereInPostingIDs deletes bookmarks of all the postings of the user, which may be made by any user.
+func (r *BookmarkRepository) DeleteWhereInPostingIDs(ctx context.Context, userID int64) (err error) {
+	q := "DELETE FROM `bookmarks` WHERE `posting_id` IN (SELECT `id` FROM `postings` WHERE `user_id` = ?)"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, userID)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, userID)
+	}
+	return
+}
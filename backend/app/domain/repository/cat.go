@@ -0,0 +1,154 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	m "github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+)
+
+type CatRepositoryInterface interface {
+	Create(ctx context.Context, cat *model.Cat) (err error)
+	GetWhereID(ctx context.Context, id int64) (cat model.Cat, err error)
+	GetWhereUserID(ctx context.Context, userID int64) (cats []model.Cat, err error)
+	GetWherePostingID(ctx context.Context, postingID int64) (cats []model.Cat, err error)
+	GetWhereIconSet(ctx context.Context) (cats []model.Cat, err error)
+	ExistsIconInOtherCats(ctx context.Context, icon string, id int64) (exists bool, err error)
+	Update(ctx context.Context, cat *model.Cat) (err error)
+	UpdateIconWhereID(ctx context.Context, icon string, id int64) (err error)
+	DeleteWhereID(ctx context.Context, id int64) (err error)
+	DeleteWhereUserID(ctx context.Context, userID int64) (err error)
+}
+
+type CatRepository struct {
+	db *sql.DB
+}
+
+func NewCatRepository(db *sql.DB) *CatRepository {
+	return &CatRepository{
+		db: db,
+	}
+}
+
+func (r *CatRepository) Create(ctx context.Context, cat *model.Cat) (err error) {
+	q := "INSERT INTO `cats` (`user_id`, `name`, `breed`, `birthday`, `icon`, `bio`) VALUES (?, ?, ?, ?, ?, ?)"
+	var result sql.Result
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		result, err = tx.ExecContext(ctx, q, cat.UserID, cat.Name, cat.Breed, cat.Birthday, cat.Icon, cat.Bio)
+	} else {
+		result, err = r.db.ExecContext(ctx, q, cat.UserID, cat.Name, cat.Breed, cat.Birthday, cat.Icon, cat.Bio)
+	}
+	if err != nil {
+		return
+	}
+	cat.ID, err = result.LastInsertId()
+	return
+}
+
+func (r *CatRepository) GetWhereID(ctx context.Context, id int64) (cat model.Cat, err error) {
+	q := "SELECT `id`, `user_id`, `name`, `breed`, `birthday`, `icon`, `bio`, `created_at`, `updated_at` FROM `cats` WHERE `id` = ?"
+	err = r.db.QueryRowContext(ctx, q, id).Scan(&cat.ID, &cat.UserID, &cat.Name, &cat.Breed, &cat.Birthday, &cat.Icon, &cat.Bio, &cat.CreatedAt, &cat.UpdatedAt)
+	if err == sql.ErrNoRows {
+		err = ErrNotExistsData
+		return
+	}
+	return
+}
+
+func (r *CatRepository) GetWhereUserID(ctx context.Context, userID int64) (cats []model.Cat, err error) {
+	q := "SELECT `id`, `user_id`, `name`, `breed`, `birthday`, `icon`, `bio`, `created_at`, `updated_at` FROM `cats` WHERE `user_id` = ? ORDER BY `id`"
+	return r.query(ctx, q, userID)
+}
+
+// GetWherePostingID returns the cats the posting is tagged with.
+func (r *CatRepository) GetWherePostingID(ctx context.Context, postingID int64) (cats []model.Cat, err error) {
+	q := "SELECT `c`.`id`, `c`.`user_id`, `c`.`name`, `c`.`breed`, `c`.`birthday`, `c`.`icon`, `c`.`bio`, `c`.`created_at`, `c`.`updated_at` FROM `cats` AS `c` INNER JOIN `posting_cats` AS `pc` ON `c`.`id` = `pc`.`cat_id` WHERE `pc`.`posting_id` = ? ORDER BY `c`.`id`"
+	return r.query(ctx, q, postingID)
+}
+
+// GetWhereIconSet returns all the cats which have an icon.
+func (r *CatRepository) GetWhereIconSet(ctx context.Context) (cats []model.Cat, err error) {
+	q := "SELECT `id`, `user_id`, `name`, `breed`, `birthday`, `icon`, `bio`, `created_at`, `updated_at` FROM `cats` WHERE `icon` <> 'UNKNOWN' ORDER BY `id`"
+	return r.query(ctx, q)
+}
+
+func (r *CatRepository) query(ctx context.Context, q string, args ...interface{}) (cats []model.Cat, err error) {
+	rows, err := r.db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	var c model.Cat
+	for rows.Next() {
+		if err = rows.Scan(&c.ID, &c.UserID, &c.Name, &c.Breed, &c.Birthday, &c.Icon, &c.Bio, &c.CreatedAt, &c.UpdatedAt); err != nil {
+			return
+		}
+		cats = append(cats, c)
+		c = model.Cat{}
+	}
+	if err = rows.Err(); err != nil {
+		return
+	}
+	return
+}
+
+// ExistsIconInOtherCats reports whether a cat other than the one of id uses the icon.
+// The icons of the cats of a user share an object when they are the same image.
+func (r *CatRepository) ExistsIconInOtherCats(ctx context.Context, icon string, id int64) (exists bool, err error) {
+	q := "SELECT EXISTS (SELECT 1 FROM `cats` WHERE `icon` = ? AND `id` <> ?)"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, q, icon, id).Scan(&exists)
+	} else {
+		err = r.db.QueryRowContext(ctx, q, icon, id).Scan(&exists)
+	}
+	return
+}
+
+// Update overwrites the profile of the cat except for the icon.
+func (r *CatRepository) Update(ctx context.Context, cat *model.Cat) (err error) {
+	q := "UPDATE `cats` SET `name` = ?, `breed` = ?, `birthday` = ?, `bio` = ? WHERE `id` = ?"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, cat.Name, cat.Breed, cat.Birthday, cat.Bio, cat.ID)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, cat.Name, cat.Breed, cat.Birthday, cat.Bio, cat.ID)
+	}
+	return
+}
+
+func (r *CatRepository) UpdateIconWhereID(ctx context.Context, icon string, id int64) (err error) {
+	q := "UPDATE `cats` SET `icon` = ? WHERE `id` = ?"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, icon, id)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, icon, id)
+	}
+	return
+}
+
+func (r *CatRepository) DeleteWhereID(ctx context.Context, id int64) (err error) {
+	q := "DELETE FROM `cats` WHERE `id` = ?"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, id)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, id)
+	}
+	return
+}
+
+func (r *CatRepository) DeleteWhereUserID(ctx context.Context, userID int64) (err error) {
+	q := "DELETE FROM `cats` WHERE `user_id` = ?"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, userID)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, userID)
+	}
+	return
+}
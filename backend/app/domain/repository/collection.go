@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	m "github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+)
+
+type CollectionRepositoryInterface interface {
+	Create(ctx context.Context, collection *model.Collection) (err error)
+	GetWhereID(ctx context.Context, id int64) (collection model.Collection, err error)
+	GetWhereUserID(ctx context.Context, userID int64) (collections []model.Collection, err error)
+	GetPublicWhereUserID(ctx context.Context, userID int64) (collections []model.Collection, err error)
+	Update(ctx context.Context, collection *model.Collection) (err error)
+	DeleteWhereID(ctx context.Context, id int64) (err error)
+	DeleteWhereUserID(ctx context.Context, userID int64) (err error)
+}
+
+type CollectionRepository struct {
+	db *sql.DB
+}
+
+func NewCollectionRepository(db *sql.DB) *CollectionRepository {
+	return &CollectionRepository{
+		db: db,
+	}
+}
+
+func (r *CollectionRepository) Create(ctx context.Context, collection *model.Collection) (err error) {
+	q := "INSERT INTO `collections` (`user_id`, `name`, `is_public`) VALUES (?, ?, ?)"
+	var result sql.Result
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		result, err = tx.ExecContext(ctx, q, collection.UserID, collection.Name, collection.IsPublic)
+	} else {
+		result, err = r.db.ExecContext(ctx, q, collection.UserID, collection.Name, collection.IsPublic)
+	}
+	if err != nil {
+		return
+	}
+	collection.ID, err = result.LastInsertId()
+	return
+}
+
+func (r *CollectionRepository) GetWhereID(ctx context.Context, id int64) (collection model.Collection, err error) {
+	q := "SELECT `id`, `user_id`, `name`, `is_public`, `created_at`, `updated_at` FROM `collections` WHERE `id` = ?"
+	err = r.db.QueryRowContext(ctx, q, id).Scan(&collection.ID, &collection.UserID, &collection.Name, &collection.IsPublic, &collection.CreatedAt, &collection.UpdatedAt)
+	if err == sql.ErrNoRows {
+		err = ErrNotExistsData
+		return
+	}
+	return
+}
+
+func (r *CollectionRepository) GetWhereUserID(ctx context.Context, userID int64) (collections []model.Collection, err error) {
+	q := "SELECT `id`, `user_id`, `name`, `is_public`, `created_at`, `updated_at` FROM `collections` WHERE `user_id` = ? ORDER BY `id`"
+	return r.query(ctx, q, userID)
+}
+
+// GetPublicWhereUserID returns the collections of the user which others can see.
+func (r *CollectionRepository) GetPublicWhereUserID(ctx context.Context, userID int64) (collections []model.Collection, err error) {
+	q := "SELECT `id`, `user_id`, `name`, `is_public`, `created_at`, `updated_at` FROM `collections` WHERE `user_id` = ? AND `is_public` = TRUE ORDER BY `id`"
+	return r.query(ctx, q, userID)
+}
+
+func (r *CollectionRepository) query(ctx context.Context, q string, args ...interface{}) (collections []model.Collection, err error) {
+	rows, err := r.db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	var c model.Collection
+	for rows.Next() {
+		if err = rows.Scan(&c.ID, &c.UserID, &c.Name, &c.IsPublic, &c.CreatedAt, &c.UpdatedAt); err != nil {
+			return
+		}
+		collections = append(collections, c)
+		c = model.Collection{}
+	}
+	if err = rows.Err(); err != nil {
+		return
+	}
+	return
+}
+
+func (r *CollectionRepository) Update(ctx context.Context, collection *model.Collection) (err error) {
+	q := "UPDATE `collections` SET `name` = ?, `is_public` = ? WHERE `id` = ?"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, collection.Name, collection.IsPublic, collection.ID)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, collection.Name, collection.IsPublic, collection.ID)
+	}
+	return
+}
+
+func (r *CollectionRepository) DeleteWhereID(ctx context.Context, id int64) (err error) {
+	q := "DELETE FROM `collections` WHERE `id` = ?"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, id)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, id)
+	}
+	return
+}
+
+func (r *CollectionRepository) DeleteWhereUserID(ctx context.Context, userID int64) (err error) {
+	q := "DELETE FROM `collections` WHERE `user_id` = ?"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, userID)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, userID)
+	}
+	return
+}
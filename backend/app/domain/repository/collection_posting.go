@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/go-sql-driver/mysql"
+
+	m "github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+)
+
+type CollectionPostingRepositoryInterface interface {
+	Create(ctx context.Context, collectionPosting *model.CollectionPosting) (err error)
+	GetWhereCollectionID(ctx context.Context, collectionID int64) (collectionPostings []model.CollectionPosting, err error)
+	UpdatePositionWhereID(ctx context.Context, position int, id int64) (err error)
+	DeleteWhereCollectionIDPostingID(ctx context.Context, collectionID int64, postingID int64) (err error)
+	DeleteWhereCollectionID(ctx context.Context, collectionID int64) (err error)
+	DeleteWherePostingID(ctx context.Context, postingID int64) (err error)
+	DeleteWhereInCollectionIDs(ctx context.Context, userID int64) (err error)
+	DeleteWhereInPostingIDs(ctx context.Context, userID int64) (err error)
+}
+
+type CollectionPostingRepository struct {
+	db *sql.DB
+}
+
+func NewCollectionPostingRepository(db *sql.DB) *CollectionPostingRepository {
+	return &CollectionPostingRepository{
+		db: db,
+	}
+}
+
+func (r *CollectionPostingRepository) Create(ctx context.Context, collectionPosting *model.CollectionPosting) (err error) {
+	q := "INSERT INTO `collection_postings` (`collection_id`, `posting_id`, `position`) VALUES (?, ?, ?)"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, collectionPosting.CollectionID, collectionPosting.PostingID, collectionPosting.Position)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, collectionPosting.CollectionID, collectionPosting.PostingID, collectionPosting.Position)
+	}
+	mysqlErr, ok := err.(*mysql.MySQLError)
+	if ok && mysqlErr.Number == 1062 {
+		return ErrDuplicateData
+	}
+	return
+}
+
+// GetWhereCollectionID returns the postings in the collection in the order of position.
+func (r *CollectionPostingRepository) GetWhereCollectionID(ctx context.Context, collectionID int64) (collectionPostings []model.CollectionPosting, err error) {
+	q := "SELECT `id`, `collection_id`, `posting_id`, `position`, `created_at`, `updated_at` FROM `collection_postings` WHERE `collection_id` = ? ORDER BY `position`, `id`"
+	rows, err := r.db.QueryContext(ctx, q, collectionID)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	var cp model.CollectionPosting
+	for rows.Next() {
+		if err = rows.Scan(&cp.ID, &cp.CollectionID, &cp.PostingID, &cp.Position, &cp.CreatedAt, &cp.UpdatedAt); err != nil {
+			return
+		}
+		collectionPostings = append(collectionPostings, cp)
+		cp = model.CollectionPosting{}
+	}
+	if err = rows.Err(); err != nil {
+		return
+	}
+	return
+}
+
+func (r *CollectionPostingRepository) UpdatePositionWhereID(ctx context.Context, position int, id int64) (err error) {
+	q := "UPDATE `collection_postings` SET `position` = ? WHERE `id` = ?"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, position, id)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, position, id)
+	}
+	return
+}
+
+func (r *CollectionPostingRepository) DeleteWhereCollectionIDPostingID(ctx context.Context, collectionID, postingID int64) (err error) {
+	q := "DELETE FROM `collection_postings` WHERE `collection_id` = ? AND `posting_id` = ?"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, collectionID, postingID)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, collectionID, postingID)
+	}
+	return
+}
+
+func (r *CollectionPostingRepository) DeleteWhereCollectionID(ctx context.Context, collectionID int64) (err error) {
+	q := "DELETE FROM `collection_postings` WHERE `collection_id` = ?"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, collectionID)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, collectionID)
+	}
+	return
+}
+
+// DeleteWherePostingID removes the posting from every collection.
+func (r *CollectionPostingRepository) DeleteWherePostingID(ctx context.Context, postingID int64) (err error) {
+	q := "DELETE FROM `collection_postings` WHERE `posting_id` = ?"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, postingID)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, postingID)
+	}
+	return
+}
+
+// DeleteWhereInCollectionIDs empties all the collections of the user.
+func (r *CollectionPostingRepository) DeleteWhereInCollectionIDs(ctx context.Context, userID int64) (err error) {
+	q := "DELETE FROM `collection_postings` WHERE `collection_id` IN (SELECT `id` FROM `collections` WHERE `user_id` = ?)"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, userID)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, userID)
+	}
+	return
+}
+
+// DeleteWhereInPostingIDs removes all the postings of the user from every collection, which may be of any user.
+func (r *CollectionPostingRepository) DeleteWhereInPostingIDs(ctx context.Context, userID int64) (err error) {
+	q := "DELETE FROM `collection_postings` WHERE `posting_id` IN (SELECT `id` FROM `postings` WHERE `user_id` = ?)"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, userID)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, userID)
+	}
+	return
+}
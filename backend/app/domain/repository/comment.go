@@ -12,7 +12,8 @@ import (
 
 type CommentRepositoryInterface interface {
 	Create(ctx context.Context, comment *model.Comment) (err error)
-	GetCommentsWherePostingID(ctx context.Context, id int64) (comments []model.Comment, err error)
+	GetCommentsWherePostingID(ctx context.Context, cursor model.Cursor, limit int8, id int64) (comments []model.Comment, err error)
+	GetLatestWithUserNamesWherePostingID(ctx context.Context, limit int8, postingID int64) (comments []model.Comment, userNames []string, err error)
 	GetWhereID(ctx context.Context, id int64) (comment model.Comment, err error)
 	DeleteWhereID(ctx context.Context, id int64) (err error)
 	DeleteWhereUserID(ctx context.Context, userID int64) (err error)
@@ -43,9 +44,16 @@ func (r *CommentRepository) Create(ctx context.Context, comment *model.Comment)
 	return
 }
 
-func (r *CommentRepository) GetCommentsWherePostingID(ctx context.Context, postingID int64) (comments []model.Comment, err error) {
-	q := "SELECT `id`, `user_id`, `posting_id`, `comment`, `created_at`, `updated_at` FROM `comments` WHERE `posting_id` = ? ORDER BY `created_at` DESC"
-	rows, err := r.db.QueryContext(ctx, q, postingID)
+// GetCommentsWherePostingID returns all the comments when limit is 0.
+func (r *CommentRepository) GetCommentsWherePostingID(ctx context.Context, cursor model.Cursor, limit int8, postingID int64) (comments []model.Comment, err error) {
+	cond, args := olderThanCursor("", cursor)
+	q := "SELECT `id`, `user_id`, `posting_id`, `comment`, `created_at`, `updated_at` FROM `comments` WHERE `posting_id` = ? AND " + cond + " ORDER BY `created_at` DESC, `id` DESC"
+	args = append([]interface{}{postingID}, args...)
+	if limit > 0 {
+		q += " LIMIT ?"
+		args = append(args, limit)
+	}
+	rows, err := r.db.QueryContext(ctx, q, args...)
 	if err == sql.ErrNoRows {
 		err = ErrNotExistsData
 		return
@@ -69,8 +77,34 @@ func (r *CommentRepository) GetCommentsWherePostingID(ctx context.Context, posti
 	return
 }
 
+// GetLatestWithUserNamesWherePostingID returns the first page of the comments and the names of their users in the same order.
+func (r *CommentRepository) GetLatestWithUserNamesWherePostingID(ctx context.Context, limit int8, postingID int64) (comments []model.Comment, userNames []string, err error) {
+	q := "SELECT `c`.`id`, `c`.`user_id`, `c`.`posting_id`, `c`.`comment`, `c`.`created_at`, `c`.`updated_at`, `u`.`name` FROM `comments` AS `c` INNER JOIN `users` AS `u` ON `c`.`user_id` = `u`.`id` " +
+		"WHERE `c`.`posting_id` = ? ORDER BY `c`.`created_at` DESC, `c`.`id` DESC LIMIT ?"
+	rows, err := r.db.QueryContext(ctx, q, postingID, limit)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	var c model.Comment
+	var userName string
+	for rows.Next() {
+		if err = rows.Scan(&c.ID, &c.UserID, &c.PostingID, &c.Comment, &c.CreatedAt, &c.UpdatedAt, &userName); err != nil {
+			return
+		}
+		comments = append(comments, c)
+		userNames = append(userNames, userName)
+		c = model.Comment{}
+	}
+	if err = rows.Err(); err != nil {
+		return
+	}
+	return
+}
+
 func (r *CommentRepository) GetWhereID(ctx context.Context, id int64) (comment model.Comment, err error) {
-	q := "SELECT `id`, `user_id`, `posting_id`, `created_at`, `updated_at` FROM `comments` WHERE `id` = ?"
+	q := "SELECT `id`, `user_id`, `posting_id`, `comment`, `created_at`, `updated_at` FROM `comments` WHERE `id` = ?"
 	err = r.db.QueryRowContext(ctx, q, id).Scan(&comment.ID, &comment.UserID, &comment.PostingID, &comment.Comment, &comment.CreatedAt, &comment.UpdatedAt)
 	if err == sql.ErrNoRows {
 		err = ErrNotExistsData
@@ -79,13 +113,25 @@ func (r *CommentRepository) GetWhereID(ctx context.Context, id int64) (comment m
 	return
 }
 
+// DeleteWhereID returns ErrNotExistsData when the comment has already been deleted.
 func (r *CommentRepository) DeleteWhereID(ctx context.Context, id int64) (err error) {
 	q := "DELETE FROM `comments` WHERE `id` = ?"
+	var result sql.Result
 	tx := m.GetTransaction(ctx)
 	if tx != nil {
-		_, err = tx.ExecContext(ctx, q, id)
+		result, err = tx.ExecContext(ctx, q, id)
 	} else {
-		_, err = r.db.ExecContext(ctx, q, id)
+		result, err = r.db.ExecContext(ctx, q, id)
+	}
+	if err != nil {
+		return
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return
+	}
+	if rows == 0 {
+		return ErrNotExistsData
 	}
 	return
 }
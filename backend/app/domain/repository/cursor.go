@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+)
+
+// olderThanCursor returns the condition to get the rows after the cursor in descending order of (created_at, id).
+// prefix is the table alias with a dot such as "`p`." or empty.
+// Comparing id as well keeps rows created in the same second from being skipped or repeated across pages.
+func olderThanCursor(prefix string, c model.Cursor) (cond string, args []interface{}) {
+	if c.IsZero() {
+		return "TRUE", nil
+	}
+	cond = "(" + prefix + "`created_at` < ? OR (" + prefix + "`created_at` = ? AND " + prefix + "`id` < ?))"
+	args = []interface{}{c.CreatedAt, c.CreatedAt, c.ID}
+	return
+}
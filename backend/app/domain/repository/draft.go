@@ -0,0 +1,182 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+
+	m "github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+)
+
+type DraftRepositoryInterface interface {
+	Create(ctx context.Context, draft *model.Draft) (err error)
+	GetWhereID(ctx context.Context, id int64) (draft model.Draft, err error)
+	GetWhereUserID(ctx context.Context, userID int64) (drafts []model.Draft, err error)
+	GetUpdatedBefore(ctx context.Context, threshold time.Time, limit int) (drafts []model.Draft, err error)
+	GetObjectKeys(ctx context.Context) (objectKeys []string, err error)
+	CountWhereUserID(ctx context.Context, userID int64) (count int64, err error)
+	Update(ctx context.Context, draft *model.Draft) (err error)
+	DeleteWhereID(ctx context.Context, id int64) (err error)
+	DeleteWhereUserIDObjectKeys(ctx context.Context, userID int64, objectKeys []string) (err error)
+	DeleteWhereUserID(ctx context.Context, userID int64) (err error)
+}
+
+type DraftRepository struct {
+	db *sql.DB
+}
+
+func NewDraftRepository(db *sql.DB) *DraftRepository {
+	return &DraftRepository{
+		db: db,
+	}
+}
+
+func (r *DraftRepository) Create(ctx context.Context, draft *model.Draft) (err error) {
+	q := "INSERT INTO `drafts` (`user_id`, `title`, `alt_text`, `object_key`) VALUES (?, ?, ?, ?)"
+	var result sql.Result
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		result, err = tx.ExecContext(ctx, q, draft.UserID, draft.Title, draft.AltText, draft.ObjectKey)
+	} else {
+		result, err = r.db.ExecContext(ctx, q, draft.UserID, draft.Title, draft.AltText, draft.ObjectKey)
+	}
+	mysqlErr, ok := err.(*mysql.MySQLError)
+	if ok && mysqlErr.Number == 1062 {
+		return ErrDuplicateData
+	}
+	if err != nil {
+		return
+	}
+	draft.ID, err = result.LastInsertId()
+	return
+}
+
+func (r *DraftRepository) GetWhereID(ctx context.Context, id int64) (draft model.Draft, err error) {
+	q := "SELECT `id`, `user_id`, `title`, `alt_text`, `object_key`, `created_at`, `updated_at` FROM `drafts` WHERE `id` = ?"
+	err = r.db.QueryRowContext(ctx, q, id).Scan(&draft.ID, &draft.UserID, &draft.Title, &draft.AltText, &draft.ObjectKey, &draft.CreatedAt, &draft.UpdatedAt)
+	if err == sql.ErrNoRows {
+		err = ErrNotExistsData
+		return
+	}
+	return
+}
+
+// GetWhereUserID returns the drafts of the user, the last edited first.
+func (r *DraftRepository) GetWhereUserID(ctx context.Context, userID int64) (drafts []model.Draft, err error) {
+	q := "SELECT `id`, `user_id`, `title`, `alt_text`, `object_key`, `created_at`, `updated_at` FROM `drafts` WHERE `user_id` = ? ORDER BY `updated_at` DESC, `id` DESC"
+	return r.query(ctx, q, userID)
+}
+
+// GetUpdatedBefore returns the drafts not edited since the threshold, the oldest first.
+func (r *DraftRepository) GetUpdatedBefore(ctx context.Context, threshold time.Time, limit int) (drafts []model.Draft, err error) {
+	q := "SELECT `id`, `user_id`, `title`, `alt_text`, `object_key`, `created_at`, `updated_at` FROM `drafts` WHERE `updated_at` < ? ORDER BY `updated_at`, `id` LIMIT ?"
+	return r.query(ctx, q, threshold, limit)
+}
+
+func (r *DraftRepository) query(ctx context.Context, q string, args ...interface{}) (drafts []model.Draft, err error) {
+	rows, err := r.db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	var d model.Draft
+	for rows.Next() {
+		if err = rows.Scan(&d.ID, &d.UserID, &d.Title, &d.AltText, &d.ObjectKey, &d.CreatedAt, &d.UpdatedAt); err != nil {
+			return
+		}
+		drafts = append(drafts, d)
+		d = model.Draft{}
+	}
+	if err = rows.Err(); err != nil {
+		return
+	}
+	return
+}
+
+// GetObjectKeys returns the keys of the images of all the drafts.
+func (r *DraftRepository) GetObjectKeys(ctx context.Context) (objectKeys []string, err error) {
+	q := "SELECT `object_key` FROM `drafts`"
+	rows, err := r.db.QueryContext(ctx, q)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	var k string
+	for rows.Next() {
+		if err = rows.Scan(&k); err != nil {
+			return
+		}
+		objectKeys = append(objectKeys, k)
+	}
+	if err = rows.Err(); err != nil {
+		return
+	}
+	return
+}
+
+func (r *DraftRepository) CountWhereUserID(ctx context.Context, userID int64) (count int64, err error) {
+	q := "SELECT COUNT(*) FROM `drafts` WHERE `user_id` = ?"
+	err = r.db.QueryRowContext(ctx, q, userID).Scan(&count)
+	return
+}
+
+func (r *DraftRepository) Update(ctx context.Context, draft *model.Draft) (err error) {
+	q := "UPDATE `drafts` SET `title` = ?, `alt_text` = ? WHERE `id` = ?"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, draft.Title, draft.AltText, draft.ID)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, draft.Title, draft.AltText, draft.ID)
+	}
+	return
+}
+
+func (r *DraftRepository) DeleteWhereID(ctx context.Context, id int64) (err error) {
+	q := "DELETE FROM `drafts` WHERE `id` = ?"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, id)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, id)
+	}
+	return
+}
+
+// DeleteWhereUserIDObjectKeys deletes the drafts of the user whose images are the objects.
+func (r *DraftRepository) DeleteWhereUserIDObjectKeys(ctx context.Context, userID int64, objectKeys []string) (err error) {
+	if len(objectKeys) == 0 {
+		return
+	}
+	placeholders := make([]string, 0, len(objectKeys))
+	args := make([]interface{}, 0, len(objectKeys)+1)
+	args = append(args, userID)
+	for _, k := range objectKeys {
+		placeholders = append(placeholders, "?")
+		args = append(args, k)
+	}
+	q := "DELETE FROM `drafts` WHERE `user_id` = ? AND `object_key` IN (" + strings.Join(placeholders, ", ") + ")"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, args...)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, args...)
+	}
+	return
+}
+
+func (r *DraftRepository) DeleteWhereUserID(ctx context.Context, userID int64) (err error) {
+	q := "DELETE FROM `drafts` WHERE `user_id` = ?"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, userID)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, userID)
+	}
+	return
+}
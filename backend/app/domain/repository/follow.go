@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"strings"
 
 	"github.com/go-sql-driver/mysql"
 
@@ -12,8 +13,7 @@ import (
 
 type FollowRepositoryInterface interface {
 	FindByBothUserIDs(ctx context.Context, followingUserID, followedUserID int64) (follow model.Follow, err error)
-	GetFollowCountWhereUserID(ctx context.Context, userID int64) (int64, err error)
-	GetFollowedCountWhereUserID(ctx context.Context, userID int64) (int64, err error)
+	GetFollowedUserIDsWhereFollowingUserID(ctx context.Context, followingUserID int64, userIDs []int64) (followed map[int64]bool, err error)
 	Create(ctx context.Context, follow *model.Follow) (err error)
 	DeleteWhereBothUserIDs(ctx context.Context, followingUserID, followedUserID int64) (err error)
 	DeleteWhereFollowingUserID(ctx context.Context, userID int64) (err error)
@@ -30,18 +30,6 @@ func NewFollowRepository(db *sql.DB) *FollowRepository {
 	}
 }
 
-func (r *FollowRepository) GetFollowCountWhereUserID(ctx context.Context, userID int64) (count int64, err error) {
-	q := "SELECT COUNT(*) FROM `follows` WHERE `following_user_id` = ?"
-	err = r.db.QueryRowContext(ctx, q, userID).Scan(&count)
-	return
-}
-
-func (r *FollowRepository) GetFollowedCountWhereUserID(ctx context.Context, userID int64) (count int64, err error) {
-	q := "SELECT COUNT(*) FROM `follows` WHERE `followed_user_id` = ?"
-	err = r.db.QueryRowContext(ctx, q, userID).Scan(&count)
-	return
-}
-
 func (r *FollowRepository) Create(ctx context.Context, follow *model.Follow) (err error) {
 	q := "INSERT INTO `follows` (`following_user_id`, `followed_user_id`) VALUES (?, ?)"
 	tx := m.GetTransaction(ctx)
@@ -67,13 +55,58 @@ func (r *FollowRepository) FindByBothUserIDs(ctx context.Context, followingUserI
 	return
 }
 
+// GetFollowedUserIDsWhereFollowingUserID returns which of the users are followed by the following user.
+func (r *FollowRepository) GetFollowedUserIDsWhereFollowingUserID(ctx context.Context, followingUserID int64, userIDs []int64) (followed map[int64]bool, err error) {
+	followed = make(map[int64]bool, len(userIDs))
+	if len(userIDs) == 0 {
+		return
+	}
+	placeholders := make([]string, 0, len(userIDs))
+	args := make([]interface{}, 0, len(userIDs)+1)
+	args = append(args, followingUserID)
+	for _, id := range userIDs {
+		placeholders = append(placeholders, "?")
+		args = append(args, id)
+	}
+	q := "SELECT `followed_user_id` FROM `follows` WHERE `following_user_id` = ? AND `followed_user_id` IN (" + strings.Join(placeholders, ", ") + ")"
+	rows, err := r.db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	var id int64
+	for rows.Next() {
+		if err = rows.Scan(&id); err != nil {
+			return
+		}
+		followed[id] = true
+	}
+	if err = rows.Err(); err != nil {
+		return
+	}
+	return
+}
+
+// DeleteWhereBothUserIDs returns ErrNotExistsData when the follow has already been deleted.
 func (r *FollowRepository) DeleteWhereBothUserIDs(ctx context.Context, followingUserID, followedUserID int64) (err error) {
 	q := "DELETE FROM `follows` WHERE `following_user_id` = ? AND `followed_user_id` = ?"
+	var result sql.Result
 	tx := m.GetTransaction(ctx)
 	if tx != nil {
-		_, err = tx.ExecContext(ctx, q, followingUserID, followedUserID)
+		result, err = tx.ExecContext(ctx, q, followingUserID, followedUserID)
 	} else {
-		_, err = r.db.ExecContext(ctx, q, followingUserID, followedUserID)
+		result, err = r.db.ExecContext(ctx, q, followingUserID, followedUserID)
+	}
+	if err != nil {
+		return
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return
+	}
+	if rows == 0 {
+		return ErrNotExistsData
 	}
 	return
 }
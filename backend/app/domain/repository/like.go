@@ -14,9 +14,7 @@ type LikeRepositoryInterface interface {
 	Create(ctx context.Context, like *model.Like) (err error)
 	GetWhereUserID(ctx context.Context, userID int64) (like model.Like, err error)
 	GetWhereUserIDPostingID(ctx context.Context, userID int64, postingID int64) (like model.Like, err error)
-	GetLikeCountWhereUserID(ctx context.Context, userID int64) (int64, err error)
-	GetLikedCountWhereUserID(ctx context.Context, userID int64) (int64, err error)
-	GetLikedCountWherePostingID(ctx context.Context, postingID int64) (int64, err error)
+	GetWithUsersWherePostingID(ctx context.Context, cursor model.Cursor, limit int8, postingID int64) (likes []model.Like, users []model.User, err error)
 	DeleteWhereUserIDPostingID(ctx context.Context, userID int64, postingID int64) (err error)
 	DeleteWhereUserID(ctx context.Context, userID int64) (err error)
 	DeleteWhereInPosingIDs(ctx context.Context, userID int64) (err error)
@@ -84,31 +82,53 @@ func (r *LikeRepository) GetWhereUserIDPostingID(ctx context.Context, userID, po
 	return
 }
 
-func (r *LikeRepository) GetLikeCountWhereUserID(ctx context.Context, userID int64) (count int64, err error) {
-	q := "SELECT COUNT(*) FROM `likes` WHERE `user_id` = ?"
-	err = r.db.QueryRowContext(ctx, q, userID).Scan(&count)
-	return
-}
-
-func (r *LikeRepository) GetLikedCountWhereUserID(ctx context.Context, userID int64) (count int64, err error) {
-	q := "SELECT COUNT(*) FROM `likes` WHERE `posting_id` IN(SELECT `id` FROM `postings` WHERE `user_id` = ?);"
-	err = r.db.QueryRowContext(ctx, q, userID).Scan(&count)
-	return
-}
+// GetWithUsersWherePostingID returns the likes of the posting in descending order of the time liked and their users in the same order.
+func (r *LikeRepository) GetWithUsersWherePostingID(ctx context.Context, cursor model.Cursor, limit int8, postingID int64) (likes []model.Like, users []model.User, err error) {
+	cond, args := olderThanCursor("`l`.", cursor)
+	q := "SELECT `l`.`id`, `l`.`user_id`, `l`.`posting_id`, `l`.`created_at`, `l`.`updated_at`, `u`.`id`, `u`.`name`, `u`.`icon`, `u`.`self_introduction` FROM `likes` AS `l` INNER JOIN `users` AS `u` ON `l`.`user_id` = `u`.`id` " +
+		"WHERE `l`.`posting_id` = ? AND " + cond + " ORDER BY `l`.`created_at` DESC, `l`.`id` DESC LIMIT ?"
+	rows, err := r.db.QueryContext(ctx, q, append(append([]interface{}{postingID}, args...), limit)...)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
 
-func (r *LikeRepository) GetLikedCountWherePostingID(ctx context.Context, postingID int64) (count int64, err error) {
-	q := "SELECT COUNT(*) FROM `likes` WHERE `posting_id` = ?;"
-	err = r.db.QueryRowContext(ctx, q, postingID).Scan(&count)
+	var like model.Like
+	var u model.User
+	for rows.Next() {
+		if err = rows.Scan(&like.ID, &like.UserID, &like.PostingID, &like.CreatedAt, &like.UpdatedAt, &u.ID, &u.Name, &u.Icon, &u.SelfIntroduction); err != nil {
+			return
+		}
+		likes = append(likes, like)
+		users = append(users, u)
+		like = model.Like{}
+		u = model.User{}
+	}
+	if err = rows.Err(); err != nil {
+		return
+	}
 	return
 }
 
+// DeleteWhereUserIDPostingID returns ErrNotExistsData when the like has already been deleted.
 func (r *LikeRepository) DeleteWhereUserIDPostingID(ctx context.Context, userID, postingID int64) (err error) {
 	q := "DELETE FROM `likes` WHERE `user_id` = ? AND `posting_id` = ?"
+	var result sql.Result
 	tx := m.GetTransaction(ctx)
 	if tx != nil {
-		_, err = tx.ExecContext(ctx, q, userID, postingID)
+		result, err = tx.ExecContext(ctx, q, userID, postingID)
 	} else {
-		_, err = r.db.ExecContext(ctx, q, userID, postingID)
+		result, err = r.db.ExecContext(ctx, q, userID, postingID)
+	}
+	if err != nil {
+		return
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return
+	}
+	if rows == 0 {
+		return ErrNotExistsData
 	}
 	return
 }
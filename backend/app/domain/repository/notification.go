@@ -12,7 +12,7 @@ import (
 
 type NotificationRepositoryInterface interface {
 	Create(ctx context.Context, notification *model.Notification) (err error)
-	GetNotifications(ctx context.Context, userID int64) (notifications []model.Notification, err error)
+	GetNotifications(ctx context.Context, cursor model.Cursor, limit int8, userID int64) (notifications []model.Notification, err error)
 	DeleteWhereID(ctx context.Context, id int64) (err error)
 	DeleteWhereNotificationUserID(ctx context.Context, userID int64) (err error)
 }
@@ -42,9 +42,16 @@ func (r *NotificationRepository) Create(ctx context.Context, notification *model
 	return
 }
 
-func (r *NotificationRepository) GetNotifications(ctx context.Context, userID int64) (notifications []model.Notification, err error) {
-	q := "SELECT `id`, `visitor_user_id`, `visited_user_id`, `action`, `created_at`, `updated_at` FROM `postings` WHERE `created_at` < ? ORDER BY `created_at` DESC"
-	rows, err := r.db.QueryContext(ctx, q, userID)
+// GetNotifications returns all the notifications to the user when limit is 0.
+func (r *NotificationRepository) GetNotifications(ctx context.Context, cursor model.Cursor, limit int8, userID int64) (notifications []model.Notification, err error) {
+	cond, args := olderThanCursor("", cursor)
+	q := "SELECT `id`, `visitor_user_id`, `visited_user_id`, `action`, `created_at`, `updated_at` FROM `notifications` WHERE `visited_user_id` = ? AND " + cond + " ORDER BY `created_at` DESC, `id` DESC"
+	args = append([]interface{}{userID}, args...)
+	if limit > 0 {
+		q += " LIMIT ?"
+		args = append(args, limit)
+	}
+	rows, err := r.db.QueryContext(ctx, q, args...)
 	if err == sql.ErrNoRows {
 		err = ErrNotExistsData
 		return
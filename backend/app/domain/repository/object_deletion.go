@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	m "github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+)
+
+type ObjectDeletionRepositoryInterface interface {
+	Create(ctx context.Context, deletion *model.ObjectDeletion) (err error)
+	GetDue(ctx context.Context, now time.Time, limit int) (deletions []model.ObjectDeletion, err error)
+	GetWhereIDForUpdate(ctx context.Context, id int64) (deletion model.ObjectDeletion, err error)
+	ExistsPostingObjectReference(ctx context.Context, baseKey, key string) (exists bool, err error)
+	ExistsIconObjectReference(ctx context.Context, key string) (exists bool, err error)
+	UpdateRetryWhereID(ctx context.Context, nextAttemptAt time.Time, id int64) (err error)
+	DeleteWhereID(ctx context.Context, id int64) (err error)
+}
+
+type ObjectDeletionRepository struct {
+	db *sql.DB
+}
+
+func NewObjectDeletionRepository(db *sql.DB) *ObjectDeletionRepository {
+	return &ObjectDeletionRepository{
+		db: db,
+	}
+}
+
+func (r *ObjectDeletionRepository) Create(ctx context.Context, deletion *model.ObjectDeletion) (err error) {
+	q := "INSERT INTO `object_deletions` (`bucket`, `object_key`, `next_attempt_at`) VALUES (?, ?, ?)"
+	var result sql.Result
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		result, err = tx.ExecContext(ctx, q, deletion.Bucket, deletion.ObjectKey, deletion.NextAttemptAt)
+	} else {
+		result, err = r.db.ExecContext(ctx, q, deletion.Bucket, deletion.ObjectKey, deletion.NextAttemptAt)
+	}
+	if err != nil {
+		return
+	}
+	deletion.ID, err = result.LastInsertId()
+	return
+}
+
+// GetDue returns deletions whose next attempt time has come, the oldest first.
+func (r *ObjectDeletionRepository) GetDue(ctx context.Context, now time.Time, limit int) (deletions []model.ObjectDeletion, err error) {
+	q := "SELECT `id`, `bucket`, `object_key`, `attempts`, `next_attempt_at`, `created_at`, `updated_at` FROM `object_deletions` WHERE `next_attempt_at` <= ? ORDER BY `next_attempt_at`, `id` LIMIT ?"
+	rows, err := r.db.QueryContext(ctx, q, now, limit)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	var d model.ObjectDeletion
+	for rows.Next() {
+		if err = rows.Scan(&d.ID, &d.Bucket, &d.ObjectKey, &d.Attempts, &d.NextAttemptAt, &d.CreatedAt, &d.UpdatedAt); err != nil {
+			return
+		}
+		deletions = append(deletions, d)
+		d = model.ObjectDeletion{}
+	}
+	if err = rows.Err(); err != nil {
+		return
+	}
+	return
+}
+
+// GetWhereIDForUpdate locks the queued deletion until the transaction of ctx ends.
+// ErrNotExistsData means that it has been processed or dequeued by someone else.
+func (r *ObjectDeletionRepository) GetWhereIDForUpdate(ctx context.Context, id int64) (deletion model.ObjectDeletion, err error) {
+	q := "SELECT `id`, `bucket`, `object_key`, `attempts`, `next_attempt_at`, `created_at`, `updated_at` FROM `object_deletions` WHERE `id` = ? FOR UPDATE"
+	tx := m.GetTransaction(ctx)
+	var row *sql.Row
+	if tx != nil {
+		row = tx.QueryRowContext(ctx, q, id)
+	} else {
+		row = r.db.QueryRowContext(ctx, q, id)
+	}
+	err = row.Scan(&deletion.ID, &deletion.Bucket, &deletion.ObjectKey, &deletion.Attempts, &deletion.NextAttemptAt, &deletion.CreatedAt, &deletion.UpdatedAt)
+	if err == sql.ErrNoRows {
+		err = ErrNotExistsData
+	}
+	return
+}
+
+// ExistsPostingObjectReference reports whether a posting image or a draft refers to the object in the posting bucket.
+// baseKey is the key of posting_images which the variant key was derived from.
+func (r *ObjectDeletionRepository) ExistsPostingObjectReference(ctx context.Context, baseKey, key string) (exists bool, err error) {
+	q := "SELECT EXISTS (SELECT 1 FROM `posting_images` WHERE `object_key` = ?) OR EXISTS (SELECT 1 FROM `drafts` WHERE `object_key` = ?)"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, q, baseKey, key).Scan(&exists)
+	} else {
+		err = r.db.QueryRowContext(ctx, q, baseKey, key).Scan(&exists)
+	}
+	return
+}
+
+// ExistsIconObjectReference reports whether a user or a cat has the object in the icon bucket as the icon.
+// Icons are stored as URLs, which end with the key whether they are path-style or virtual-hosted-style.
+func (r *ObjectDeletionRepository) ExistsIconObjectReference(ctx context.Context, key string) (exists bool, err error) {
+	q := "SELECT EXISTS (SELECT 1 FROM `users` WHERE `icon` LIKE ?) OR EXISTS (SELECT 1 FROM `cats` WHERE `icon` LIKE ?)"
+	pattern := "%/" + likeEscaper.Replace(key)
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, q, pattern, pattern).Scan(&exists)
+	} else {
+		err = r.db.QueryRowContext(ctx, q, pattern, pattern).Scan(&exists)
+	}
+	return
+}
+
+// likeEscaper escapes the wildcards of LIKE because keys contain underscores.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// UpdateRetryWhereID counts up the failed attempts and puts off the next attempt.
+func (r *ObjectDeletionRepository) UpdateRetryWhereID(ctx context.Context, nextAttemptAt time.Time, id int64) (err error) {
+	q := "UPDATE `object_deletions` SET `attempts` = `attempts` + 1, `next_attempt_at` = ? WHERE `id` = ?"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, nextAttemptAt, id)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, nextAttemptAt, id)
+	}
+	return
+}
+
+func (r *ObjectDeletionRepository) DeleteWhereID(ctx context.Context, id int64) (err error) {
+	q := "DELETE FROM `object_deletions` WHERE `id` = ?"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, id)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, id)
+	}
+	return
+}
@@ -7,14 +7,32 @@ import (
 
 	m "github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
 	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+	"github.com/gold-kou/ToeBeans/backend/app/lib"
 )
 
 type PostingRepositoryInterface interface {
 	Create(ctx context.Context, posting *model.Posting) (err error)
-	GetPostings(ctx context.Context, sinceAt time.Time, limit int8) (postings []model.Posting, err error)
+	GetPostings(ctx context.Context, cursor model.Cursor, limit int8, userID int64) (postings []model.Posting, err error)
+	GetPostingsWhereTagID(ctx context.Context, cursor model.Cursor, limit int8, tagID int64) (postings []model.Posting, err error)
+	GetPostingsWhereCatID(ctx context.Context, cursor model.Cursor, limit int8, catID int64) (postings []model.Posting, err error)
+	GetTimeline(ctx context.Context, cursor model.Cursor, limit int8, userID int64) (postings []model.TimelinePosting, err error)
+	GetBookmarkedPostings(ctx context.Context, cursor model.Cursor, limit int8, userID int64) (postings []model.BookmarkedPosting, err error)
+	GetPostingsWhereCollectionID(ctx context.Context, collectionID int64) (postings []model.Posting, err error)
+	GetPopular(ctx context.Context, period string, limit int8, offset int) (postings []model.Posting, err error)
+	Search(ctx context.Context, query string, limit int8, offset int) (postings []model.Posting, err error)
 	GetWhereID(ctx context.Context, id int64) (posting model.Posting, err error)
 	GetWhereIDUserID(ctx context.Context, id int64, userID int64) (posting model.Posting, err error)
-	GetCountWhereUserID(ctx context.Context, userID int64) (int64, err error)
+	GetDetailWhereID(ctx context.Context, id int64, userID int64) (posting model.PostingDetail, err error)
+	GetScheduledWhereUserID(ctx context.Context, userID int64) (postings []model.Posting, err error)
+	GetImageURLs(ctx context.Context) (imageURLs []string, err error)
+	GetDueScheduled(ctx context.Context, now time.Time, limit int) (postings []model.Posting, err error)
+	GetUntaggedAfterID(ctx context.Context, id int64, limit int) (postings []model.Posting, err error)
+	GetWithoutAltTextAfterID(ctx context.Context, id int64, limit int) (postings []model.Posting, err error)
+	UpdateImageURLWhereID(ctx context.Context, imageURL string, id int64) (err error)
+	UpdateTitleWhereID(ctx context.Context, title string, editedAt time.Time, id int64) (err error)
+	UpdateAltTextWhereID(ctx context.Context, altText string, id int64) (err error)
+	UpdatePublishAtWhereID(ctx context.Context, publishAt time.Time, id int64) (err error)
+	UpdatePublishedWhereID(ctx context.Context, id int64) (published bool, err error)
 	DeleteWhereID(ctx context.Context, id int64) (err error)
 	DeleteWhereUserID(ctx context.Context, userID int64) (err error)
 }
@@ -29,26 +47,41 @@ func NewPostingRepository(db *sql.DB) *PostingRepository {
 	}
 }
 
+// publishedCond returns the condition to exclude the scheduled postings which are not published yet and the current time to bind to it.
+// The time is taken from the app clock rather than the DB one so that both agree on what is published.
+// prefix is the table alias with a dot such as "`p`." or empty.
+func publishedCond(prefix string) (cond string, now time.Time) {
+	return "(" + prefix + "`publish_at` IS NULL OR " + prefix + "`publish_at` <= ?)", lib.NowFunc()
+}
+
 func (r *PostingRepository) Create(ctx context.Context, posting *model.Posting) (err error) {
-	q := "INSERT INTO `postings` (`user_id`, `title`, `image_url`) VALUES (?, ?, ?)"
+	// a scheduled posting is created at the time published
+	q := "INSERT INTO `postings` (`user_id`, `title`, `image_url`, `alt_text`, `publish_at`, `created_at`) VALUES (?, ?, ?, ?, ?, IFNULL(?, CURRENT_TIMESTAMP))"
+	var result sql.Result
 	tx := m.GetTransaction(ctx)
 	if tx != nil {
-		_, err = tx.ExecContext(ctx, q, posting.UserID, posting.Title, posting.ImageURL)
+		result, err = tx.ExecContext(ctx, q, posting.UserID, posting.Title, posting.ImageURL, posting.AltText, posting.PublishAt, posting.PublishAt)
 	} else {
-		_, err = r.db.ExecContext(ctx, q, posting.UserID, posting.Title, posting.ImageURL)
+		result, err = r.db.ExecContext(ctx, q, posting.UserID, posting.Title, posting.ImageURL, posting.AltText, posting.PublishAt, posting.PublishAt)
+	}
+	if err != nil {
+		return
 	}
+	posting.ID, err = result.LastInsertId()
 	return
 }
 
-func (r *PostingRepository) GetPostings(ctx context.Context, sinceAt time.Time, limit int8, userID int64) (postings []model.Posting, err error) {
+func (r *PostingRepository) GetPostings(ctx context.Context, cursor model.Cursor, limit int8, userID int64) (postings []model.Posting, err error) {
+	cond, args := olderThanCursor("", cursor)
+	pubCond, now := publishedCond("")
 	var q string
 	var rows *sql.Rows
 	if userID == 0 {
-		q = "SELECT `id`, `user_id`, `title`, `image_url`, `created_at`, `updated_at` FROM `postings` WHERE `created_at` < ? ORDER BY `created_at` DESC LIMIT ?"
-		rows, err = r.db.QueryContext(ctx, q, sinceAt, limit)
+		q = "SELECT `id`, `user_id`, `title`, `image_url`, `alt_text`, `edited_at`, `created_at`, `updated_at` FROM `postings` WHERE " + cond + " AND " + pubCond + " ORDER BY `created_at` DESC, `id` DESC LIMIT ?"
+		rows, err = r.db.QueryContext(ctx, q, append(args, now, limit)...)
 	} else {
-		q = "SELECT `id`, `user_id`, `title`, `image_url`, `created_at`, `updated_at` FROM `postings` WHERE `created_at` < ? AND `user_id` = ? ORDER BY `created_at` DESC LIMIT ?"
-		rows, err = r.db.QueryContext(ctx, q, sinceAt, userID, limit)
+		q = "SELECT `id`, `user_id`, `title`, `image_url`, `alt_text`, `edited_at`, `created_at`, `updated_at` FROM `postings` WHERE " + cond + " AND " + pubCond + " AND `user_id` = ? ORDER BY `created_at` DESC, `id` DESC LIMIT ?"
+		rows, err = r.db.QueryContext(ctx, q, append(args, now, userID, limit)...)
 	}
 	if err == sql.ErrNoRows {
 		err = ErrNotExistsData
@@ -61,7 +94,136 @@ func (r *PostingRepository) GetPostings(ctx context.Context, sinceAt time.Time,
 
 	var p model.Posting
 	for rows.Next() {
-		if err = rows.Scan(&p.ID, &p.UserID, &p.Title, &p.ImageURL, &p.CreatedAt, &p.UpdatedAt); err != nil {
+		if err = rows.Scan(&p.ID, &p.UserID, &p.Title, &p.ImageURL, &p.AltText, &p.EditedAt, &p.CreatedAt, &p.UpdatedAt); err != nil {
+			return
+		}
+		postings = append(postings, p)
+		p = model.Posting{}
+	}
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	return
+}
+
+func (r *PostingRepository) GetPostingsWhereTagID(ctx context.Context, cursor model.Cursor, limit int8, tagID int64) (postings []model.Posting, err error) {
+	cond, args := olderThanCursor("`p`.", cursor)
+	pubCond, now := publishedCond("`p`.")
+	q := "SELECT `p`.`id`, `p`.`user_id`, `p`.`title`, `p`.`image_url`, `p`.`alt_text`, `p`.`edited_at`, `p`.`created_at`, `p`.`updated_at` FROM `postings` AS `p` INNER JOIN `posting_tags` AS `pt` ON `p`.`id` = `pt`.`posting_id` WHERE `pt`.`tag_id` = ? AND " + cond + " AND " + pubCond + " ORDER BY `p`.`created_at` DESC, `p`.`id` DESC LIMIT ?"
+	rows, err := r.db.QueryContext(ctx, q, append(append([]interface{}{tagID}, args...), now, limit)...)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	var p model.Posting
+	for rows.Next() {
+		if err = rows.Scan(&p.ID, &p.UserID, &p.Title, &p.ImageURL, &p.AltText, &p.EditedAt, &p.CreatedAt, &p.UpdatedAt); err != nil {
+			return
+		}
+		postings = append(postings, p)
+		p = model.Posting{}
+	}
+	if err = rows.Err(); err != nil {
+		return
+	}
+	return
+}
+
+func (r *PostingRepository) GetPostingsWhereCatID(ctx context.Context, cursor model.Cursor, limit int8, catID int64) (postings []model.Posting, err error) {
+	cond, args := olderThanCursor("`p`.", cursor)
+	pubCond, now := publishedCond("`p`.")
+	q := "SELECT `p`.`id`, `p`.`user_id`, `p`.`title`, `p`.`image_url`, `p`.`alt_text`, `p`.`edited_at`, `p`.`created_at`, `p`.`updated_at` FROM `postings` AS `p` INNER JOIN `posting_cats` AS `pc` ON `p`.`id` = `pc`.`posting_id` WHERE `pc`.`cat_id` = ? AND " + cond + " AND " + pubCond + " ORDER BY `p`.`created_at` DESC, `p`.`id` DESC LIMIT ?"
+	rows, err := r.db.QueryContext(ctx, q, append(append([]interface{}{catID}, args...), now, limit)...)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	var p model.Posting
+	for rows.Next() {
+		if err = rows.Scan(&p.ID, &p.UserID, &p.Title, &p.ImageURL, &p.AltText, &p.EditedAt, &p.CreatedAt, &p.UpdatedAt); err != nil {
+			return
+		}
+		postings = append(postings, p)
+		p = model.Posting{}
+	}
+	if err = rows.Err(); err != nil {
+		return
+	}
+	return
+}
+
+// GetTimeline returns the postings of the users followed by the user with their user names, liked counts and whether the user likes them.
+func (r *PostingRepository) GetTimeline(ctx context.Context, cursor model.Cursor, limit int8, userID int64) (postings []model.TimelinePosting, err error) {
+	cond, args := olderThanCursor("`p`.", cursor)
+	pubCond, now := publishedCond("`p`.")
+	q := "SELECT `p`.`id`, `p`.`user_id`, `p`.`title`, `p`.`image_url`, `p`.`alt_text`, `p`.`edited_at`, `p`.`created_at`, `p`.`updated_at`, `u`.`name`, " +
+		"IFNULL(`pc`.`liked_count`, 0), " +
+		"EXISTS (SELECT 1 FROM `likes` AS `l` WHERE `l`.`posting_id` = `p`.`id` AND `l`.`user_id` = ?) " +
+		"FROM `postings` AS `p` INNER JOIN `follows` AS `f` ON `p`.`user_id` = `f`.`followed_user_id` INNER JOIN `users` AS `u` ON `p`.`user_id` = `u`.`id` " +
+		"LEFT JOIN `posting_counters` AS `pc` ON `p`.`id` = `pc`.`posting_id` " +
+		"WHERE `f`.`following_user_id` = ? AND " + cond + " AND " + pubCond + " ORDER BY `p`.`created_at` DESC, `p`.`id` DESC LIMIT ?"
+	rows, err := r.db.QueryContext(ctx, q, append(append([]interface{}{userID, userID}, args...), now, limit)...)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	var p model.TimelinePosting
+	for rows.Next() {
+		if err = rows.Scan(&p.ID, &p.UserID, &p.Title, &p.ImageURL, &p.AltText, &p.EditedAt, &p.CreatedAt, &p.UpdatedAt, &p.UserName, &p.LikedCount, &p.Liked); err != nil {
+			return
+		}
+		postings = append(postings, p)
+		p = model.TimelinePosting{}
+	}
+	if err = rows.Err(); err != nil {
+		return
+	}
+	return
+}
+
+// GetBookmarkedPostings returns the postings bookmarked by the user in descending order of when they were bookmarked.
+// The cursor points at a bookmark, not at a posting.
+func (r *PostingRepository) GetBookmarkedPostings(ctx context.Context, cursor model.Cursor, limit int8, userID int64) (postings []model.BookmarkedPosting, err error) {
+	cond, args := olderThanCursor("`b`.", cursor)
+	pubCond, now := publishedCond("`p`.")
+	q := "SELECT `p`.`id`, `p`.`user_id`, `p`.`title`, `p`.`image_url`, `p`.`alt_text`, `p`.`edited_at`, `p`.`created_at`, `p`.`updated_at`, `b`.`id`, `b`.`created_at` FROM `postings` AS `p` INNER JOIN `bookmarks` AS `b` ON `p`.`id` = `b`.`posting_id` WHERE `b`.`user_id` = ? AND " + cond + " AND " + pubCond + " ORDER BY `b`.`created_at` DESC, `b`.`id` DESC LIMIT ?"
+	rows, err := r.db.QueryContext(ctx, q, append(append([]interface{}{userID}, args...), now, limit)...)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	var p model.BookmarkedPosting
+	for rows.Next() {
+		if err = rows.Scan(&p.ID, &p.UserID, &p.Title, &p.ImageURL, &p.AltText, &p.EditedAt, &p.CreatedAt, &p.UpdatedAt, &p.BookmarkID, &p.BookmarkedAt); err != nil {
+			return
+		}
+		postings = append(postings, p)
+		p = model.BookmarkedPosting{}
+	}
+	if err = rows.Err(); err != nil {
+		return
+	}
+	return
+}
+
+// GetPostingsWhereCollectionID returns the postings in the collection in the order the owner arranged.
+func (r *PostingRepository) GetPostingsWhereCollectionID(ctx context.Context, collectionID int64) (postings []model.Posting, err error) {
+	pubCond, now := publishedCond("`p`.")
+	q := "SELECT `p`.`id`, `p`.`user_id`, `p`.`title`, `p`.`image_url`, `p`.`alt_text`, `p`.`edited_at`, `p`.`created_at`, `p`.`updated_at` FROM `postings` AS `p` INNER JOIN `collection_postings` AS `cp` ON `p`.`id` = `cp`.`posting_id` WHERE `cp`.`collection_id` = ? AND " + pubCond + " ORDER BY `cp`.`position`, `cp`.`id`"
+	rows, err := r.db.QueryContext(ctx, q, collectionID, now)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	var p model.Posting
+	for rows.Next() {
+		if err = rows.Scan(&p.ID, &p.UserID, &p.Title, &p.ImageURL, &p.AltText, &p.EditedAt, &p.CreatedAt, &p.UpdatedAt); err != nil {
 			return
 		}
 		postings = append(postings, p)
@@ -70,13 +232,64 @@ func (r *PostingRepository) GetPostings(ctx context.Context, sinceAt time.Time,
 	if err = rows.Err(); err != nil {
 		return
 	}
+	return
+}
 
+// GetPopular returns postings in descending order of the precomputed score of the period.
+func (r *PostingRepository) GetPopular(ctx context.Context, period string, limit int8, offset int) (postings []model.Posting, err error) {
+	pubCond, now := publishedCond("`p`.")
+	q := "SELECT `p`.`id`, `p`.`user_id`, `p`.`title`, `p`.`image_url`, `p`.`alt_text`, `p`.`edited_at`, `p`.`created_at`, `p`.`updated_at` FROM `posting_scores` AS `s` INNER JOIN `postings` AS `p` ON `s`.`posting_id` = `p`.`id` WHERE `s`.`period` = ? AND " + pubCond + " ORDER BY `s`.`score` DESC, `p`.`id` DESC LIMIT ? OFFSET ?"
+	rows, err := r.db.QueryContext(ctx, q, period, now, limit, offset)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	var p model.Posting
+	for rows.Next() {
+		if err = rows.Scan(&p.ID, &p.UserID, &p.Title, &p.ImageURL, &p.AltText, &p.EditedAt, &p.CreatedAt, &p.UpdatedAt); err != nil {
+			return
+		}
+		postings = append(postings, p)
+		p = model.Posting{}
+	}
+	if err = rows.Err(); err != nil {
+		return
+	}
+	return
+}
+
+// Search returns postings whose title matches the query in descending order of relevance.
+func (r *PostingRepository) Search(ctx context.Context, query string, limit int8, offset int) (postings []model.Posting, err error) {
+	pubCond, now := publishedCond("")
+	q := "SELECT `id`, `user_id`, `title`, `image_url`, `alt_text`, `edited_at`, `created_at`, `updated_at` FROM `postings` WHERE MATCH (`title`) AGAINST (? IN BOOLEAN MODE) AND " + pubCond + " ORDER BY MATCH (`title`) AGAINST (? IN BOOLEAN MODE) DESC, `id` DESC LIMIT ? OFFSET ?"
+	booleanQuery := toBooleanModeQuery(query)
+	if booleanQuery == "" {
+		return
+	}
+	rows, err := r.db.QueryContext(ctx, q, booleanQuery, now, booleanQuery, limit, offset)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	var p model.Posting
+	for rows.Next() {
+		if err = rows.Scan(&p.ID, &p.UserID, &p.Title, &p.ImageURL, &p.AltText, &p.EditedAt, &p.CreatedAt, &p.UpdatedAt); err != nil {
+			return
+		}
+		postings = append(postings, p)
+		p = model.Posting{}
+	}
+	if err = rows.Err(); err != nil {
+		return
+	}
 	return
 }
 
 func (r *PostingRepository) GetWhereID(ctx context.Context, id int64) (posting model.Posting, err error) {
-	q := "SELECT `id`, `user_id`, `title`, `image_url`, `created_at`, `updated_at` FROM `postings` WHERE `id` = ?"
-	err = r.db.QueryRowContext(ctx, q, id).Scan(&posting.ID, &posting.UserID, &posting.Title, &posting.ImageURL, &posting.CreatedAt, &posting.UpdatedAt)
+	q := "SELECT `id`, `user_id`, `title`, `image_url`, `alt_text`, `edited_at`, `publish_at`, `created_at`, `updated_at` FROM `postings` WHERE `id` = ?"
+	err = r.db.QueryRowContext(ctx, q, id).Scan(&posting.ID, &posting.UserID, &posting.Title, &posting.ImageURL, &posting.AltText, &posting.EditedAt, &posting.PublishAt, &posting.CreatedAt, &posting.UpdatedAt)
 	if err == sql.ErrNoRows {
 		err = ErrNotExistsData
 		return
@@ -85,8 +298,25 @@ func (r *PostingRepository) GetWhereID(ctx context.Context, id int64) (posting m
 }
 
 func (r *PostingRepository) GetWhereIDUserID(ctx context.Context, id int64, userID int64) (posting model.Posting, err error) {
-	q := "SELECT `id`, `user_id`, `title`, `image_url`, `created_at`, `updated_at` FROM `postings` WHERE `id` = ? AND `user_id` = ?"
-	err = r.db.QueryRowContext(ctx, q, id, userID).Scan(&posting.ID, &posting.UserID, &posting.Title, &posting.ImageURL, &posting.CreatedAt, &posting.UpdatedAt)
+	q := "SELECT `id`, `user_id`, `title`, `image_url`, `alt_text`, `edited_at`, `publish_at`, `created_at`, `updated_at` FROM `postings` WHERE `id` = ? AND `user_id` = ?"
+	err = r.db.QueryRowContext(ctx, q, id, userID).Scan(&posting.ID, &posting.UserID, &posting.Title, &posting.ImageURL, &posting.AltText, &posting.EditedAt, &posting.PublishAt, &posting.CreatedAt, &posting.UpdatedAt)
+	if err == sql.ErrNoRows {
+		err = ErrNotExistsData
+		return
+	}
+	return
+}
+
+// GetDetailWhereID returns the posting with its user, liked count, comment count and whether the user likes it.
+// A scheduled posting is returned only to its owner.
+func (r *PostingRepository) GetDetailWhereID(ctx context.Context, id int64, userID int64) (posting model.PostingDetail, err error) {
+	pubCond, now := publishedCond("`p`.")
+	q := "SELECT `p`.`id`, `p`.`user_id`, `p`.`title`, `p`.`image_url`, `p`.`alt_text`, `p`.`edited_at`, `p`.`publish_at`, `p`.`created_at`, `p`.`updated_at`, `u`.`name`, `u`.`icon`, " +
+		"IFNULL(`pc`.`liked_count`, 0), IFNULL(`pc`.`comment_count`, 0), " +
+		"EXISTS (SELECT 1 FROM `likes` AS `l` WHERE `l`.`posting_id` = `p`.`id` AND `l`.`user_id` = ?) " +
+		"FROM `postings` AS `p` INNER JOIN `users` AS `u` ON `p`.`user_id` = `u`.`id` LEFT JOIN `posting_counters` AS `pc` ON `p`.`id` = `pc`.`posting_id` WHERE `p`.`id` = ? AND (" + pubCond + " OR `p`.`user_id` = ?)"
+	err = r.db.QueryRowContext(ctx, q, userID, id, now, userID).Scan(&posting.ID, &posting.UserID, &posting.Title, &posting.ImageURL, &posting.AltText, &posting.EditedAt, &posting.PublishAt, &posting.CreatedAt, &posting.UpdatedAt,
+		&posting.UserName, &posting.UserIcon, &posting.LikedCount, &posting.CommentCount, &posting.Liked)
 	if err == sql.ErrNoRows {
 		err = ErrNotExistsData
 		return
@@ -94,9 +324,186 @@ func (r *PostingRepository) GetWhereIDUserID(ctx context.Context, id int64, user
 	return
 }
 
-func (r *PostingRepository) GetCountWhereUserID(ctx context.Context, userID int64) (count int64, err error) {
-	q := "SELECT COUNT(*) FROM `postings` WHERE `user_id` = ?"
-	err = r.db.QueryRowContext(ctx, q, userID).Scan(&count)
+// GetScheduledWhereUserID returns the postings of the user which are not published yet in the order they will be published.
+func (r *PostingRepository) GetScheduledWhereUserID(ctx context.Context, userID int64) (postings []model.Posting, err error) {
+	pubCond, now := publishedCond("")
+	q := "SELECT `id`, `user_id`, `title`, `image_url`, `alt_text`, `edited_at`, `publish_at`, `created_at`, `updated_at` FROM `postings` WHERE `user_id` = ? AND NOT " + pubCond + " ORDER BY `publish_at`, `id`"
+	rows, err := r.db.QueryContext(ctx, q, userID, now)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	var p model.Posting
+	for rows.Next() {
+		if err = rows.Scan(&p.ID, &p.UserID, &p.Title, &p.ImageURL, &p.AltText, &p.EditedAt, &p.PublishAt, &p.CreatedAt, &p.UpdatedAt); err != nil {
+			return
+		}
+		postings = append(postings, p)
+		p = model.Posting{}
+	}
+	if err = rows.Err(); err != nil {
+		return
+	}
+	return
+}
+
+// GetImageURLs returns the cover image URLs of all the postings.
+func (r *PostingRepository) GetImageURLs(ctx context.Context) (imageURLs []string, err error) {
+	q := "SELECT `image_url` FROM `postings`"
+	rows, err := r.db.QueryContext(ctx, q)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	var s string
+	for rows.Next() {
+		if err = rows.Scan(&s); err != nil {
+			return
+		}
+		imageURLs = append(imageURLs, s)
+	}
+	if err = rows.Err(); err != nil {
+		return
+	}
+	return
+}
+
+// GetDueScheduled returns the scheduled postings whose publish_at has come by now in the order they were published.
+func (r *PostingRepository) GetDueScheduled(ctx context.Context, now time.Time, limit int) (postings []model.Posting, err error) {
+	q := "SELECT `id`, `user_id`, `title`, `image_url`, `alt_text`, `edited_at`, `publish_at`, `created_at`, `updated_at` FROM `postings` WHERE `publish_at` <= ? ORDER BY `publish_at`, `id` LIMIT ?"
+	rows, err := r.db.QueryContext(ctx, q, now, limit)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	var p model.Posting
+	for rows.Next() {
+		if err = rows.Scan(&p.ID, &p.UserID, &p.Title, &p.ImageURL, &p.AltText, &p.EditedAt, &p.PublishAt, &p.CreatedAt, &p.UpdatedAt); err != nil {
+			return
+		}
+		postings = append(postings, p)
+		p = model.Posting{}
+	}
+	if err = rows.Err(); err != nil {
+		return
+	}
+	return
+}
+
+// GetUntaggedAfterID returns the postings which have no tags linked in ascending order of the id from the one after id.
+func (r *PostingRepository) GetUntaggedAfterID(ctx context.Context, id int64, limit int) (postings []model.Posting, err error) {
+	q := "SELECT `id`, `user_id`, `title`, `image_url`, `alt_text`, `edited_at`, `publish_at`, `created_at`, `updated_at` FROM `postings` AS `p` " +
+		"WHERE `p`.`id` > ? AND NOT EXISTS (SELECT 1 FROM `posting_tags` AS `pt` WHERE `pt`.`posting_id` = `p`.`id`) ORDER BY `p`.`id` LIMIT ?"
+	rows, err := r.db.QueryContext(ctx, q, id, limit)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	var p model.Posting
+	for rows.Next() {
+		if err = rows.Scan(&p.ID, &p.UserID, &p.Title, &p.ImageURL, &p.AltText, &p.EditedAt, &p.PublishAt, &p.CreatedAt, &p.UpdatedAt); err != nil {
+			return
+		}
+		postings = append(postings, p)
+		p = model.Posting{}
+	}
+	if err = rows.Err(); err != nil {
+		return
+	}
+	return
+}
+
+// GetWithoutAltTextAfterID returns the postings whose alt text is empty in ascending order of the id from the one after id.
+func (r *PostingRepository) GetWithoutAltTextAfterID(ctx context.Context, id int64, limit int) (postings []model.Posting, err error) {
+	q := "SELECT `id`, `user_id`, `title`, `image_url`, `alt_text`, `edited_at`, `publish_at`, `created_at`, `updated_at` FROM `postings` WHERE `id` > ? AND `alt_text` = '' ORDER BY `id` LIMIT ?"
+	rows, err := r.db.QueryContext(ctx, q, id, limit)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	var p model.Posting
+	for rows.Next() {
+		if err = rows.Scan(&p.ID, &p.UserID, &p.Title, &p.ImageURL, &p.AltText, &p.EditedAt, &p.PublishAt, &p.CreatedAt, &p.UpdatedAt); err != nil {
+			return
+		}
+		postings = append(postings, p)
+		p = model.Posting{}
+	}
+	if err = rows.Err(); err != nil {
+		return
+	}
+	return
+}
+
+func (r *PostingRepository) UpdateTitleWhereID(ctx context.Context, title string, editedAt time.Time, id int64) (err error) {
+	q := "UPDATE `postings` SET `title` = ?, `edited_at` = ? WHERE `id` = ?"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, title, editedAt, id)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, title, editedAt, id)
+	}
+	return
+}
+
+func (r *PostingRepository) UpdateAltTextWhereID(ctx context.Context, altText string, id int64) (err error) {
+	q := "UPDATE `postings` SET `alt_text` = ? WHERE `id` = ?"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, altText, id)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, altText, id)
+	}
+	return
+}
+
+// UpdatePublishAtWhereID reschedules the posting. created_at follows publish_at as in Create.
+// A posting already marked as published is left as it is.
+func (r *PostingRepository) UpdatePublishAtWhereID(ctx context.Context, publishAt time.Time, id int64) (err error) {
+	q := "UPDATE `postings` SET `publish_at` = ?, `created_at` = ? WHERE `id` = ? AND `publish_at` IS NOT NULL"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, publishAt, publishAt, id)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, publishAt, publishAt, id)
+	}
+	return
+}
+
+// UpdatePublishedWhereID marks the scheduled posting as published by clearing publish_at,
+// and reports whether it was still scheduled, so that it is done only once for a posting.
+func (r *PostingRepository) UpdatePublishedWhereID(ctx context.Context, id int64) (published bool, err error) {
+	q := "UPDATE `postings` SET `publish_at` = NULL WHERE `id` = ? AND `publish_at` IS NOT NULL"
+	var result sql.Result
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		result, err = tx.ExecContext(ctx, q, id)
+	} else {
+		result, err = r.db.ExecContext(ctx, q, id)
+	}
+	if err != nil {
+		return
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return
+	}
+	return n == 1, nil
+}
+
+func (r *PostingRepository) UpdateImageURLWhereID(ctx context.Context, imageURL string, id int64) (err error) {
+	q := "UPDATE `postings` SET `image_url` = ? WHERE `id` = ?"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, imageURL, id)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, imageURL, id)
+	}
 	return
 }
 
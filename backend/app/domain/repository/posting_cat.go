@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	m "github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+)
+
+type PostingCatRepositoryInterface interface {
+	Create(ctx context.Context, postingCat *model.PostingCat) (err error)
+	DeleteWherePostingID(ctx context.Context, postingID int64) (err error)
+	DeleteWhereCatID(ctx context.Context, catID int64) (err error)
+	DeleteWhereInPostingIDs(ctx context.Context, userID int64) (err error)
+}
+
+type PostingCatRepository struct {
+	db *sql.DB
+}
+
+func NewPostingCatRepository(db *sql.DB) *PostingCatRepository {
+	return &PostingCatRepository{
+		db: db,
+	}
+}
+
+func (r *PostingCatRepository) Create(ctx context.Context, postingCat *model.PostingCat) (err error) {
+	q := "INSERT INTO `posting_cats` (`posting_id`, `cat_id`) VALUES (?, ?)"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, postingCat.PostingID, postingCat.CatID)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, postingCat.PostingID, postingCat.CatID)
+	}
+	return
+}
+
+func (r *PostingCatRepository) DeleteWherePostingID(ctx context.Context, postingID int64) (err error) {
+	q := "DELETE FROM `posting_cats` WHERE `posting_id` = ?"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, postingID)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, postingID)
+	}
+	return
+}
+
+func (r *PostingCatRepository) DeleteWhereCatID(ctx context.Context, catID int64) (err error) {
+	q := "DELETE FROM `posting_cats` WHERE `cat_id` = ?"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, catID)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, catID)
+	}
+	return
+}
+
+// DeleteWhereInPostingIDs deletes cats of all the postings of the user.
+// A posting is tagged only with cats of its owner, so this also removes every tag of the user's cats.
+func (r *PostingCatRepository) DeleteWhereInPostingIDs(ctx context.Context, userID int64) (err error) {
+	q := "DELETE FROM `posting_cats` WHERE `posting_id` IN (SELECT `id` FROM `postings` WHERE `user_id` = ?)"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, userID)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, userID)
+	}
+	return
+}
@@ -0,0 +1,177 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	m "github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+)
+
+type PostingCounterRepositoryInterface interface {
+	GetWherePostingIDs(ctx context.Context, postingIDs []int64) (counters map[int64]model.PostingCounter, err error)
+	GetPostingIDsReactedByUserID(ctx context.Context, userID int64) (postingIDs []int64, err error)
+	GetDriftedAfterPostingID(ctx context.Context, postingID int64, limit int) (driftedPostingIDs []int64, lastPostingID int64, err error)
+	Add(ctx context.Context, delta *model.PostingCounter) (err error)
+	Recount(ctx context.Context, postingIDs []int64) (err error)
+	DeleteWherePostingID(ctx context.Context, postingID int64) (err error)
+	DeleteWhereInPostingIDs(ctx context.Context, userID int64) (err error)
+}
+
+// postingCounts counts the likes and the comments of the posting `p` in the order of the columns of posting_counters.
+const postingCounts = "(SELECT COUNT(*) FROM `likes` AS `l` WHERE `l`.`posting_id` = `p`.`id`), " +
+	"(SELECT COUNT(*) FROM `comments` AS `c` WHERE `c`.`posting_id` = `p`.`id`)"
+
+type PostingCounterRepository struct {
+	db *sql.DB
+}
+
+func NewPostingCounterRepository(db *sql.DB) *PostingCounterRepository {
+	return &PostingCounterRepository{
+		db: db,
+	}
+}
+
+// GetWherePostingIDs returns the counters by the posting ID. A posting without a counter has nothing yet and is omitted.
+func (r *PostingCounterRepository) GetWherePostingIDs(ctx context.Context, postingIDs []int64) (counters map[int64]model.PostingCounter, err error) {
+	counters = make(map[int64]model.PostingCounter, len(postingIDs))
+	if len(postingIDs) == 0 {
+		return
+	}
+	placeholders := make([]string, 0, len(postingIDs))
+	args := make([]interface{}, 0, len(postingIDs))
+	for _, id := range postingIDs {
+		placeholders = append(placeholders, "?")
+		args = append(args, id)
+	}
+	q := "SELECT `id`, `posting_id`, `liked_count`, `comment_count`, `created_at`, `updated_at` FROM `posting_counters` WHERE `posting_id` IN (" + strings.Join(placeholders, ", ") + ")"
+	rows, err := r.db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	var c model.PostingCounter
+	for rows.Next() {
+		if err = rows.Scan(&c.ID, &c.PostingID, &c.LikedCount, &c.CommentCount, &c.CreatedAt, &c.UpdatedAt); err != nil {
+			return
+		}
+		counters[c.PostingID] = c
+	}
+	if err = rows.Err(); err != nil {
+		return
+	}
+	return
+}
+
+// GetPostingIDsReactedByUserID returns the postings liked or commented on by the user.
+func (r *PostingCounterRepository) GetPostingIDsReactedByUserID(ctx context.Context, userID int64) (postingIDs []int64, err error) {
+	q := "SELECT `posting_id` FROM `likes` WHERE `user_id` = ? UNION SELECT `posting_id` FROM `comments` WHERE `user_id` = ?"
+	rows, err := r.db.QueryContext(ctx, q, userID, userID)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	var id int64
+	for rows.Next() {
+		if err = rows.Scan(&id); err != nil {
+			return
+		}
+		postingIDs = append(postingIDs, id)
+	}
+	if err = rows.Err(); err != nil {
+		return
+	}
+	return
+}
+
+// GetDriftedAfterPostingID checks the counters of at most limit postings after the posting ID in the order of the ID,
+// and returns the postings whose counters differ from the likes and the comments, and the last posting checked.
+// lastPostingID is 0 when there are no more postings.
+func (r *PostingCounterRepository) GetDriftedAfterPostingID(ctx context.Context, postingID int64, limit int) (driftedPostingIDs []int64, lastPostingID int64, err error) {
+	q := "SELECT `p`.`id`, (IFNULL(`pc`.`liked_count`, 0), IFNULL(`pc`.`comment_count`, 0)) <> (" + postingCounts + ") " +
+		"FROM (SELECT `id` FROM `postings` WHERE `id` > ? ORDER BY `id` LIMIT ?) AS `p` LEFT JOIN `posting_counters` AS `pc` ON `pc`.`posting_id` = `p`.`id` ORDER BY `p`.`id`"
+	rows, err := r.db.QueryContext(ctx, q, postingID, limit)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	var id int64
+	var drifted bool
+	for rows.Next() {
+		if err = rows.Scan(&id, &drifted); err != nil {
+			return
+		}
+		if drifted {
+			driftedPostingIDs = append(driftedPostingIDs, id)
+		}
+		lastPostingID = id
+	}
+	if err = rows.Err(); err != nil {
+		return
+	}
+	return
+}
+
+// Add adds the counts of delta to the counter of delta.PostingID. A negative count subtracts.
+func (r *PostingCounterRepository) Add(ctx context.Context, delta *model.PostingCounter) (err error) {
+	q := "INSERT INTO `posting_counters` (`posting_id`, `liked_count`, `comment_count`) VALUES (?, GREATEST(?, 0), GREATEST(?, 0)) " +
+		"ON DUPLICATE KEY UPDATE `liked_count` = `liked_count` + ?, `comment_count` = `comment_count` + ?"
+	args := []interface{}{delta.PostingID, delta.LikedCount, delta.CommentCount, delta.LikedCount, delta.CommentCount}
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, args...)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, args...)
+	}
+	return
+}
+
+// Recount sets the counters of the postings to the numbers of their likes and comments.
+// It is used where adding is impractical, e.g. when the likes of a user are deleted at once, and to repair drifted counters.
+func (r *PostingCounterRepository) Recount(ctx context.Context, postingIDs []int64) (err error) {
+	if len(postingIDs) == 0 {
+		return
+	}
+	placeholders := make([]string, 0, len(postingIDs))
+	args := make([]interface{}, 0, len(postingIDs))
+	for _, id := range postingIDs {
+		placeholders = append(placeholders, "?")
+		args = append(args, id)
+	}
+	q := "INSERT INTO `posting_counters` (`posting_id`, `liked_count`, `comment_count`) " +
+		"SELECT `p`.`id`, " + postingCounts + " FROM `postings` AS `p` WHERE `p`.`id` IN (" + strings.Join(placeholders, ", ") + ") " +
+		"ON DUPLICATE KEY UPDATE `liked_count` = VALUES(`liked_count`), `comment_count` = VALUES(`comment_count`)"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, args...)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, args...)
+	}
+	return
+}
+
+func (r *PostingCounterRepository) DeleteWherePostingID(ctx context.Context, postingID int64) (err error) {
+	q := "DELETE FROM `posting_counters` WHERE `posting_id` = ?"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, postingID)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, postingID)
+	}
+	return
+}
+
+func (r *PostingCounterRepository) DeleteWhereInPostingIDs(ctx context.Context, userID int64) (err error) {
+	q := "DELETE FROM `posting_counters` WHERE `posting_id` IN (SELECT `id` FROM `postings` WHERE `user_id` = ?)"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, userID)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, userID)
+	}
+	return
+}
@@ -0,0 +1,218 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	m "github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+)
+
+type PostingImageRepositoryInterface interface {
+	Create(ctx context.Context, image *model.PostingImage) (err error)
+	GetWherePostingID(ctx context.Context, postingID int64) (images []model.PostingImage, err error)
+	GetWhereUserID(ctx context.Context, userID int64) (images []model.PostingImage, err error)
+	GetWhereObjectKeyUnprefixed(ctx context.Context) (images []model.PostingImage, err error)
+	GetObjectKeys(ctx context.Context) (objectKeys []string, err error)
+	ExistsObjectKeyInOtherPostings(ctx context.Context, objectKey string, postingID int64) (exists bool, err error)
+	ExistsSimilarWhereUserID(ctx context.Context, dhash uint64, maxDistance int, since time.Time, userID int64) (exists bool, err error)
+	GetSimilarOfOtherUsers(ctx context.Context, maxDistance int, postingID int64) (images []model.SimilarImage, err error)
+	UpdateObjectWhereID(ctx context.Context, imageURL, objectKey string, id int64) (err error)
+	DeleteWherePostingID(ctx context.Context, postingID int64) (err error)
+	DeleteWhereInPostingIDs(ctx context.Context, userID int64) (err error)
+}
+
+type PostingImageRepository struct {
+	db *sql.DB
+}
+
+func NewPostingImageRepository(db *sql.DB) *PostingImageRepository {
+	return &PostingImageRepository{
+		db: db,
+	}
+}
+
+func (r *PostingImageRepository) Create(ctx context.Context, image *model.PostingImage) (err error) {
+	q := "INSERT INTO `posting_images` (`posting_id`, `position`, `image_url`, `object_key`, `dhash`) VALUES (?, ?, ?, ?, ?)"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, image.PostingID, image.Position, image.ImageURL, image.ObjectKey, image.DHash)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, image.PostingID, image.Position, image.ImageURL, image.ObjectKey, image.DHash)
+	}
+	return
+}
+
+func (r *PostingImageRepository) GetWherePostingID(ctx context.Context, postingID int64) (images []model.PostingImage, err error) {
+	q := "SELECT `id`, `posting_id`, `position`, `image_url`, `object_key`, `dhash`, `created_at`, `updated_at` FROM `posting_images` WHERE `posting_id` = ? ORDER BY `position`"
+	rows, err := r.db.QueryContext(ctx, q, postingID)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	var i model.PostingImage
+	for rows.Next() {
+		if err = rows.Scan(&i.ID, &i.PostingID, &i.Position, &i.ImageURL, &i.ObjectKey, &i.DHash, &i.CreatedAt, &i.UpdatedAt); err != nil {
+			return
+		}
+		images = append(images, i)
+		i = model.PostingImage{}
+	}
+	if err = rows.Err(); err != nil {
+		return
+	}
+	return
+}
+
+// GetWhereObjectKeyUnprefixed returns images stored under the keys made before they were derived from the content.
+func (r *PostingImageRepository) GetWhereObjectKeyUnprefixed(ctx context.Context) (images []model.PostingImage, err error) {
+	q := "SELECT `id`, `posting_id`, `position`, `image_url`, `object_key`, `dhash`, `created_at`, `updated_at` FROM `posting_images` WHERE `object_key` NOT LIKE '%/%' ORDER BY `id`"
+	rows, err := r.db.QueryContext(ctx, q)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	var i model.PostingImage
+	for rows.Next() {
+		if err = rows.Scan(&i.ID, &i.PostingID, &i.Position, &i.ImageURL, &i.ObjectKey, &i.DHash, &i.CreatedAt, &i.UpdatedAt); err != nil {
+			return
+		}
+		images = append(images, i)
+		i = model.PostingImage{}
+	}
+	if err = rows.Err(); err != nil {
+		return
+	}
+	return
+}
+
+// GetWhereUserID returns images of all the postings of the user.
+func (r *PostingImageRepository) GetWhereUserID(ctx context.Context, userID int64) (images []model.PostingImage, err error) {
+	q := "SELECT `id`, `posting_id`, `position`, `image_url`, `object_key`, `dhash`, `created_at`, `updated_at` FROM `posting_images` WHERE `posting_id` IN (SELECT `id` FROM `postings` WHERE `user_id` = ?) ORDER BY `posting_id`, `position`"
+	rows, err := r.db.QueryContext(ctx, q, userID)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	var i model.PostingImage
+	for rows.Next() {
+		if err = rows.Scan(&i.ID, &i.PostingID, &i.Position, &i.ImageURL, &i.ObjectKey, &i.DHash, &i.CreatedAt, &i.UpdatedAt); err != nil {
+			return
+		}
+		images = append(images, i)
+		i = model.PostingImage{}
+	}
+	if err = rows.Err(); err != nil {
+		return
+	}
+	return
+}
+
+// GetObjectKeys returns the object keys of all the images.
+func (r *PostingImageRepository) GetObjectKeys(ctx context.Context) (objectKeys []string, err error) {
+	q := "SELECT `object_key` FROM `posting_images`"
+	rows, err := r.db.QueryContext(ctx, q)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	var s string
+	for rows.Next() {
+		if err = rows.Scan(&s); err != nil {
+			return
+		}
+		objectKeys = append(objectKeys, s)
+	}
+	if err = rows.Err(); err != nil {
+		return
+	}
+	return
+}
+
+// ExistsObjectKeyInOtherPostings reports whether a posting other than the one refers to the object.
+// Keys are derived from the content, so the same image posted twice shares its objects.
+func (r *PostingImageRepository) ExistsObjectKeyInOtherPostings(ctx context.Context, objectKey string, postingID int64) (exists bool, err error) {
+	q := "SELECT EXISTS (SELECT 1 FROM `posting_images` WHERE `object_key` = ? AND `posting_id` <> ?)"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, q, objectKey, postingID).Scan(&exists)
+	} else {
+		err = r.db.QueryRowContext(ctx, q, objectKey, postingID).Scan(&exists)
+	}
+	return
+}
+
+// ExistsSimilarWhereUserID reports whether the user posted an image within maxDistance of dhash since the time.
+func (r *PostingImageRepository) ExistsSimilarWhereUserID(ctx context.Context, dhash uint64, maxDistance int, since time.Time, userID int64) (exists bool, err error) {
+	q := "SELECT EXISTS (SELECT 1 FROM `posting_images` AS `pi` INNER JOIN `postings` AS `p` ON `pi`.`posting_id` = `p`.`id` WHERE `p`.`user_id` = ? AND `p`.`created_at` >= ? AND BIT_COUNT(`pi`.`dhash` ^ ?) <= ?)"
+	err = r.db.QueryRowContext(ctx, q, userID, since, dhash, maxDistance).Scan(&exists)
+	return
+}
+
+// GetSimilarOfOtherUsers returns images of other users within maxDistance of any image of the posting, the closest first.
+// This is for moderators to find reposts across accounts, so older ones are returned as well as newer ones.
+func (r *PostingImageRepository) GetSimilarOfOtherUsers(ctx context.Context, maxDistance int, postingID int64) (images []model.SimilarImage, err error) {
+	q := "SELECT `o`.`posting_id`, `u`.`name`, `o`.`image_url`, MIN(BIT_COUNT(`o`.`dhash` ^ `s`.`dhash`)) AS `distance`, `op`.`created_at` " +
+		"FROM `posting_images` AS `s` INNER JOIN `postings` AS `sp` ON `s`.`posting_id` = `sp`.`id` " +
+		"INNER JOIN `posting_images` AS `o` ON BIT_COUNT(`o`.`dhash` ^ `s`.`dhash`) <= ? " +
+		"INNER JOIN `postings` AS `op` ON `o`.`posting_id` = `op`.`id` AND `op`.`user_id` <> `sp`.`user_id` " +
+		"INNER JOIN `users` AS `u` ON `op`.`user_id` = `u`.`id` " +
+		"WHERE `s`.`posting_id` = ? GROUP BY `o`.`id`, `o`.`posting_id`, `u`.`name`, `o`.`image_url`, `op`.`created_at` ORDER BY `distance`, `op`.`created_at`"
+	rows, err := r.db.QueryContext(ctx, q, maxDistance, postingID)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	var i model.SimilarImage
+	for rows.Next() {
+		if err = rows.Scan(&i.PostingID, &i.UserName, &i.ImageURL, &i.Distance, &i.CreatedAt); err != nil {
+			return
+		}
+		images = append(images, i)
+		i = model.SimilarImage{}
+	}
+	if err = rows.Err(); err != nil {
+		return
+	}
+	return
+}
+
+func (r *PostingImageRepository) UpdateObjectWhereID(ctx context.Context, imageURL, objectKey string, id int64) (err error) {
+	q := "UPDATE `posting_images` SET `image_url` = ?, `object_key` = ? WHERE `id` = ?"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, imageURL, objectKey, id)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, imageURL, objectKey, id)
+	}
+	return
+}
+
+func (r *PostingImageRepository) DeleteWherePostingID(ctx context.Context, postingID int64) (err error) {
+	q := "DELETE FROM `posting_images` WHERE `posting_id` = ?"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, postingID)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, postingID)
+	}
+	return
+}
+
+// DeleteWhereInPostingIDs deletes images of all the postings of the user.
+func (r *PostingImageRepository) DeleteWhereInPostingIDs(ctx context.Context, userID int64) (err error) {
+	q := "DELETE FROM `posting_images` WHERE `posting_id` IN (SELECT `id` FROM `postings` WHERE `user_id` = ?)"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, userID)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, userID)
+	}
+	return
+}
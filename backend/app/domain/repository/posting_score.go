@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	m "github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+)
+
+type PostingScoreRepositoryInterface interface {
+	DeleteWherePeriod(ctx context.Context, period string) (err error)
+	CreateFromReactions(ctx context.Context, period string, since, now time.Time, halfLife time.Duration, likeWeight, commentWeight float64) (err error)
+}
+
+type PostingScoreRepository struct {
+	db *sql.DB
+}
+
+func NewPostingScoreRepository(db *sql.DB) *PostingScoreRepository {
+	return &PostingScoreRepository{
+		db: db,
+	}
+}
+
+func (r *PostingScoreRepository) DeleteWherePeriod(ctx context.Context, period string) (err error) {
+	q := "DELETE FROM `posting_scores` WHERE `period` = ?"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, period)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, period)
+	}
+	return
+}
+
+// CreateFromReactions scores every posting liked or commented on since the time.
+// Each like and comment is weighted and then halved every halfLife, so recent reactions count more.
+func (r *PostingScoreRepository) CreateFromReactions(ctx context.Context, period string, since, now time.Time, halfLife time.Duration, likeWeight, commentWeight float64) (err error) {
+	q := "INSERT INTO `posting_scores` (`posting_id`, `period`, `score`) " +
+		"SELECT `r`.`posting_id`, ?, SUM(`r`.`weight` * POW(0.5, GREATEST(TIMESTAMPDIFF(SECOND, `r`.`created_at`, ?), 0) / ?)) FROM (" +
+		"SELECT `posting_id`, `created_at`, ? AS `weight` FROM `likes` WHERE `created_at` >= ? " +
+		"UNION ALL SELECT `posting_id`, `created_at`, ? AS `weight` FROM `comments` WHERE `created_at` >= ?" +
+		") AS `r` GROUP BY `r`.`posting_id`"
+	args := []interface{}{period, now, halfLife.Seconds(), likeWeight, since, commentWeight, since}
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, args...)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, args...)
+	}
+	return
+}
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	m "github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+)
+
+type PostingTagRepositoryInterface interface {
+	Create(ctx context.Context, postingTag *model.PostingTag) (err error)
+	CreateWithCreatedAt(ctx context.Context, postingTag *model.PostingTag) (err error)
+	DeleteWherePostingID(ctx context.Context, postingID int64) (err error)
+	DeleteWherePostingIDTagID(ctx context.Context, postingID, tagID int64) (err error)
+	DeleteWhereInPostingIDs(ctx context.Context, userID int64) (err error)
+}
+
+type PostingTagRepository struct {
+	db *sql.DB
+}
+
+func NewPostingTagRepository(db *sql.DB) *PostingTagRepository {
+	return &PostingTagRepository{
+		db: db,
+	}
+}
+
+func (r *PostingTagRepository) Create(ctx context.Context, postingTag *model.PostingTag) (err error) {
+	q := "INSERT INTO `posting_tags` (`posting_id`, `tag_id`) VALUES (?, ?)"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, postingTag.PostingID, postingTag.TagID)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, postingTag.PostingID, postingTag.TagID)
+	}
+	return
+}
+
+// CreateWithCreatedAt links the tag as if it had been linked at postingTag.CreatedAt.
+func (r *PostingTagRepository) CreateWithCreatedAt(ctx context.Context, postingTag *model.PostingTag) (err error) {
+	q := "INSERT INTO `posting_tags` (`posting_id`, `tag_id`, `created_at`) VALUES (?, ?, ?)"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, postingTag.PostingID, postingTag.TagID, postingTag.CreatedAt)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, postingTag.PostingID, postingTag.TagID, postingTag.CreatedAt)
+	}
+	return
+}
+
+func (r *PostingTagRepository) DeleteWherePostingID(ctx context.Context, postingID int64) (err error) {
+	q := "DELETE FROM `posting_tags` WHERE `posting_id` = ?"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, postingID)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, postingID)
+	}
+	return
+}
+
+func (r *PostingTagRepository) DeleteWherePostingIDTagID(ctx context.Context, postingID, tagID int64) (err error) {
+	q := "DELETE FROM `posting_tags` WHERE `posting_id` = ? AND `tag_id` = ?"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, postingID, tagID)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, postingID, tagID)
+	}
+	return
+}
+
+// DeleteWhereInPostingIDs deletes tags of all the postings of the user.
+func (r *PostingTagRepository) DeleteWhereInPostingIDs(ctx context.Context, userID int64) (err error) {
+	q := "DELETE FROM `posting_tags` WHERE `posting_id` IN (SELECT `id` FROM `postings` WHERE `user_id` = ?)"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, userID)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, userID)
+	}
+	return
+}
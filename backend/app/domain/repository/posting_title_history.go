@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	m "github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+)
+
+type PostingTitleHistoryRepositoryInterface interface {
+	Create(ctx context.Context, history *model.PostingTitleHistory) (err error)
+	GetWherePostingID(ctx context.Context, postingID int64) (histories []model.PostingTitleHistory, err error)
+}
+
+type PostingTitleHistoryRepository struct {
+	db *sql.DB
+}
+
+func NewPostingTitleHistoryRepository(db *sql.DB) *PostingTitleHistoryRepository {
+	return &PostingTitleHistoryRepository{
+		db: db,
+	}
+}
+
+func (r *PostingTitleHistoryRepository) Create(ctx context.Context, history *model.PostingTitleHistory) (err error) {
+	q := "INSERT INTO `posting_title_histories` (`posting_id`, `title`) VALUES (?, ?)"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, history.PostingID, history.Title)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, history.PostingID, history.Title)
+	}
+	return
+}
+
+// GetWherePostingID returns old titles of the posting from the oldest. This is for moderators.
+func (r *PostingTitleHistoryRepository) GetWherePostingID(ctx context.Context, postingID int64) (histories []model.PostingTitleHistory, err error) {
+	q := "SELECT `id`, `posting_id`, `title`, `created_at`, `updated_at` FROM `posting_title_histories` WHERE `posting_id` = ? ORDER BY `id`"
+	rows, err := r.db.QueryContext(ctx, q, postingID)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	var h model.PostingTitleHistory
+	for rows.Next() {
+		if err = rows.Scan(&h.ID, &h.PostingID, &h.Title, &h.CreatedAt, &h.UpdatedAt); err != nil {
+			return
+		}
+		histories = append(histories, h)
+		h = model.PostingTitleHistory{}
+	}
+	if err = rows.Err(); err != nil {
+		return
+	}
+	return
+}
@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	m "github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+)
+
+type PostingViewRepositoryInterface interface {
+	CreateIgnoringDuplicates(ctx context.Context, views []model.PostingView) (err error)
+	GetDailyStatsWherePostingID(ctx context.Context, postingID int64, since time.Time) (stats []model.PostingDailyStats, err error)
+	DeleteWherePostingID(ctx context.Context, postingID int64) (err error)
+	DeleteWhereUserID(ctx context.Context, userID int64) (err error)
+	DeleteWhereInPostingIDs(ctx context.Context, userID int64) (err error)
+}
+
+type PostingViewRepository struct {
+	db *sql.DB
+}
+
+func NewPostingViewRepository(db *sql.DB) *PostingViewRepository {
+	return &PostingViewRepository{
+		db: db,
+	}
+}
+
+// CreateIgnoringDuplicates inserts the views in one statement.
+// A view already recorded in the same window is skipped, so the same views can be inserted again after a failure.
+func (r *PostingViewRepository) CreateIgnoringDuplicates(ctx context.Context, views []model.PostingView) (err error) {
+	if len(views) == 0 {
+		return
+	}
+	placeholders := make([]string, 0, len(views))
+	args := make([]interface{}, 0, len(views)*4)
+	for _, v := range views {
+		placeholders = append(placeholders, "(?, ?, ?, ?)")
+		args = append(args, v.PostingID, v.UserID, v.Kind, v.WindowStart)
+	}
+	q := "INSERT IGNORE INTO `posting_views` (`posting_id`, `user_id`, `kind`, `window_start`) VALUES " + strings.Join(placeholders, ", ")
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, args...)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, args...)
+	}
+	return
+}
+
+// GetDailyStatsWherePostingID counts the views, likes and comments of the posting per date since the time.
+// Dates without any of them are not returned.
+func (r *PostingViewRepository) GetDailyStatsWherePostingID(ctx context.Context, postingID int64, since time.Time) (stats []model.PostingDailyStats, err error) {
+	q := "SELECT `s`.`date`, SUM(`s`.`impressions`), SUM(`s`.`views`), SUM(`s`.`likes`), SUM(`s`.`comments`) FROM (" +
+		"SELECT DATE(`window_start`) AS `date`, `kind` = ? AS `impressions`, `kind` = ? AS `views`, 0 AS `likes`, 0 AS `comments` FROM `posting_views` WHERE `posting_id` = ? AND `window_start` >= ? " +
+		"UNION ALL SELECT DATE(`created_at`), 0, 0, 1, 0 FROM `likes` WHERE `posting_id` = ? AND `created_at` >= ? " +
+		"UNION ALL SELECT DATE(`created_at`), 0, 0, 0, 1 FROM `comments` WHERE `posting_id` = ? AND `created_at` >= ?" +
+		") AS `s` GROUP BY `s`.`date` ORDER BY `s`.`date`"
+	rows, err := r.db.QueryContext(ctx, q, model.PostingViewKindImpression, model.PostingViewKindDetail, postingID, since, postingID, since, postingID, since)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var s model.PostingDailyStats
+		if err = rows.Scan(&s.Date, &s.Impressions, &s.Views, &s.Likes, &s.Comments); err != nil {
+			return
+		}
+		stats = append(stats, s)
+	}
+	err = rows.Err()
+	return
+}
+
+func (r *PostingViewRepository) DeleteWherePostingID(ctx context.Context, postingID int64) (err error) {
+	q := "DELETE FROM `posting_views` WHERE `posting_id` = ?"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, postingID)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, postingID)
+	}
+	return
+}
+
+func (r *PostingViewRepository) DeleteWhereUserID(ctx context.Context, userID int64) (err error) {
+	q := "DELETE FROM `posting_views` WHERE `user_id` = ?"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, userID)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, userID)
+	}
+	return
+}
+
+// DeleteWhereInPostingIDs deletes views of all the postings of the user, which may be made by any user.
+func (r *PostingViewRepository) DeleteWhereInPostingIDs(ctx context.Context, userID int64) (err error) {
+	q := "DELETE FROM `posting_views` WHERE `posting_id` IN (SELECT `id` FROM `postings` WHERE `user_id` = ?)"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, userID)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, userID)
+	}
+	return
+}
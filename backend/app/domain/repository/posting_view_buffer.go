@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"sync"
+
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+)
+
+const (
+	postingViewBufferCapacity = 100000
+	postingViewBatchSize      = 500
+)
+
+// DefaultPostingViewBuffer is shared by the requests recording views and the job flushing them.
+var DefaultPostingViewBuffer = NewPostingViewBuffer(postingViewBufferCapacity, postingViewBatchSize)
+
+type postingViewKey struct {
+	postingID   int64
+	userID      int64
+	kind        string
+	windowStart int64
+}
+
+// PostingViewBuffer keeps the views in memory until they are written in batches,
+// so that reading postings doesn't wait for writing their views.
+type PostingViewBuffer struct {
+	mu        sync.Mutex
+	views     []model.PostingView
+	seen      map[postingViewKey]struct{}
+	capacity  int
+	batchSize int
+	ready     chan struct{}
+}
+
+func NewPostingViewBuffer(capacity, batchSize int) *PostingViewBuffer {
+	return &PostingViewBuffer{
+		seen:      make(map[postingViewKey]struct{}),
+		capacity:  capacity,
+		batchSize: batchSize,
+		ready:     make(chan struct{}, 1),
+	}
+}
+
+// Add buffers the views. A view already buffered in the same window is ignored.
+// Views are dropped while the buffer is full so that the memory stays bounded when the db is down.
+func (b *PostingViewBuffer) Add(views ...model.PostingView) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, v := range views {
+		if len(b.views) >= b.capacity {
+			break
+		}
+		k := postingViewKey{postingID: v.PostingID, userID: v.UserID, kind: v.Kind, windowStart: v.WindowStart.Unix()}
+		if _, ok := b.seen[k]; ok {
+			continue
+		}
+		b.seen[k] = struct{}{}
+		b.views = append(b.views, v)
+	}
+	if len(b.views) >= b.batchSize {
+		select {
+		case b.ready <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Ready receives when a full batch is buffered.
+func (b *PostingViewBuffer) Ready() <-chan struct{} {
+	return b.ready
+}
+
+// DrainBatch removes at most a batch of the oldest views from the buffer and returns them.
+func (b *PostingViewBuffer) DrainBatch() []model.PostingView {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	n := len(b.views)
+	if n > b.batchSize {
+		n = b.batchSize
+	}
+	batch := make([]model.PostingView, n)
+	copy(batch, b.views[:n])
+	b.views = b.views[n:]
+	for _, v := range batch {
+		delete(b.seen, postingViewKey{postingID: v.PostingID, userID: v.UserID, kind: v.Kind, windowStart: v.WindowStart.Unix()})
+	}
+	return batch
+}
+
+func (b *PostingViewBuffer) Len() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.views)
+}
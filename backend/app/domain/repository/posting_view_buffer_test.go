@@ -0,0 +1,48 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+)
+
+func TestPostingViewBuffer(t *testing.T) {
+	window := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	view := func(postingID int64, kind string) model.PostingView {
+		return model.PostingView{PostingID: postingID, UserID: 1, Kind: kind, WindowStart: window}
+	}
+
+	b := repository.NewPostingViewBuffer(4, 2)
+
+	// the same view in the same window is buffered once
+	b.Add(view(1, model.PostingViewKindImpression), view(1, model.PostingViewKindImpression))
+	assert.Equal(t, 1, b.Len())
+	select {
+	case <-b.Ready():
+		t.Fatal("ready before a batch is buffered")
+	default:
+	}
+
+	b.Add(view(1, model.PostingViewKindDetail))
+	select {
+	case <-b.Ready():
+	default:
+		t.Fatal("not ready after a batch is buffered")
+	}
+
+	// views over the capacity are dropped
+	b.Add(view(2, model.PostingViewKindImpression), view(3, model.PostingViewKindImpression), view(4, model.PostingViewKindImpression))
+	assert.Equal(t, 4, b.Len())
+
+	assert.Equal(t, []model.PostingView{view(1, model.PostingViewKindImpression), view(1, model.PostingViewKindDetail)}, b.DrainBatch())
+	assert.Equal(t, []model.PostingView{view(2, model.PostingViewKindImpression), view(3, model.PostingViewKindImpression)}, b.DrainBatch())
+	assert.Equal(t, 0, len(b.DrainBatch()))
+
+	// drained views can be buffered again, e.g. after the write failed
+	b.Add(view(1, model.PostingViewKindImpression))
+	assert.Equal(t, 1, b.Len())
+}
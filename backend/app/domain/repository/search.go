@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"strings"
+)
+
+// operators of the boolean mode full text search
+const booleanModeOperators = `+-<>()~*"@`
+
+// same as ngram_token_size in my.cnf. a shorter word can never match.
+const ngramTokenSize = 2
+
+// toBooleanModeQuery makes every word of the user input required.
+// With the ngram parser each word is searched as a phrase, so it matches where the word appears as is.
+// Operators are removed so that the input can't change the meaning of the query, and too short words are ignored.
+func toBooleanModeQuery(query string) string {
+	query = strings.Map(func(r rune) rune {
+		if strings.ContainsRune(booleanModeOperators, r) {
+			return ' '
+		}
+		return r
+	}, query)
+
+	var words []string
+	for _, w := range strings.Fields(query) {
+		if len([]rune(w)) < ngramTokenSize {
+			continue
+		}
+		words = append(words, "+"+w)
+	}
+	return strings.Join(words, " ")
+}
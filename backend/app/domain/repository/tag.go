@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	m "github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+)
+
+type TagRepositoryInterface interface {
+	Upsert(ctx context.Context, tag *model.Tag) (err error)
+	GetWhereName(ctx context.Context, name string) (tag model.Tag, err error)
+	GetTrending(ctx context.Context, since time.Time, limit int8) (tagCounts []model.TagCount, err error)
+}
+
+type TagRepository struct {
+	db *sql.DB
+}
+
+func NewTagRepository(db *sql.DB) *TagRepository {
+	return &TagRepository{
+		db: db,
+	}
+}
+
+// Upsert inserts the tag if it doesn't exist yet and sets the id of the tag either way.
+func (r *TagRepository) Upsert(ctx context.Context, tag *model.Tag) (err error) {
+	// LAST_INSERT_ID(expr) makes LastInsertId return the existing id on duplicate
+	q := "INSERT INTO `tags` (`name`) VALUES (?) ON DUPLICATE KEY UPDATE `id` = LAST_INSERT_ID(`id`)"
+	var result sql.Result
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		result, err = tx.ExecContext(ctx, q, tag.Name)
+	} else {
+		result, err = r.db.ExecContext(ctx, q, tag.Name)
+	}
+	if err != nil {
+		return
+	}
+	tag.ID, err = result.LastInsertId()
+	return
+}
+
+func (r *TagRepository) GetWhereName(ctx context.Context, name string) (tag model.Tag, err error) {
+	q := "SELECT `id`, `name`, `created_at`, `updated_at` FROM `tags` WHERE `name` = ?"
+	err = r.db.QueryRowContext(ctx, q, name).Scan(&tag.ID, &tag.Name, &tag.CreatedAt, &tag.UpdatedAt)
+	if err == sql.ErrNoRows {
+		err = ErrNotExistsData
+		return
+	}
+	return
+}
+
+// GetTrending returns tags used most since the given time.
+func (r *TagRepository) GetTrending(ctx context.Context, since time.Time, limit int8) (tagCounts []model.TagCount, err error) {
+	pubCond, now := publishedCond("`p`.")
+	q := "SELECT `t`.`name`, COUNT(*) AS `count` FROM `posting_tags` AS `pt` INNER JOIN `tags` AS `t` ON `pt`.`tag_id` = `t`.`id` INNER JOIN `postings` AS `p` ON `pt`.`posting_id` = `p`.`id` WHERE `pt`.`created_at` >= ? AND " + pubCond + " GROUP BY `t`.`id`, `t`.`name` ORDER BY `count` DESC, `t`.`name` LIMIT ?"
+	rows, err := r.db.QueryContext(ctx, q, since, now, limit)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	var tc model.TagCount
+	for rows.Next() {
+		if err = rows.Scan(&tc.Name, &tc.Count); err != nil {
+			return
+		}
+		tagCounts = append(tagCounts, tc)
+		tc = model.TagCount{}
+	}
+	if err = rows.Err(); err != nil {
+		return
+	}
+	return
+}
@@ -15,8 +15,11 @@ type UserRepositoryInterface interface {
 	GetUserWhereID(ctx context.Context, id int64) (user model.User, err error)
 	GetUserWhereName(ctx context.Context, userName string) (user model.User, err error)
 	GetUserWhereEmail(ctx context.Context, email string) (user model.User, err error)
+	Search(ctx context.Context, query string, limit int8, offset int) (users []model.User, err error)
+	GetWhereIconSet(ctx context.Context) (users []model.User, err error)
 	UpdatePasswordWhereName(ctx context.Context, password string, userName string) (err error)
 	UpdateIconWhereName(ctx context.Context, iconURL string, userName string) (err error)
+	UpdateIconWhereID(ctx context.Context, iconURL string, id int64) (err error)
 	UpdateSelfIntroductionWhereName(ctx context.Context, selfIntroduction string, userName string) (err error)
 	UpdateEmailVerifiedWhereNameActivationKey(ctx context.Context, emailVerified bool, userName string, activationKey string) (err error)
 	ResetPassword(ctx context.Context, password string, userName string) (err error)
@@ -78,6 +81,56 @@ func (r *UserRepository) GetUserWhereEmail(ctx context.Context, email string) (u
 	return
 }
 
+// Search returns activated users whose name or self introduction matches the query in descending order of relevance.
+func (r *UserRepository) Search(ctx context.Context, query string, limit int8, offset int) (users []model.User, err error) {
+	q := "SELECT `id`, `name`, `email`, `password`, `icon`, `self_introduction`, `activation_key`, `email_verified`, `created_at`, `updated_at` FROM `users` WHERE MATCH (`name`, `self_introduction`) AGAINST (? IN BOOLEAN MODE) AND `email_verified` = true ORDER BY MATCH (`name`, `self_introduction`) AGAINST (? IN BOOLEAN MODE) DESC, `id` DESC LIMIT ? OFFSET ?"
+	booleanQuery := toBooleanModeQuery(query)
+	if booleanQuery == "" {
+		return
+	}
+	rows, err := r.db.QueryContext(ctx, q, booleanQuery, booleanQuery, limit, offset)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	var u model.User
+	for rows.Next() {
+		if err = rows.Scan(&u.ID, &u.Name, &u.Email, &u.Password, &u.Icon, &u.SelfIntroduction, &u.ActivationKey, &u.EmailVerified, &u.CreatedAt, &u.UpdatedAt); err != nil {
+			return
+		}
+		users = append(users, u)
+		u = model.User{}
+	}
+	if err = rows.Err(); err != nil {
+		return
+	}
+	return
+}
+
+// GetWhereIconSet returns all the users who have set an icon.
+func (r *UserRepository) GetWhereIconSet(ctx context.Context) (users []model.User, err error) {
+	q := "SELECT `id`, `name`, `email`, `password`, `icon`, `self_introduction`, `activation_key`, `email_verified`, `created_at`, `updated_at` FROM `users` WHERE `icon` <> 'UNKNOWN' ORDER BY `id`"
+	rows, err := r.db.QueryContext(ctx, q)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	var user model.User
+	for rows.Next() {
+		if err = rows.Scan(&user.ID, &user.Name, &user.Email, &user.Password, &user.Icon, &user.SelfIntroduction, &user.ActivationKey, &user.EmailVerified, &user.CreatedAt, &user.UpdatedAt); err != nil {
+			return
+		}
+		users = append(users, user)
+		user = model.User{}
+	}
+	if err = rows.Err(); err != nil {
+		return
+	}
+	return
+}
+
 func (r *UserRepository) UpdatePasswordWhereName(ctx context.Context, password, userName string) (err error) {
 	q := "UPDATE `users` SET `password` = ? WHERE `name` = ?"
 	tx := m.GetTransaction(ctx)
@@ -100,6 +153,17 @@ func (r *UserRepository) UpdateIconWhereName(ctx context.Context, iconURL, userN
 	return
 }
 
+func (r *UserRepository) UpdateIconWhereID(ctx context.Context, iconURL string, id int64) (err error) {
+	q := "UPDATE `users` SET `icon` = ? WHERE `id` = ?"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, iconURL, id)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, iconURL, id)
+	}
+	return
+}
+
 func (r *UserRepository) UpdateSelfIntroductionWhereName(ctx context.Context, selfIntroduction, userName string) (err error) {
 	q := "UPDATE `users` SET `self_introduction` = ? WHERE `name` = ?"
 	tx := m.GetTransaction(ctx)
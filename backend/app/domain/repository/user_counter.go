@@ -0,0 +1,154 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	m "github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+)
+
+type UserCounterRepositoryInterface interface {
+	GetWhereUserID(ctx context.Context, userID int64) (counter model.UserCounter, err error)
+	GetUserIDsRelatedToUserID(ctx context.Context, userID int64) (userIDs []int64, err error)
+	GetDriftedAfterUserID(ctx context.Context, userID int64, limit int) (driftedUserIDs []int64, lastUserID int64, err error)
+	Add(ctx context.Context, delta *model.UserCounter) (err error)
+	Recount(ctx context.Context, userIDs []int64) (err error)
+	DeleteWhereUserID(ctx context.Context, userID int64) (err error)
+}
+
+// userCounts counts the postings, the likes and the follows of the user `u` in the order of the columns of user_counters.
+// The scheduled postings are counted once they are marked as published.
+const userCounts = "(SELECT COUNT(*) FROM `postings` AS `p` WHERE `p`.`user_id` = `u`.`id` AND `p`.`publish_at` IS NULL), " +
+	"(SELECT COUNT(*) FROM `likes` AS `l` WHERE `l`.`user_id` = `u`.`id`), " +
+	"(SELECT COUNT(*) FROM `likes` AS `l` INNER JOIN `postings` AS `p` ON `l`.`posting_id` = `p`.`id` WHERE `p`.`user_id` = `u`.`id`), " +
+	"(SELECT COUNT(*) FROM `follows` AS `f` WHERE `f`.`following_user_id` = `u`.`id`), " +
+	"(SELECT COUNT(*) FROM `follows` AS `f` WHERE `f`.`followed_user_id` = `u`.`id`)"
+
+type UserCounterRepository struct {
+	db *sql.DB
+}
+
+func NewUserCounterRepository(db *sql.DB) *UserCounterRepository {
+	return &UserCounterRepository{
+		db: db,
+	}
+}
+
+// GetWhereUserID returns the counter of the user. A user without a counter has nothing yet and gets zeros.
+func (r *UserCounterRepository) GetWhereUserID(ctx context.Context, userID int64) (counter model.UserCounter, err error) {
+	q := "SELECT `id`, `user_id`, `posting_count`, `like_count`, `liked_count`, `follow_count`, `followed_count`, `created_at`, `updated_at` FROM `user_counters` WHERE `user_id` = ?"
+	err = r.db.QueryRowContext(ctx, q, userID).Scan(&counter.ID, &counter.UserID, &counter.PostingCount, &counter.LikeCount, &counter.LikedCount, &counter.FollowCount, &counter.FollowedCount, &counter.CreatedAt, &counter.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return model.UserCounter{UserID: userID}, nil
+	}
+	return
+}
+
+// GetUserIDsRelatedToUserID returns the users whose counters change when the likes and the follows of the user are gone,
+// which are the users following or followed by the user, liking the postings of the user, and posting what the user likes.
+func (r *UserCounterRepository) GetUserIDsRelatedToUserID(ctx context.Context, userID int64) (userIDs []int64, err error) {
+	q := "SELECT `followed_user_id` FROM `follows` WHERE `following_user_id` = ? " +
+		"UNION SELECT `following_user_id` FROM `follows` WHERE `followed_user_id` = ? " +
+		"UNION SELECT `l`.`user_id` FROM `likes` AS `l` INNER JOIN `postings` AS `p` ON `l`.`posting_id` = `p`.`id` WHERE `p`.`user_id` = ? " +
+		"UNION SELECT `p`.`user_id` FROM `likes` AS `l` INNER JOIN `postings` AS `p` ON `l`.`posting_id` = `p`.`id` WHERE `l`.`user_id` = ?"
+	rows, err := r.db.QueryContext(ctx, q, userID, userID, userID, userID)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	var id int64
+	for rows.Next() {
+		if err = rows.Scan(&id); err != nil {
+			return
+		}
+		userIDs = append(userIDs, id)
+	}
+	if err = rows.Err(); err != nil {
+		return
+	}
+	return
+}
+
+// GetDriftedAfterUserID checks the counters of at most limit users after the user ID in the order of the ID,
+// and returns the users whose counters differ from the postings, the likes and the follows, and the last user checked.
+// lastUserID is 0 when there are no more users.
+func (r *UserCounterRepository) GetDriftedAfterUserID(ctx context.Context, userID int64, limit int) (driftedUserIDs []int64, lastUserID int64, err error) {
+	q := "SELECT `u`.`id`, (IFNULL(`uc`.`posting_count`, 0), IFNULL(`uc`.`like_count`, 0), IFNULL(`uc`.`liked_count`, 0), IFNULL(`uc`.`follow_count`, 0), IFNULL(`uc`.`followed_count`, 0)) <> (" + userCounts + ") " +
+		"FROM (SELECT `id` FROM `users` WHERE `id` > ? ORDER BY `id` LIMIT ?) AS `u` LEFT JOIN `user_counters` AS `uc` ON `uc`.`user_id` = `u`.`id` ORDER BY `u`.`id`"
+	rows, err := r.db.QueryContext(ctx, q, userID, limit)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	var id int64
+	var drifted bool
+	for rows.Next() {
+		if err = rows.Scan(&id, &drifted); err != nil {
+			return
+		}
+		if drifted {
+			driftedUserIDs = append(driftedUserIDs, id)
+		}
+		lastUserID = id
+	}
+	if err = rows.Err(); err != nil {
+		return
+	}
+	return
+}
+
+// Add adds the counts of delta to the counter of delta.UserID. A negative count subtracts.
+func (r *UserCounterRepository) Add(ctx context.Context, delta *model.UserCounter) (err error) {
+	q := "INSERT INTO `user_counters` (`user_id`, `posting_count`, `like_count`, `liked_count`, `follow_count`, `followed_count`) " +
+		"VALUES (?, GREATEST(?, 0), GREATEST(?, 0), GREATEST(?, 0), GREATEST(?, 0), GREATEST(?, 0)) " +
+		"ON DUPLICATE KEY UPDATE `posting_count` = `posting_count` + ?, `like_count` = `like_count` + ?, `liked_count` = `liked_count` + ?, `follow_count` = `follow_count` + ?, `followed_count` = `followed_count` + ?"
+	counts := []interface{}{delta.PostingCount, delta.LikeCount, delta.LikedCount, delta.FollowCount, delta.FollowedCount}
+	args := append(append([]interface{}{delta.UserID}, counts...), counts...)
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, args...)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, args...)
+	}
+	return
+}
+
+// Recount sets the counters of the users to the numbers of their postings, likes and follows.
+// It is used where adding is impractical, e.g. when the likes of a user are deleted at once, and to repair drifted counters.
+func (r *UserCounterRepository) Recount(ctx context.Context, userIDs []int64) (err error) {
+	if len(userIDs) == 0 {
+		return
+	}
+	placeholders := make([]string, 0, len(userIDs))
+	args := make([]interface{}, 0, len(userIDs))
+	for _, id := range userIDs {
+		placeholders = append(placeholders, "?")
+		args = append(args, id)
+	}
+	q := "INSERT INTO `user_counters` (`user_id`, `posting_count`, `like_count`, `liked_count`, `follow_count`, `followed_count`) " +
+		"SELECT `u`.`id`, " + userCounts + " FROM `users` AS `u` WHERE `u`.`id` IN (" + strings.Join(placeholders, ", ") + ") " +
+		"ON DUPLICATE KEY UPDATE `posting_count` = VALUES(`posting_count`), `like_count` = VALUES(`like_count`), `liked_count` = VALUES(`liked_count`), " +
+		"`follow_count` = VALUES(`follow_count`), `followed_count` = VALUES(`followed_count`)"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, args...)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, args...)
+	}
+	return
+}
+
+func (r *UserCounterRepository) DeleteWhereUserID(ctx context.Context, userID int64) (err error) {
+	q := "DELETE FROM `user_counters` WHERE `user_id` = ?"
+	tx := m.GetTransaction(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, q, userID)
+	} else {
+		_, err = r.db.ExecContext(ctx, q, userID)
+	}
+	return
+}
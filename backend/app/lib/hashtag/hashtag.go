@@ -0,0 +1,41 @@
+package hashtag
+
+/*
+pull hashtags out of free text such as posting titles
+*/
+
+import (
+	"regexp"
+	"strings"
+)
+
+// MaxLength is the max number of characters of a tag name
+const MaxLength = 100
+
+// a tag starts with # (or full width ＃) and continues with letters, numbers and _ in any language
+var pattern = regexp.MustCompile(`[#＃]([\p{L}\p{M}\p{N}_]+)`)
+
+// Extract returns the normalized tags in s in order of appearance without duplicates.
+func Extract(s string) (tags []string) {
+	seen := map[string]bool{}
+	for _, m := range pattern.FindAllStringSubmatch(s, -1) {
+		tag := Normalize(m[1])
+		if len([]rune(tag)) > MaxLength || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		tags = append(tags, tag)
+	}
+	return
+}
+
+// Normalize makes #Cat and #cat the same tag. A leading # is dropped so that it can be used for user input as well.
+func Normalize(tag string) string {
+	tag = strings.TrimLeft(tag, "#＃")
+	return strings.ToLower(tag)
+}
+
+// Remove returns s without the tags in it.
+func Remove(s string) string {
+	return pattern.ReplaceAllString(s, "")
+}
@@ -0,0 +1,63 @@
+package hashtag_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gold-kou/ToeBeans/backend/app/lib/hashtag"
+)
+
+func TestExtract(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []string
+	}{
+		{
+			name:  "success",
+			input: "My cat is sleeping #cat_nap #Tabby",
+			want:  []string{"cat_nap", "tabby"},
+		},
+		{
+			name:  "success japanese and full width sharp",
+			input: "お昼寝中 ＃ねこ #猫",
+			want:  []string{"ねこ", "猫"},
+		},
+		{
+			name:  "success duplicate tags are merged",
+			input: "#Cat and #cat and #CAT",
+			want:  []string{"cat"},
+		},
+		{
+			name:  "success punctuation ends a tag",
+			input: "#cat, #kitten.",
+			want:  []string{"cat", "kitten"},
+		},
+		{
+			name:  "success no tags",
+			input: "This is a sample posting. # not a tag",
+			want:  nil,
+		},
+		{
+			name:  "success too long tag is ignored",
+			input: "#" + strings.Repeat("a", hashtag.MaxLength+1) + " #cat",
+			want:  []string{"cat"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, hashtag.Extract(tt.input))
+		})
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	assert.Equal(t, "cat_nap", hashtag.Normalize("#Cat_Nap"))
+	assert.Equal(t, "cat_nap", hashtag.Normalize("cat_nap"))
+}
+
+func TestRemove(t *testing.T) {
+	assert.Equal(t, "My cat  is sleeping", hashtag.Remove("My cat #cat_nap is sleeping"))
+}
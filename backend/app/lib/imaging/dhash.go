@@ -0,0 +1,43 @@
+package imaging
+
+import (
+	"image"
+	"math/bits"
+
+	"golang.org/x/image/draw"
+)
+
+// the hash has dHashSize * dHashSize bits
+const dHashSize = 8
+
+// DHash returns the difference hash of an encoded image.
+// Unlike a checksum it hardly changes when the image is resized or re-encoded, so near-duplicates have a small Distance.
+func DHash(data []byte) (uint64, error) {
+	img, _, err := decode(data)
+	if err != nil {
+		return 0, err
+	}
+	return dHash(img), nil
+}
+
+// dHash shrinks the image to 9x8 in grayscale and sets a bit for each pixel which is darker than its right neighbor.
+func dHash(img image.Image) uint64 {
+	small := image.NewGray(image.Rect(0, 0, dHashSize+1, dHashSize))
+	draw.CatmullRom.Scale(small, small.Bounds(), img, img.Bounds(), draw.Src, nil)
+
+	var hash uint64
+	for y := 0; y < dHashSize; y++ {
+		for x := 0; x < dHashSize; x++ {
+			hash <<= 1
+			if small.GrayAt(x, y).Y < small.GrayAt(x+1, y).Y {
+				hash |= 1
+			}
+		}
+	}
+	return hash
+}
+
+// Distance is the Hamming distance between two hashes. 0 means the images look the same.
+func Distance(a, b uint64) int {
+	return bits.OnesCount64(a ^ b)
+}
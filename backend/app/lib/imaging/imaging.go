@@ -0,0 +1,152 @@
+package imaging
+
+/*
+decode uploaded images, drop their metadata and produce resized variants
+*/
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"image"
+	"image/color"
+	"image/jpeg"
+	_ "image/png" // register decoder
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp" // register decoder
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported image format")
+	ErrTooManyPixels     = errors.New("image has too many pixels")
+)
+
+const (
+	ContentType = "image/jpeg"
+	Extension   = ".jpg"
+	// the content of a key never changes, so browsers may keep it forever. shared caches must not because objects are private
+	CacheControl = "private, max-age=31536000, immutable"
+	jpegQuality  = 85
+	// decoding allocates about 4 bytes per pixel, so a small file declaring a huge canvas must be rejected before decoding
+	MaxPixels = 40 * 1000 * 1000
+)
+
+// Variant is a resized copy of an uploaded image. The longer edge is shrunk to MaxEdge (never enlarged).
+type Variant struct {
+	Name    string
+	MaxEdge int
+}
+
+var (
+	VariantThumb = Variant{Name: "thumb", MaxEdge: 150}
+	VariantFeed  = Variant{Name: "feed", MaxEdge: 640}
+	VariantFull  = Variant{Name: "full", MaxEdge: 2048}
+	VariantIcon  = Variant{Name: "icon", MaxEdge: 256}
+)
+
+var PostingVariants = []Variant{VariantThumb, VariantFeed, VariantFull}
+var IconVariants = []Variant{VariantIcon}
+
+var supportedFormats = map[string]bool{
+	"jpeg": true,
+	"png":  true,
+	"webp": true,
+}
+
+type Processed struct {
+	Variant Variant
+	Data    []byte
+}
+
+// Process decodes r, rotates it according to the EXIF orientation and encodes every variant as JPEG.
+// Re-encoding from pixels means that no metadata (EXIF, GPS, ICC and so on) of the original survives.
+func Process(r io.Reader, variants []Variant) ([]Processed, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	img, format, err := decode(b)
+	if err != nil {
+		return nil, err
+	}
+	if format == "jpeg" {
+		img = applyOrientation(img, readOrientation(b))
+	}
+
+	var processed []Processed
+	for _, v := range variants {
+		var buf bytes.Buffer
+		if err := jpeg.Encode(&buf, resize(img, v.MaxEdge), &jpeg.Options{Quality: jpegQuality}); err != nil {
+			return nil, err
+		}
+		processed = append(processed, Processed{Variant: v, Data: buf.Bytes()})
+	}
+	return processed, nil
+}
+
+// decode checks the dimensions declared in the header before decoding the pixels.
+func decode(b []byte) (image.Image, string, error) {
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(b))
+	if err != nil || !supportedFormats[format] {
+		return nil, "", ErrUnsupportedFormat
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || int64(cfg.Width)*int64(cfg.Height) > MaxPixels {
+		return nil, "", ErrTooManyPixels
+	}
+	img, format, err := image.Decode(bytes.NewReader(b))
+	if err != nil || !supportedFormats[format] {
+		return nil, "", ErrUnsupportedFormat
+	}
+	return img, format, nil
+}
+
+// ContentKey returns the base key of an image derived from its content under the prefix.
+// Different images never share a key, and a key always holds the same image.
+func ContentKey(prefix string, data []byte) string {
+	sum := sha256.Sum256(data)
+	return prefix + "/" + hex.EncodeToString(sum[:])
+}
+
+// VariantKey returns the object key under which the variant of the base key is stored.
+func VariantKey(baseKey string, v Variant) string {
+	return baseKey + "_" + v.Name + Extension
+}
+
+// VariantURL derives the URL of a variant from the URL of the full variant.
+// Images uploaded before variants existed have only one object, so its URL is returned as is.
+func VariantURL(fullURL string, v Variant) string {
+	suffix := "_" + VariantFull.Name + Extension
+	if !strings.HasSuffix(fullURL, suffix) {
+		return fullURL
+	}
+	return strings.TrimSuffix(fullURL, suffix) + "_" + v.Name + Extension
+}
+
+func resize(src image.Image, maxEdge int) image.Image {
+	b := src.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w > maxEdge || h > maxEdge {
+		if w >= h {
+			w, h = maxEdge, h*maxEdge/w
+		} else {
+			w, h = w*maxEdge/h, maxEdge
+		}
+		if w == 0 {
+			w = 1
+		}
+		if h == 0 {
+			h = 1
+		}
+	}
+
+	// JPEG has no alpha channel, so transparent pixels are put on a white background
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	draw.Draw(dst, dst.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.CatmullRom.Scale(dst, dst.Bounds(), src, b, draw.Over, nil)
+	return dst
+}
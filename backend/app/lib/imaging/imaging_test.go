@@ -0,0 +1,215 @@
+package imaging_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/gold-kou/ToeBeans/backend/app/lib/imaging"
+)
+
+func encodePNG(w, h int) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	img.Set(0, 0, color.RGBA{R: 255, A: 255})
+	var b bytes.Buffer
+	_ = png.Encode(&b, img)
+	return b.Bytes()
+}
+
+// encodePNGDeclaring builds a 1x1 PNG whose header declares a w x h canvas.
+func encodePNGDeclaring(w, h uint32) []byte {
+	b := encodePNG(1, 1)
+	// signature(8) + length(4) + "IHDR"(4) + width(4) + height(4) ... + crc
+	binary.BigEndian.PutUint32(b[16:20], w)
+	binary.BigEndian.PutUint32(b[20:24], h)
+	binary.BigEndian.PutUint32(b[29:33], crc32.ChecksumIEEE(b[12:29]))
+	return b
+}
+
+func encodeGIF() []byte {
+	img := image.NewPaletted(image.Rect(0, 0, 2, 2), []color.Color{color.Black, color.White})
+	var b bytes.Buffer
+	_ = gif.Encode(&b, img, nil)
+	return b.Bytes()
+}
+
+// encodeJPEGWithOrientation builds a JPEG which has an EXIF APP1 segment holding the orientation and a GPS tag.
+func encodeJPEGWithOrientation(w, h int, orientation uint16) []byte {
+	var b bytes.Buffer
+	_ = jpeg.Encode(&b, image.NewRGBA(image.Rect(0, 0, w, h)), nil)
+	jpg := b.Bytes()
+
+	// TIFF header (big endian) + IFD0 with 2 entries
+	tiff := []byte("MM\x00\x2a\x00\x00\x00\x08")
+	tiff = append(tiff, 0x00, 0x02)
+	// Orientation, SHORT, count 1
+	entry := make([]byte, 12)
+	binary.BigEndian.PutUint16(entry[0:2], 0x0112)
+	binary.BigEndian.PutUint16(entry[2:4], 3)
+	binary.BigEndian.PutUint32(entry[4:8], 1)
+	binary.BigEndian.PutUint16(entry[8:10], orientation)
+	tiff = append(tiff, entry...)
+	// GPSInfo IFD pointer
+	entry = make([]byte, 12)
+	binary.BigEndian.PutUint16(entry[0:2], 0x8825)
+	binary.BigEndian.PutUint16(entry[2:4], 4)
+	binary.BigEndian.PutUint32(entry[4:8], 1)
+	tiff = append(tiff, entry...)
+	tiff = append(tiff, 0, 0, 0, 0)
+
+	payload := append([]byte("Exif\x00\x00"), tiff...)
+	app1 := []byte{0xFF, 0xE1, 0, 0}
+	binary.BigEndian.PutUint16(app1[2:4], uint16(len(payload)+2))
+	app1 = append(app1, payload...)
+
+	var out []byte
+	out = append(out, jpg[:2]...)
+	out = append(out, app1...)
+	out = append(out, jpg[2:]...)
+	return out
+}
+
+func TestProcess(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []byte
+		variants []imaging.Variant
+		wantW    []int
+		wantH    []int
+		wantErr  error
+	}{
+		{
+			name:     "success png shrunk per variant",
+			input:    encodePNG(4000, 2000),
+			variants: imaging.PostingVariants,
+			wantW:    []int{150, 640, 2048},
+			wantH:    []int{75, 320, 1024},
+		},
+		{
+			name:     "success small image is not enlarged",
+			input:    encodePNG(2, 2),
+			variants: imaging.PostingVariants,
+			wantW:    []int{2, 2, 2},
+			wantH:    []int{2, 2, 2},
+		},
+		{
+			name:     "success jpeg rotated by exif orientation",
+			input:    encodeJPEGWithOrientation(40, 20, 6),
+			variants: []imaging.Variant{imaging.VariantFull},
+			wantW:    []int{20},
+			wantH:    []int{40},
+		},
+		{
+			name:     "success jpeg without rotation",
+			input:    encodeJPEGWithOrientation(40, 20, 1),
+			variants: []imaging.Variant{imaging.VariantFull},
+			wantW:    []int{40},
+			wantH:    []int{20},
+		},
+		{
+			name:     "error gif",
+			input:    encodeGIF(),
+			variants: imaging.PostingVariants,
+			wantErr:  imaging.ErrUnsupportedFormat,
+		},
+		{
+			name:     "error not image",
+			input:    []byte("this is not an image"),
+			variants: imaging.PostingVariants,
+			wantErr:  imaging.ErrUnsupportedFormat,
+		},
+		{
+			name:     "error huge canvas declared",
+			input:    encodePNGDeclaring(100000, 100000),
+			variants: imaging.PostingVariants,
+			wantErr:  imaging.ErrTooManyPixels,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := imaging.Process(bytes.NewReader(tt.input), tt.variants)
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, len(tt.variants), len(got))
+			for i, p := range got {
+				assert.Equal(t, tt.variants[i], p.Variant)
+				// output never carries the EXIF segment
+				assert.False(t, bytes.Contains(p.Data, []byte("Exif\x00\x00")))
+				cfg, format, err := image.DecodeConfig(bytes.NewReader(p.Data))
+				assert.NoError(t, err)
+				assert.Equal(t, "jpeg", format)
+				assert.Equal(t, tt.wantW[i], cfg.Width)
+				assert.Equal(t, tt.wantH[i], cfg.Height)
+			}
+		})
+	}
+}
+
+func TestVariantURL(t *testing.T) {
+	full := "http://localhost:9000/toebeans-postings/" + imaging.VariantKey("20200101000000_testUser1", imaging.VariantFull)
+	assert.Equal(t, "http://localhost:9000/toebeans-postings/20200101000000_testUser1_thumb.jpg", imaging.VariantURL(full, imaging.VariantThumb))
+	assert.Equal(t, full, imaging.VariantURL(full, imaging.VariantFull))
+
+	// uploaded before variants existed
+	legacy := "http://localhost:9000/toebeans-postings/20200101000000_testUser1"
+	assert.Equal(t, legacy, imaging.VariantURL(legacy, imaging.VariantThumb))
+}
+
+func TestContentKey(t *testing.T) {
+	a := imaging.ContentKey("1", []byte("a"))
+	assert.Equal(t, "1/ca978112ca1bbdcafac231b39a23dc4da786eff8147c4e72b9807785afee48bb", a)
+	assert.Equal(t, a, imaging.ContentKey("1", []byte("a")))
+	assert.NotEqual(t, a, imaging.ContentKey("1", []byte("b")))
+	assert.NotEqual(t, a, imaging.ContentKey("2", []byte("a")))
+	assert.Equal(t, "1/ca978112ca1bbdcafac231b39a23dc4da786eff8147c4e72b9807785afee48bb_full.jpg", imaging.VariantKey(a, imaging.VariantFull))
+}
+
+// encodeGradient builds a PNG getting brighter from left to right, or from right to left when reverse is true.
+func encodeGradient(w, h int, reverse bool) []byte {
+	img := image.NewGray(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			v := uint8(x * 255 / (w - 1))
+			if reverse {
+				v = 255 - v
+			}
+			img.SetGray(x, y, color.Gray{Y: v})
+		}
+	}
+	var b bytes.Buffer
+	_ = png.Encode(&b, img)
+	return b.Bytes()
+}
+
+func TestDHash(t *testing.T) {
+	original, err := imaging.DHash(encodeGradient(400, 300, false))
+	assert.NoError(t, err)
+
+	// a shrunk copy re-encoded as JPEG by Process
+	processed, err := imaging.Process(bytes.NewReader(encodeGradient(400, 300, false)), []imaging.Variant{imaging.VariantThumb})
+	assert.NoError(t, err)
+	resized, err := imaging.DHash(processed[0].Data)
+	assert.NoError(t, err)
+	assert.True(t, imaging.Distance(original, resized) <= 2)
+
+	different, err := imaging.DHash(encodeGradient(400, 300, true))
+	assert.NoError(t, err)
+	assert.True(t, imaging.Distance(original, different) > 32)
+
+	_, err = imaging.DHash([]byte("this is not an image"))
+	assert.Equal(t, imaging.ErrUnsupportedFormat, err)
+
+	_, err = imaging.DHash(encodePNGDeclaring(100000, 100000))
+	assert.Equal(t, imaging.ErrTooManyPixels, err)
+}
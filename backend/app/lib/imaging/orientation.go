@@ -0,0 +1,121 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+)
+
+const (
+	orientationNormal = 1
+	tagOrientation    = 0x0112
+)
+
+// readOrientation returns the EXIF orientation (1-8) of a JPEG file, or orientationNormal when it is missing.
+// Phones save the sensor image as is and put the rotation here, which is lost once metadata is dropped.
+func readOrientation(b []byte) int {
+	// SOI
+	if len(b) < 4 || b[0] != 0xFF || b[1] != 0xD8 {
+		return orientationNormal
+	}
+	for i := 2; i+4 <= len(b); {
+		if b[i] != 0xFF {
+			return orientationNormal
+		}
+		marker := b[i+1]
+		// SOS: image data follows, so there is no more metadata
+		if marker == 0xDA {
+			return orientationNormal
+		}
+		size := int(binary.BigEndian.Uint16(b[i+2 : i+4]))
+		if size < 2 || i+2+size > len(b) {
+			return orientationNormal
+		}
+		segment := b[i+4 : i+2+size]
+		// APP1
+		if marker == 0xE1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			return readTIFFOrientation(segment[6:])
+		}
+		i += 2 + size
+	}
+	return orientationNormal
+}
+
+func readTIFFOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return orientationNormal
+	}
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return orientationNormal
+	}
+
+	// IFD0
+	offset := int(order.Uint32(tiff[4:8]))
+	if offset+2 > len(tiff) {
+		return orientationNormal
+	}
+	count := int(order.Uint16(tiff[offset : offset+2]))
+	for i := 0; i < count; i++ {
+		entry := offset + 2 + i*12
+		if entry+12 > len(tiff) {
+			return orientationNormal
+		}
+		if order.Uint16(tiff[entry:entry+2]) != tagOrientation {
+			continue
+		}
+		// SHORT value is stored at the head of the value field
+		o := int(order.Uint16(tiff[entry+8 : entry+10]))
+		if o < 1 || o > 8 {
+			return orientationNormal
+		}
+		return o
+	}
+	return orientationNormal
+}
+
+// applyOrientation returns an upright copy of img.
+// See the Orientation tag in the EXIF specification for the meaning of each value.
+func applyOrientation(img image.Image, orientation int) image.Image {
+	if orientation == orientationNormal {
+		return img
+	}
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	// 5-8 are rotated by 90 degrees, so width and height are swapped
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			var dx, dy int
+			switch orientation {
+			case 2: // flip horizontal
+				dx, dy = w-1-x, y
+			case 3: // rotate 180
+				dx, dy = w-1-x, h-1-y
+			case 4: // flip vertical
+				dx, dy = x, h-1-y
+			case 5: // transpose
+				dx, dy = y, x
+			case 6: // rotate 90 clockwise
+				dx, dy = h-1-y, x
+			case 7: // transverse
+				dx, dy = h-1-y, w-1-x
+			case 8: // rotate 90 counterclockwise
+				dx, dy = y, w-1-x
+			default:
+				dx, dy = x, y
+			}
+			dst.Set(dx, dy, img.At(b.Min.X+x, b.Min.Y+y))
+		}
+	}
+	return dst
+}
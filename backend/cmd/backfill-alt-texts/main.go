@@ -0,0 +1,41 @@
+package main
+
+/*
+give the postings created before alt texts existed the default alt text made from the labels of their cover images. This is a one-off migration
+to be run after toebeans-sql/mysql/migration/010_postings_alt_text.sql. It calls the image classifier once for each posting,
+so set IMAGE_CLASSIFIER as the server does. Postings which already have an alt text are skipped, so run it again to retry failures.
+
+	$ go run ./cmd/backfill-alt-texts
+*/
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/classifier"
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/application/usecase"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+)
+
+func main() {
+	// db connect
+	db, err := mysql.NewDB()
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer db.Close()
+
+	// repository
+	postingRepo := repository.NewPostingRepository(db)
+
+	// UseCase
+	u := usecase.NewBackfillAltTexts(classifier.NewImageClassifier(), postingRepo)
+	described, err := u.BackfillAltTextsUseCase(context.Background())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("described %d postings\n", described)
+}
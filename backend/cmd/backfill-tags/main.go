@@ -0,0 +1,42 @@
+package main
+
+/*
+link the hashtags in the titles of the postings created before tags existed. This is a one-off migration
+to be run after toebeans-sql/mysql/migration/014_tags.sql. Postings already tagged are skipped, so run it again to retry failures.
+
+	$ go run ./cmd/backfill-tags
+*/
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/application/usecase"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+)
+
+func main() {
+	// db connect
+	db, err := mysql.NewDB()
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer db.Close()
+	tx := mysql.NewDBTransaction(db)
+
+	// repository
+	postingRepo := repository.NewPostingRepository(db)
+	tagRepo := repository.NewTagRepository(db)
+	postingTagRepo := repository.NewPostingTagRepository(db)
+
+	// UseCase
+	u := usecase.NewBackfillTags(tx, postingRepo, tagRepo, postingTagRepo)
+	tagged, err := u.BackfillTagsUseCase(context.Background())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("tagged %d postings\n", tagged)
+}
@@ -0,0 +1,82 @@
+package main
+
+/*
+find objects in the posting and icon buckets which no row refers to and delete them.
+They are left behind when a transaction fails after its upload.
+
+	$ go run ./cmd/gc-objects -dry_run [-grace_hours 24]
+	$ go run ./cmd/gc-objects [-grace_hours 24]
+
+run it against minio of docker-compose.test.yml with `make gc-objects ARGS=-dry_run`.
+*/
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/application/usecase"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+)
+
+func main() {
+	dryRun := flag.Bool("dry_run", false, "only report the orphaned objects without deleting them")
+	graceHours := flag.Int("grace_hours", 24, "keep unreferenced objects newer than this because their transactions may be in progress")
+	flag.Parse()
+	if *graceHours < 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	// db connect
+	db, err := mysql.NewDB()
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer db.Close()
+
+	// repository
+	userRepo := repository.NewUserRepository(db)
+	postingRepo := repository.NewPostingRepository(db)
+	postingImageRepo := repository.NewPostingImageRepository(db)
+	catRepo := repository.NewCatRepository(db)
+	draftRepo := repository.NewDraftRepository(db)
+
+	// UseCase
+	u := usecase.NewCollectOrphanedObjects(*dryRun, time.Duration(*graceHours)*time.Hour, userRepo, postingRepo, postingImageRepo, catRepo, draftRepo)
+	reports, err := u.CollectOrphanedObjectsUseCase(context.Background())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	action := "DELETE"
+	if *dryRun {
+		action = "WOULD_DELETE"
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ACTION\tBUCKET\tKEY\tSIZE\tLAST_MODIFIED")
+	for _, r := range reports {
+		for _, o := range r.Orphans {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\n", action, r.Bucket, o.Key, o.Size, o.LastModified.Format(time.RFC3339))
+		}
+	}
+	w.Flush()
+
+	fmt.Println()
+	failed := 0
+	w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "BUCKET\tSCANNED\tREFERENCED\tIN_GRACE_PERIOD\tORPHANED\tORPHANED_BYTES\tDELETED\tFAILED")
+	for _, r := range reports {
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\t%d\t%d\t%d\n", r.Bucket, r.Scanned, r.Referenced, r.InGracePeriod, len(r.Orphans), r.OrphanedBytes, r.Deleted, r.Failed)
+		failed += r.Failed
+	}
+	w.Flush()
+	if failed > 0 {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,36 @@
+package main
+
+/*
+make the objects uploaded with the public-read ACL private. This is a one-off migration.
+Objects uploaded before the buckets became private are still readable by anyone at their old URLs until this is run.
+It is safe to run it again to retry failures.
+
+	$ go run ./cmd/make-objects-private
+*/
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/gold-kou/ToeBeans/backend/app/application/usecase"
+)
+
+func main() {
+	// UseCase
+	u := usecase.NewMakeObjectsPrivate()
+	reports, err := u.MakeObjectsPrivateUseCase(context.Background())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var failed int
+	for _, r := range reports {
+		fmt.Printf("%s: made %d objects private, %d failed\n", r.Bucket, r.Objects, r.Failed)
+		failed += r.Failed
+	}
+	if failed > 0 {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,47 @@
+package main
+
+/*
+move posting images and icons to the object keys derived from their content. This is a one-off migration.
+Rows already migrated are skipped, so run it again to retry failures.
+
+	$ go run ./cmd/migrate-object-keys
+*/
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/application/usecase"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+)
+
+func main() {
+	// db connect
+	db, err := mysql.NewDB()
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer db.Close()
+	tx := mysql.NewDBTransaction(db)
+
+	// repository
+	userRepo := repository.NewUserRepository(db)
+	postingRepo := repository.NewPostingRepository(db)
+	postingImageRepo := repository.NewPostingImageRepository(db)
+	objectDeletionRepo := repository.NewObjectDeletionRepository(db)
+
+	// UseCase
+	u := usecase.NewMigrateObjectKeys(tx, userRepo, postingRepo, postingImageRepo, objectDeletionRepo)
+	report, err := u.MigrateObjectKeysUseCase(context.Background())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("migrated %d posting images and %d icons, %d failed\n", report.Images, report.Icons, report.Failed)
+	if report.Failed > 0 {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,57 @@
+package main
+
+/*
+list likely reposts of the images of a posting by other users. This is for moderators.
+
+	$ go run ./cmd/reposts -posting_id 1 [-max_distance 10]
+*/
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
+	"github.com/gold-kou/ToeBeans/backend/app/application/usecase"
+	"github.com/gold-kou/ToeBeans/backend/app/domain/repository"
+)
+
+func main() {
+	postingID := flag.Int64("posting_id", 0, "posting whose images are looked for")
+	maxDistance := flag.Int("max_distance", -1, "max Hamming distance of the perceptual hashes. DUPLICATE_IMAGE_MAX_DISTANCE is used when it is negative")
+	flag.Parse()
+	if *postingID <= 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	// db connect
+	db, err := mysql.NewDB()
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer db.Close()
+	tx := mysql.NewDBTransaction(db)
+
+	// repository
+	postingRepo := repository.NewPostingRepository(db)
+	postingImageRepo := repository.NewPostingImageRepository(db)
+
+	// UseCase
+	u := usecase.NewGetReposts(tx, *postingID, *maxDistance, postingRepo, postingImageRepo)
+	images, err := u.GetRepostsUseCase(context.Background())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "DISTANCE\tPOSTING_ID\tUSER_NAME\tPOSTED_AT\tIMAGE_URL")
+	for _, i := range images {
+		fmt.Fprintf(w, "%d\t%d\t%s\t%s\t%s\n", i.Distance, i.PostingID, i.UserName, i.CreatedAt.Format(time.RFC3339), i.ImageURL)
+	}
+	w.Flush()
+}
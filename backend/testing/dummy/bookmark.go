@@ -0,0 +1,17 @@
+package dummy
+
+import (
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+)
+
+var Bookmark1 = model.Bookmark{
+	ID:        1,
+	UserID:    User1.ID,
+	PostingID: Posting2.ID,
+}
+
+var Bookmark2 = model.Bookmark{
+	ID:        2,
+	UserID:    User2.ID,
+	PostingID: Posting1.ID,
+}
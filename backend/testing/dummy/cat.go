@@ -0,0 +1,32 @@
+package dummy
+
+import (
+	"time"
+
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+)
+
+var catBirthday = time.Date(2018, 4, 1, 0, 0, 0, 0, time.Local)
+
+var Cat1 = model.Cat{
+	ID:       1,
+	UserID:   User1.ID,
+	Name:     "Tama",
+	Breed:    "Japanese Bobtail",
+	Birthday: &catBirthday,
+	Icon:     "UNKNOWN",
+	Bio:      "likes napping in the sun",
+}
+
+var Cat2 = model.Cat{
+	ID:     2,
+	UserID: User2.ID,
+	Name:   "Mike",
+	Icon:   "UNKNOWN",
+}
+
+var PostingCat1 = model.PostingCat{
+	ID:        1,
+	PostingID: Posting1.ID,
+	CatID:     Cat1.ID,
+}
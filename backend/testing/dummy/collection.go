@@ -0,0 +1,32 @@
+package dummy
+
+import (
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+)
+
+var Collection1 = model.Collection{
+	ID:       1,
+	UserID:   User1.ID,
+	Name:     "sleeping cats",
+	IsPublic: true,
+}
+
+var Collection2 = model.Collection{
+	ID:     2,
+	UserID: User1.ID,
+	Name:   "secret",
+}
+
+var CollectionPosting1 = model.CollectionPosting{
+	ID:           1,
+	CollectionID: Collection1.ID,
+	PostingID:    Posting1.ID,
+	Position:     0,
+}
+
+var CollectionPosting2 = model.CollectionPosting{
+	ID:           2,
+	CollectionID: Collection1.ID,
+	PostingID:    Posting2.ID,
+	Position:     1,
+}
@@ -0,0 +1,27 @@
+package dummy
+
+import (
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+)
+
+var Draft1 = model.Draft{
+	ID:        1,
+	UserID:    User1.ID,
+	Title:     "This is a sample draft. #cat_nap",
+	ObjectKey: "uploads/1/0f8fad5b-d9cb-469f-a165-70867728950e",
+}
+
+// Draft2 is saved before the title is written.
+var Draft2 = model.Draft{
+	ID:        2,
+	UserID:    User1.ID,
+	AltText:   "test draft alt text",
+	ObjectKey: "uploads/1/7c9e6679-7425-40de-944b-e07fc1f90ae7",
+}
+
+var Draft3 = model.Draft{
+	ID:        3,
+	UserID:    User2.ID,
+	Title:     "test draft title",
+	ObjectKey: "uploads/2/16fd2706-8baf-433b-82eb-8c7fada847da",
+}
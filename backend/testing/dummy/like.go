@@ -15,3 +15,9 @@ var Like2to1 = model.Like{
 	UserID:    User2.ID,
 	PostingID: Posting1.ID, // you can't like yourself posting
 }
+
+var Like3to1 = model.Like{
+	ID:        3,
+	UserID:    User3.ID,
+	PostingID: Posting1.ID,
+}
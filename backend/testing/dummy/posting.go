@@ -8,7 +8,8 @@ var Posting1 = model.Posting{
 	ID:       1,
 	UserID:   User1.ID,
 	Title:    "This is a sample posting.",
-	ImageURL: "http://localhost:9000/toebeans-postings/20200101000000_testUser1",
+	ImageURL: "http://localhost:9000/toebeans-postings/20200101000000_testUser1_full.jpg",
+	AltText:  "Cat, Whiskers, Tabby cat",
 }
 
 var Posting2 = model.Posting{
@@ -16,4 +17,13 @@ var Posting2 = model.Posting{
 	UserID:   User2.ID,
 	Title:    "test title",
 	ImageURL: "test url",
+	AltText:  "test alt text",
+}
+
+var PostingImage1 = model.PostingImage{
+	ID:        1,
+	PostingID: Posting1.ID,
+	Position:  0,
+	ImageURL:  Posting1.ImageURL,
+	ObjectKey: "20200101000000_testUser1",
 }
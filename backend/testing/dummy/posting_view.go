@@ -0,0 +1,21 @@
+package dummy
+
+import (
+	"time"
+
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+)
+
+var PostingView2to1 = model.PostingView{
+	PostingID:   Posting1.ID,
+	UserID:      User2.ID,
+	Kind:        model.PostingViewKindDetail,
+	WindowStart: time.Date(2020, 1, 1, 0, 0, 0, 0, time.Local),
+}
+
+var PostingView1to2 = model.PostingView{
+	PostingID:   Posting2.ID,
+	UserID:      User1.ID,
+	Kind:        model.PostingViewKindImpression,
+	WindowStart: time.Date(2020, 1, 1, 0, 0, 0, 0, time.Local),
+}
@@ -0,0 +1,33 @@
+package dummy
+
+import (
+	"github.com/gold-kou/ToeBeans/backend/app/domain/model"
+)
+
+var Tag1 = model.Tag{
+	ID:   1,
+	Name: "cat_nap",
+}
+
+var Tag2 = model.Tag{
+	ID:   2,
+	Name: "tabby",
+}
+
+var PostingTag1 = model.PostingTag{
+	ID:        1,
+	PostingID: Posting1.ID,
+	TagID:     Tag1.ID,
+}
+
+var PostingTag2 = model.PostingTag{
+	ID:        2,
+	PostingID: Posting2.ID,
+	TagID:     Tag1.ID,
+}
+
+var PostingTag3 = model.PostingTag{
+	ID:        3,
+	PostingID: Posting2.ID,
+	TagID:     Tag2.ID,
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 	"os"
+	"regexp"
 	"time"
 
 	"github.com/gold-kou/ToeBeans/backend/app/adapter/mysql"
@@ -38,6 +39,13 @@ func ResetTime() {
 	lib.NowFunc = time.Now
 }
 
+var urlSignature = regexp.MustCompile(`\?X-Amz-[^"]*`)
+
+// StripURLSignatures removes the query of presigned URLs, which changes every time, from the response body.
+func StripURLSignatures(body string) string {
+	return urlSignature.ReplaceAllString(body, "")
+}
+
 func SetupDBTest() *sql.DB {
 	db, err := mysql.NewDB()
 	if err != nil {
@@ -105,6 +113,24 @@ func TeardownDBTest(db *sql.DB) {
 	if err := DeleteAllTableData(db, "notifications"); err != nil {
 		panic(err)
 	}
+	if err := DeleteAllTableData(db, "object_deletions"); err != nil {
+		panic(err)
+	}
+	if err := DeleteAllTableData(db, "posting_scores"); err != nil {
+		panic(err)
+	}
+	if err := DeleteAllTableData(db, "posting_views"); err != nil {
+		panic(err)
+	}
+	if err := DeleteAllTableData(db, "posting_counters"); err != nil {
+		panic(err)
+	}
+	if err := DeleteAllTableData(db, "user_counters"); err != nil {
+		panic(err)
+	}
+	if err := DeleteAllTableData(db, "drafts"); err != nil {
+		panic(err)
+	}
 	if err := DeleteAllTableData(db, "likes"); err != nil {
 		panic(err)
 	}
@@ -114,6 +140,33 @@ func TeardownDBTest(db *sql.DB) {
 	if err := DeleteAllTableData(db, "follows"); err != nil {
 		panic(err)
 	}
+	if err := DeleteAllTableData(db, "posting_title_histories"); err != nil {
+		panic(err)
+	}
+	if err := DeleteAllTableData(db, "posting_tags"); err != nil {
+		panic(err)
+	}
+	if err := DeleteAllTableData(db, "tags"); err != nil {
+		panic(err)
+	}
+	if err := DeleteAllTableData(db, "collection_postings"); err != nil {
+		panic(err)
+	}
+	if err := DeleteAllTableData(db, "collections"); err != nil {
+		panic(err)
+	}
+	if err := DeleteAllTableData(db, "bookmarks"); err != nil {
+		panic(err)
+	}
+	if err := DeleteAllTableData(db, "posting_cats"); err != nil {
+		panic(err)
+	}
+	if err := DeleteAllTableData(db, "cats"); err != nil {
+		panic(err)
+	}
+	if err := DeleteAllTableData(db, "posting_images"); err != nil {
+		panic(err)
+	}
 	if err := DeleteAllTableData(db, "postings"); err != nil {
 		panic(err)
 	}
@@ -179,7 +232,7 @@ func FindAllPasswordResets(ctx context.Context, db *sql.DB) ([]model.PasswordRes
 }
 
 func FindAllPostings(ctx context.Context, db *sql.DB) ([]model.Posting, error) {
-	q := "SELECT `id`, `user_id`, `title`, `image_url`, `created_at`, `updated_at` FROM `postings`"
+	q := "SELECT `id`, `user_id`, `title`, `image_url`, `alt_text`, `edited_at`, `created_at`, `updated_at` FROM `postings`"
 	rows, err := db.QueryContext(ctx, q)
 	if err != nil {
 		return nil, err
@@ -189,7 +242,7 @@ func FindAllPostings(ctx context.Context, db *sql.DB) ([]model.Posting, error) {
 	result := []model.Posting{}
 	for rows.Next() {
 		var p model.Posting
-		if err := rows.Scan(&p.ID, &p.UserID, &p.Title, &p.ImageURL, &p.CreatedAt, &p.UpdatedAt); err != nil {
+		if err := rows.Scan(&p.ID, &p.UserID, &p.Title, &p.ImageURL, &p.AltText, &p.EditedAt, &p.CreatedAt, &p.UpdatedAt); err != nil {
 			return nil, err
 		}
 		result = append(result, p)
@@ -204,6 +257,371 @@ func FindAllPostings(ctx context.Context, db *sql.DB) ([]model.Posting, error) {
 	return result, nil
 }
 
+func FindAllPostingImages(ctx context.Context, db *sql.DB) ([]model.PostingImage, error) {
+	q := "SELECT `id`, `posting_id`, `position`, `image_url`, `object_key`, `dhash`, `created_at`, `updated_at` FROM `posting_images`"
+	rows, err := db.QueryContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := []model.PostingImage{}
+	for rows.Next() {
+		var i model.PostingImage
+		if err := rows.Scan(&i.ID, &i.PostingID, &i.Position, &i.ImageURL, &i.ObjectKey, &i.DHash, &i.CreatedAt, &i.UpdatedAt); err != nil {
+			return nil, err
+		}
+		result = append(result, i)
+	}
+
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func FindAllObjectDeletions(ctx context.Context, db *sql.DB) ([]model.ObjectDeletion, error) {
+	q := "SELECT `id`, `bucket`, `object_key`, `attempts`, `next_attempt_at`, `created_at`, `updated_at` FROM `object_deletions`"
+	rows, err := db.QueryContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := []model.ObjectDeletion{}
+	for rows.Next() {
+		var d model.ObjectDeletion
+		if err := rows.Scan(&d.ID, &d.Bucket, &d.ObjectKey, &d.Attempts, &d.NextAttemptAt, &d.CreatedAt, &d.UpdatedAt); err != nil {
+			return nil, err
+		}
+		result = append(result, d)
+	}
+
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func FindAllTags(ctx context.Context, db *sql.DB) ([]model.Tag, error) {
+	q := "SELECT `id`, `name`, `created_at`, `updated_at` FROM `tags`"
+	rows, err := db.QueryContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := []model.Tag{}
+	for rows.Next() {
+		var t model.Tag
+		if err := rows.Scan(&t.ID, &t.Name, &t.CreatedAt, &t.UpdatedAt); err != nil {
+			return nil, err
+		}
+		result = append(result, t)
+	}
+
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func FindAllPostingTags(ctx context.Context, db *sql.DB) ([]model.PostingTag, error) {
+	q := "SELECT `id`, `posting_id`, `tag_id`, `created_at`, `updated_at` FROM `posting_tags`"
+	rows, err := db.QueryContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := []model.PostingTag{}
+	for rows.Next() {
+		var pt model.PostingTag
+		if err := rows.Scan(&pt.ID, &pt.PostingID, &pt.TagID, &pt.CreatedAt, &pt.UpdatedAt); err != nil {
+			return nil, err
+		}
+		result = append(result, pt)
+	}
+
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func FindAllCats(ctx context.Context, db *sql.DB) ([]model.Cat, error) {
+	q := "SELECT `id`, `user_id`, `name`, `breed`, `birthday`, `icon`, `bio`, `created_at`, `updated_at` FROM `cats`"
+	rows, err := db.QueryContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := []model.Cat{}
+	for rows.Next() {
+		var c model.Cat
+		if err := rows.Scan(&c.ID, &c.UserID, &c.Name, &c.Breed, &c.Birthday, &c.Icon, &c.Bio, &c.CreatedAt, &c.UpdatedAt); err != nil {
+			return nil, err
+		}
+		result = append(result, c)
+	}
+
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func FindAllPostingCats(ctx context.Context, db *sql.DB) ([]model.PostingCat, error) {
+	q := "SELECT `id`, `posting_id`, `cat_id`, `created_at`, `updated_at` FROM `posting_cats`"
+	rows, err := db.QueryContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := []model.PostingCat{}
+	for rows.Next() {
+		var pc model.PostingCat
+		if err := rows.Scan(&pc.ID, &pc.PostingID, &pc.CatID, &pc.CreatedAt, &pc.UpdatedAt); err != nil {
+			return nil, err
+		}
+		result = append(result, pc)
+	}
+
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func FindAllBookmarks(ctx context.Context, db *sql.DB) ([]model.Bookmark, error) {
+	q := "SELECT `id`, `user_id`, `posting_id`, `created_at`, `updated_at` FROM `bookmarks`"
+	rows, err := db.QueryContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := []model.Bookmark{}
+	for rows.Next() {
+		var b model.Bookmark
+		if err := rows.Scan(&b.ID, &b.UserID, &b.PostingID, &b.CreatedAt, &b.UpdatedAt); err != nil {
+			return nil, err
+		}
+		result = append(result, b)
+	}
+
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func FindAllCollections(ctx context.Context, db *sql.DB) ([]model.Collection, error) {
+	q := "SELECT `id`, `user_id`, `name`, `is_public`, `created_at`, `updated_at` FROM `collections`"
+	rows, err := db.QueryContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := []model.Collection{}
+	for rows.Next() {
+		var c model.Collection
+		if err := rows.Scan(&c.ID, &c.UserID, &c.Name, &c.IsPublic, &c.CreatedAt, &c.UpdatedAt); err != nil {
+			return nil, err
+		}
+		result = append(result, c)
+	}
+
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// FindAllCollectionPostings returns the postings in all the collections in the order of position in each collection.
+func FindAllCollectionPostings(ctx context.Context, db *sql.DB) ([]model.CollectionPosting, error) {
+	q := "SELECT `id`, `collection_id`, `posting_id`, `position`, `created_at`, `updated_at` FROM `collection_postings` ORDER BY `collection_id`, `position`"
+	rows, err := db.QueryContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := []model.CollectionPosting{}
+	for rows.Next() {
+		var cp model.CollectionPosting
+		if err := rows.Scan(&cp.ID, &cp.CollectionID, &cp.PostingID, &cp.Position, &cp.CreatedAt, &cp.UpdatedAt); err != nil {
+			return nil, err
+		}
+		result = append(result, cp)
+	}
+
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func FindAllPostingViews(ctx context.Context, db *sql.DB) ([]model.PostingView, error) {
+	q := "SELECT `id`, `posting_id`, `user_id`, `kind`, `window_start`, `created_at`, `updated_at` FROM `posting_views` ORDER BY `id`"
+	rows, err := db.QueryContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := []model.PostingView{}
+	for rows.Next() {
+		var v model.PostingView
+		if err := rows.Scan(&v.ID, &v.PostingID, &v.UserID, &v.Kind, &v.WindowStart, &v.CreatedAt, &v.UpdatedAt); err != nil {
+			return nil, err
+		}
+		result = append(result, v)
+	}
+
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func FindAllDrafts(ctx context.Context, db *sql.DB) ([]model.Draft, error) {
+	q := "SELECT `id`, `user_id`, `title`, `alt_text`, `object_key`, `created_at`, `updated_at` FROM `drafts` ORDER BY `id`"
+	rows, err := db.QueryContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := []model.Draft{}
+	for rows.Next() {
+		var d model.Draft
+		if err := rows.Scan(&d.ID, &d.UserID, &d.Title, &d.AltText, &d.ObjectKey, &d.CreatedAt, &d.UpdatedAt); err != nil {
+			return nil, err
+		}
+		result = append(result, d)
+	}
+
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func FindAllPostingCounters(ctx context.Context, db *sql.DB) ([]model.PostingCounter, error) {
+	q := "SELECT `id`, `posting_id`, `liked_count`, `comment_count`, `created_at`, `updated_at` FROM `posting_counters` ORDER BY `posting_id`"
+	rows, err := db.QueryContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := []model.PostingCounter{}
+	for rows.Next() {
+		var c model.PostingCounter
+		if err := rows.Scan(&c.ID, &c.PostingID, &c.LikedCount, &c.CommentCount, &c.CreatedAt, &c.UpdatedAt); err != nil {
+			return nil, err
+		}
+		result = append(result, c)
+	}
+
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func FindAllUserCounters(ctx context.Context, db *sql.DB) ([]model.UserCounter, error) {
+	q := "SELECT `id`, `user_id`, `posting_count`, `like_count`, `liked_count`, `follow_count`, `followed_count`, `created_at`, `updated_at` FROM `user_counters` ORDER BY `user_id`"
+	rows, err := db.QueryContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := []model.UserCounter{}
+	for rows.Next() {
+		var c model.UserCounter
+		if err := rows.Scan(&c.ID, &c.UserID, &c.PostingCount, &c.LikeCount, &c.LikedCount, &c.FollowCount, &c.FollowedCount, &c.CreatedAt, &c.UpdatedAt); err != nil {
+			return nil, err
+		}
+		result = append(result, c)
+	}
+
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func FindAllPostingTitleHistories(ctx context.Context, db *sql.DB) ([]model.PostingTitleHistory, error) {
+	q := "SELECT `id`, `posting_id`, `title`, `created_at`, `updated_at` FROM `posting_title_histories`"
+	rows, err := db.QueryContext(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := []model.PostingTitleHistory{}
+	for rows.Next() {
+		var h model.PostingTitleHistory
+		if err := rows.Scan(&h.ID, &h.PostingID, &h.Title, &h.CreatedAt, &h.UpdatedAt); err != nil {
+			return nil, err
+		}
+		result = append(result, h)
+	}
+
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func FindAllLikes(ctx context.Context, db *sql.DB) ([]model.Like, error) {
 	q := "SELECT `id`, `user_id`, `posting_id`, `created_at`, `updated_at` FROM `likes`"
 	rows, err := db.QueryContext(ctx, q)
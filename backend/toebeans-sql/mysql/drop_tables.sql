@@ -1 +1 @@
-DROP TABLE IF EXISTS`posting_reports`, `user_reports`, `notifications`, `follows`, `comments`, `likes`, `postings`, `password_resets`, `users`;
+DROP TABLE IF EXISTS`posting_reports`, `user_reports`, `notifications`, `follows`, `posting_scores`, `posting_views`, `posting_counters`, `user_counters`, `object_deletions`, `drafts`, `comments`, `likes`, `posting_title_histories`, `posting_images`, `posting_tags`, `tags`, `collection_postings`, `collections`, `bookmarks`, `posting_cats`, `cats`, `postings`, `password_resets`, `users`;
@@ -9,7 +9,8 @@ CREATE TABLE `users` (
     `email_verified` BOOLEAN NOT NULL DEFAULT FALSE COMMENT 'メール本人確認が済んでいるかどうか',
     `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時',
     `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP on UPDATE CURRENT_TIMESTAMP COMMENT '更新日時',
-    INDEX idx_users_name(name)
+    INDEX idx_users_name(name),
+    FULLTEXT INDEX ft_users_name_self_introduction(name, self_introduction) WITH PARSER ngram COMMENT '検索用'
 )COMMENT 'ユーザテーブル';
 
 CREATE TABLE `password_resets` (
@@ -27,13 +28,139 @@ CREATE TABLE `postings` (
     `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT 'サロゲートキー',
     `user_id` INT NOT NULL,
     `title` VARCHAR(255) NOT NULL,
-    `image_url` VARCHAR(255) NOT NULL,
-    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時',
+    `image_url` VARCHAR(255) NOT NULL COMMENT 'カバー画像(posting_imagesのposition 0)のURL',
+    `alt_text` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '画像の代替テキスト。未入力なら画像認識のラベルから作る。',
+    `edited_at` DATETIME DEFAULT NULL COMMENT 'タイトル編集日時。未編集ならNULL。',
+    `publish_at` DATETIME DEFAULT NULL COMMENT '予約投稿の公開日時。即時公開と公開済みはNULL。公開時刻を過ぎたら定期ジョブがNULLにして投稿数に数える。',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時。予約投稿では公開順に並ぶようにpublish_atと同じにする。',
     `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP on UPDATE CURRENT_TIMESTAMP COMMENT '更新日時',
     CONSTRAINT `postings_user_id` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`),
-    INDEX idx_postings_user_id(user_id)
+    INDEX idx_postings_user_id(user_id),
+    INDEX idx_postings_publish_at(publish_at),
+    INDEX idx_postings_user_id_publish_at(user_id, publish_at) COMMENT '自分の予約投稿の一覧用',
+    FULLTEXT INDEX ft_postings_title(title) WITH PARSER ngram COMMENT '検索用'
 )COMMENT '投稿テーブル';
 
+CREATE TABLE `posting_title_histories` (
+    `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT 'サロゲートキー',
+    `posting_id` INT NOT NULL COMMENT 'モデレーション用に投稿削除後も残すため外部キーにしない',
+    `title` VARCHAR(255) NOT NULL COMMENT '編集前のタイトル',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP on UPDATE CURRENT_TIMESTAMP COMMENT '更新日時',
+    INDEX idx_posting_title_histories_posting_id(posting_id)
+)COMMENT '投稿タイトル編集履歴テーブル';
+
+CREATE TABLE `posting_images` (
+    `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT 'サロゲートキー',
+    `posting_id` INT NOT NULL,
+    `position` TINYINT UNSIGNED NOT NULL COMMENT '投稿内での表示順。0始まり。',
+    `image_url` VARCHAR(255) NOT NULL COMMENT 'fullバリアントのURL',
+    `object_key` VARCHAR(255) NOT NULL COMMENT 'S3のオブジェクトキー。各バリアントのキーはこれに接尾辞を付けたもの。',
+    `dhash` BIGINT UNSIGNED DEFAULT NULL COMMENT '重複検出用の知覚ハッシュ(dHash)。ハッシュ導入前の画像はNULL。',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP on UPDATE CURRENT_TIMESTAMP COMMENT '更新日時',
+    CONSTRAINT `posting_images_posting_id` FOREIGN KEY (`posting_id`) REFERENCES `postings` (`id`),
+    UNIQUE `uk_posting_id_position` (`posting_id`, `position`),
+    INDEX idx_posting_images_object_key(object_key) COMMENT 'S3オブジェクトを削除する前に参照が残っていないか調べる用'
+)COMMENT '投稿画像テーブル';
+
+CREATE TABLE `tags` (
+    `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT 'サロゲートキー',
+    `name` VARCHAR(100) NOT NULL COMMENT '#を除き小文字に正規化したタグ名',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP on UPDATE CURRENT_TIMESTAMP COMMENT '更新日時',
+    UNIQUE `uk_name` (`name`)
+)COMMENT 'タグテーブル';
+
+CREATE TABLE `posting_tags` (
+    `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT 'サロゲートキー',
+    `posting_id` INT NOT NULL,
+    `tag_id` INT NOT NULL,
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP on UPDATE CURRENT_TIMESTAMP COMMENT '更新日時',
+    CONSTRAINT `posting_tags_posting_id` FOREIGN KEY (`posting_id`) REFERENCES `postings` (`id`),
+    CONSTRAINT `posting_tags_tag_id` FOREIGN KEY (`tag_id`) REFERENCES `tags` (`id`),
+    UNIQUE `uk_posting_id_tag_id` (`posting_id`, `tag_id`),
+    INDEX idx_posting_tags_tag_id_created_at(tag_id, created_at),
+    INDEX idx_posting_tags_created_at(created_at)
+)COMMENT '投稿タグテーブル';
+
+CREATE TABLE `cats` (
+    `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT 'サロゲートキー',
+    `user_id` INT NOT NULL COMMENT '飼い主のユーザID',
+    `name` VARCHAR(255) NOT NULL,
+    `breed` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '猫種',
+    `birthday` DATE DEFAULT NULL COMMENT '誕生日。不明ならNULL。',
+    `icon` VARCHAR(255) NOT NULL DEFAULT 'UNKNOWN',
+    `bio` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '紹介文',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP on UPDATE CURRENT_TIMESTAMP COMMENT '更新日時',
+    CONSTRAINT `cats_user_id` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`),
+    INDEX idx_cats_user_id(user_id)
+)COMMENT '猫テーブル';
+
+CREATE TABLE `posting_cats` (
+    `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT 'サロゲートキー',
+    `posting_id` INT NOT NULL,
+    `cat_id` INT NOT NULL,
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP on UPDATE CURRENT_TIMESTAMP COMMENT '更新日時',
+    CONSTRAINT `posting_cats_posting_id` FOREIGN KEY (`posting_id`) REFERENCES `postings` (`id`),
+    CONSTRAINT `posting_cats_cat_id` FOREIGN KEY (`cat_id`) REFERENCES `cats` (`id`),
+    UNIQUE `uk_posting_id_cat_id` (`posting_id`, `cat_id`),
+    INDEX idx_posting_cats_cat_id(cat_id)
+)COMMENT '投稿に写っている猫のテーブル';
+
+CREATE TABLE `bookmarks` (
+    `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT 'サロゲートキー',
+    `user_id` INT NOT NULL,
+    `posting_id` INT NOT NULL,
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP on UPDATE CURRENT_TIMESTAMP COMMENT '更新日時',
+    CONSTRAINT `bookmarks_user_id` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`),
+    CONSTRAINT `bookmarks_posting_id` FOREIGN KEY (`posting_id`) REFERENCES `postings` (`id`),
+    UNIQUE `uk_user_id_posting_id` (`user_id`, `posting_id`),
+    INDEX idx_bookmarks_posting_id(posting_id)
+)COMMENT 'ブックマークテーブル。本人にしか見えない。';
+
+CREATE TABLE `collections` (
+    `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT 'サロゲートキー',
+    `user_id` INT NOT NULL,
+    `name` VARCHAR(255) NOT NULL,
+    `is_public` BOOLEAN NOT NULL DEFAULT FALSE COMMENT '他のユーザにも見せるかどうか',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP on UPDATE CURRENT_TIMESTAMP COMMENT '更新日時',
+    CONSTRAINT `collections_user_id` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`),
+    INDEX idx_collections_user_id(user_id)
+)COMMENT 'コレクションテーブル';
+
+CREATE TABLE `collection_postings` (
+    `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT 'サロゲートキー',
+    `collection_id` INT NOT NULL,
+    `posting_id` INT NOT NULL,
+    `position` INT UNSIGNED NOT NULL COMMENT 'コレクション内での表示順。並べ替えで振り直す。',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP on UPDATE CURRENT_TIMESTAMP COMMENT '更新日時',
+    CONSTRAINT `collection_postings_collection_id` FOREIGN KEY (`collection_id`) REFERENCES `collections` (`id`),
+    CONSTRAINT `collection_postings_posting_id` FOREIGN KEY (`posting_id`) REFERENCES `postings` (`id`),
+    UNIQUE `uk_collection_id_posting_id` (`collection_id`, `posting_id`),
+    INDEX idx_collection_postings_posting_id(posting_id)
+)COMMENT 'コレクションに入っている投稿のテーブル';
+
+CREATE TABLE `drafts` (
+    `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT 'サロゲートキー',
+    `user_id` INT NOT NULL,
+    `title` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '未入力のまま保存できる。公開時に投稿と同じ検証をする。',
+    `alt_text` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '画像の代替テキスト。未入力なら公開時に画像認識のラベルから作る。',
+    `object_key` VARCHAR(255) NOT NULL COMMENT 'POST /uploadsでアップロードされた画像のキー。公開時に投稿の画像に変換する。',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP on UPDATE CURRENT_TIMESTAMP COMMENT '更新日時。保存期間はここから数える。',
+    CONSTRAINT `drafts_user_id` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`),
+    UNIQUE `uk_object_key` (`object_key`),
+    INDEX idx_drafts_user_id(user_id),
+    INDEX idx_drafts_updated_at(updated_at)
+)COMMENT '下書きテーブル。本人にしか見えない。保存期間を過ぎると画像と一緒に削除する。';
+
 CREATE TABLE `likes` (
     `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT 'サロゲートキー',
     `user_id` INT NOT NULL,
@@ -42,7 +169,9 @@ CREATE TABLE `likes` (
     `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP on UPDATE CURRENT_TIMESTAMP COMMENT '更新日時',
     CONSTRAINT `likes_user_id` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`),
     CONSTRAINT `likes_posting_id` FOREIGN KEY (`posting_id`) REFERENCES `postings` (`id`),
-    UNIQUE `uk_user_id_posting_id` (`user_id`, `posting_id`)
+    UNIQUE `uk_user_id_posting_id` (`user_id`, `posting_id`),
+    INDEX idx_likes_created_at(created_at),
+    INDEX idx_likes_posting_id_created_at(posting_id, created_at) COMMENT '投稿にいいねしたユーザを新しい順に取得する用'
 )COMMENT 'いいねテーブル';
 
 CREATE TABLE `comments` (
@@ -54,9 +183,69 @@ CREATE TABLE `comments` (
     `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP on UPDATE CURRENT_TIMESTAMP COMMENT '更新日時',
     CONSTRAINT `comments_user_id` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`),
     CONSTRAINT `comments_posting_id` FOREIGN KEY (`posting_id`) REFERENCES `postings` (`id`),
-    INDEX idx_comments_posting_id(posting_id)
+    INDEX idx_comments_posting_id(posting_id),
+    INDEX idx_comments_created_at(created_at)
 )COMMENT 'コメントテーブル';
 
+CREATE TABLE `posting_scores` (
+    `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT 'サロゲートキー',
+    `posting_id` INT NOT NULL COMMENT '削除された投稿の行は次の再計算で消えるため外部キーは張らない',
+    `period` ENUM('day', 'week', 'all') NOT NULL COMMENT '集計期間',
+    `score` DOUBLE NOT NULL COMMENT 'いいねとコメントを経過時間で減衰させて重み付けした合計',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP on UPDATE CURRENT_TIMESTAMP COMMENT '更新日時',
+    UNIQUE `uk_period_posting_id` (`period`, `posting_id`),
+    INDEX idx_posting_scores_period_score(period, score)
+)COMMENT '人気投稿スコアテーブル。定期ジョブが再計算する。';
+
+CREATE TABLE `posting_views` (
+    `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT 'サロゲートキー',
+    `posting_id` INT NOT NULL COMMENT 'メモリ上のバッファから遅れて書き込まれ、削除済みの投稿を指すことがあるため外部キーは張らない',
+    `user_id` INT NOT NULL COMMENT '閲覧したユーザ',
+    `kind` ENUM('impression', 'detail') NOT NULL COMMENT 'impressionはフィードでの表示、detailは投稿詳細の閲覧',
+    `window_start` DATETIME NOT NULL COMMENT '重複排除する時間枠の開始日時。同じ枠内の同じユーザの閲覧は1回と数える',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP on UPDATE CURRENT_TIMESTAMP COMMENT '更新日時',
+    UNIQUE `uk_posting_id_kind_window_start_user_id` (`posting_id`, `kind`, `window_start`, `user_id`),
+    INDEX idx_posting_views_user_id(user_id)
+)COMMENT '投稿閲覧テーブル。定期ジョブがまとめて書き込む。';
+
+CREATE TABLE `posting_counters` (
+    `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT 'サロゲートキー',
+    `posting_id` INT NOT NULL,
+    `liked_count` INT NOT NULL DEFAULT 0 COMMENT 'いいねされた数',
+    `comment_count` INT NOT NULL DEFAULT 0 COMMENT 'コメントされた数',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP on UPDATE CURRENT_TIMESTAMP COMMENT '更新日時',
+    CONSTRAINT `posting_counters_posting_id` FOREIGN KEY (`posting_id`) REFERENCES `postings` (`id`),
+    UNIQUE `uk_posting_id` (`posting_id`)
+)COMMENT '投稿の件数テーブル。いいねとコメントと同じトランザクションで増減させ、ずれは定期ジョブが数え直す。行がなければ0件。';
+
+CREATE TABLE `user_counters` (
+    `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT 'サロゲートキー',
+    `user_id` INT NOT NULL,
+    `posting_count` INT NOT NULL DEFAULT 0 COMMENT '投稿数。予約投稿は公開されてから数える。',
+    `like_count` INT NOT NULL DEFAULT 0 COMMENT 'いいねした数',
+    `liked_count` INT NOT NULL DEFAULT 0 COMMENT '投稿がいいねされた数',
+    `follow_count` INT NOT NULL DEFAULT 0 COMMENT 'フォローしている数',
+    `followed_count` INT NOT NULL DEFAULT 0 COMMENT 'フォローされている数',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP on UPDATE CURRENT_TIMESTAMP COMMENT '更新日時',
+    CONSTRAINT `user_counters_user_id` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`),
+    UNIQUE `uk_user_id` (`user_id`)
+)COMMENT 'ユーザの件数テーブル。投稿、いいね、フォローと同じトランザクションで増減させ、ずれは定期ジョブが数え直す。行がなければ0件。';
+
+CREATE TABLE `object_deletions` (
+    `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT 'サロゲートキー',
+    `bucket` VARCHAR(255) NOT NULL COMMENT 'S3バケット名',
+    `object_key` VARCHAR(255) NOT NULL COMMENT '削除するオブジェクトのキー',
+    `attempts` INT NOT NULL DEFAULT 0 COMMENT '失敗した削除の回数',
+    `next_attempt_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '次に削除を試みる日時',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP on UPDATE CURRENT_TIMESTAMP COMMENT '更新日時',
+    INDEX idx_object_deletions_next_attempt_at(next_attempt_at)
+)COMMENT 'S3オブジェクト削除キュー。行の削除と同じトランザクションで積み、コミット後と定期ジョブで削除する。';
+
 CREATE TABLE `follows` (
     `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT 'サロゲートキー',
     `following_user_id` INT NOT NULL,
@@ -0,0 +1,16 @@
+-- 既存DB向け。posting_imagesテーブルを作成し、単一画像の投稿をposition 0の画像として移行する。
+-- 何度実行しても結果は変わらない。
+CREATE TABLE IF NOT EXISTS `posting_images` (
+    `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT 'サロゲートキー',
+    `posting_id` INT NOT NULL,
+    `position` TINYINT UNSIGNED NOT NULL COMMENT '投稿内での表示順。0始まり。',
+    `image_url` VARCHAR(255) NOT NULL COMMENT 'fullバリアントのURL',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP on UPDATE CURRENT_TIMESTAMP COMMENT '更新日時',
+    CONSTRAINT `posting_images_posting_id` FOREIGN KEY (`posting_id`) REFERENCES `postings` (`id`),
+    UNIQUE `uk_posting_id_position` (`posting_id`, `position`)
+)COMMENT '投稿画像テーブル';
+
+INSERT INTO `posting_images` (`posting_id`, `position`, `image_url`, `created_at`)
+SELECT `p`.`id`, 0, `p`.`image_url`, `p`.`created_at` FROM `postings` AS `p`
+WHERE NOT EXISTS (SELECT 1 FROM `posting_images` AS `pi` WHERE `pi`.`posting_id` = `p`.`id`);
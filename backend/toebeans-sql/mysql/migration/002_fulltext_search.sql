@@ -0,0 +1,3 @@
+-- 既存DB向け。検索用のFULLTEXTインデックスを追加する。
+ALTER TABLE `users` ADD FULLTEXT INDEX ft_users_name_self_introduction(name, self_introduction) WITH PARSER ngram COMMENT '検索用';
+ALTER TABLE `postings` ADD FULLTEXT INDEX ft_postings_title(title) WITH PARSER ngram COMMENT '検索用';
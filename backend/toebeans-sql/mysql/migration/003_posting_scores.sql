@@ -0,0 +1,14 @@
+-- 既存DB向け。人気投稿スコアテーブルと、再計算で使うインデックスを追加する。
+CREATE TABLE IF NOT EXISTS `posting_scores` (
+    `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT 'サロゲートキー',
+    `posting_id` INT NOT NULL COMMENT '削除された投稿の行は次の再計算で消えるため外部キーは張らない',
+    `period` ENUM('day', 'week', 'all') NOT NULL COMMENT '集計期間',
+    `score` DOUBLE NOT NULL COMMENT 'いいねとコメントを経過時間で減衰させて重み付けした合計',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP on UPDATE CURRENT_TIMESTAMP COMMENT '更新日時',
+    UNIQUE `uk_period_posting_id` (`period`, `posting_id`),
+    INDEX idx_posting_scores_period_score(period, score)
+)COMMENT '人気投稿スコアテーブル。定期ジョブが再計算する。';
+
+ALTER TABLE `likes` ADD INDEX idx_likes_created_at(created_at);
+ALTER TABLE `comments` ADD INDEX idx_comments_created_at(created_at);
@@ -0,0 +1,2 @@
+-- 既存DB向け。重複検出用の知覚ハッシュを追加する。既存の画像はNULLのままで、重複検出の対象にならない。
+ALTER TABLE `posting_images` ADD COLUMN `dhash` BIGINT UNSIGNED DEFAULT NULL COMMENT '重複検出用の知覚ハッシュ(dHash)。ハッシュ導入前の画像はNULL。' AFTER `image_url`;
@@ -0,0 +1,22 @@
+-- 既存DB向け。投稿画像のS3オブジェクトキーと、S3オブジェクト削除キューを追加する。
+-- 既存の画像のキーはURLの末尾から求める。バリアント導入前の画像はURLの末尾がそのままキーになる。
+ALTER TABLE `posting_images` ADD COLUMN `object_key` VARCHAR(255) NOT NULL DEFAULT '' COMMENT 'S3のオブジェクトキー。各バリアントのキーはこれに接尾辞を付けたもの。' AFTER `image_url`;
+
+UPDATE `posting_images` SET `object_key` = IF(
+    `image_url` LIKE '%\_full.jpg',
+    SUBSTRING(SUBSTRING_INDEX(`image_url`, '/', -1), 1, CHAR_LENGTH(SUBSTRING_INDEX(`image_url`, '/', -1)) - CHAR_LENGTH('_full.jpg')),
+    SUBSTRING_INDEX(`image_url`, '/', -1)
+) WHERE `object_key` = '';
+
+ALTER TABLE `posting_images` ALTER COLUMN `object_key` DROP DEFAULT;
+
+CREATE TABLE IF NOT EXISTS `object_deletions` (
+    `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT 'サロゲートキー',
+    `bucket` VARCHAR(255) NOT NULL COMMENT 'S3バケット名',
+    `object_key` VARCHAR(255) NOT NULL COMMENT '削除するオブジェクトのキー',
+    `attempts` INT NOT NULL DEFAULT 0 COMMENT '失敗した削除の回数',
+    `next_attempt_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '次に削除を試みる日時',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP on UPDATE CURRENT_TIMESTAMP COMMENT '更新日時',
+    INDEX idx_object_deletions_next_attempt_at(next_attempt_at)
+)COMMENT 'S3オブジェクト削除キュー。行の削除と同じトランザクションで積み、コミット後と定期ジョブで削除する。';
@@ -0,0 +1,4 @@
+-- 既存DB向け。予約投稿の公開日時を追加する。既存の投稿は即時公開なのでNULLのままでよい。
+ALTER TABLE `postings` ADD COLUMN `publish_at` DATETIME DEFAULT NULL COMMENT '予約投稿の公開日時。即時公開ならNULL。' AFTER `image_url`;
+ALTER TABLE `postings` MODIFY COLUMN `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時。予約投稿では公開順に並ぶようにpublish_atと同じにする。';
+ALTER TABLE `postings` ADD INDEX idx_postings_publish_at(publish_at);
@@ -0,0 +1,26 @@
+-- 既存DB向け。猫のプロフィールと、投稿に写っている猫のテーブルを追加する。
+CREATE TABLE IF NOT EXISTS `cats` (
+    `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT 'サロゲートキー',
+    `user_id` INT NOT NULL COMMENT '飼い主のユーザID',
+    `name` VARCHAR(255) NOT NULL,
+    `breed` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '猫種',
+    `birthday` DATE DEFAULT NULL COMMENT '誕生日。不明ならNULL。',
+    `icon` VARCHAR(255) NOT NULL DEFAULT 'UNKNOWN',
+    `bio` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '紹介文',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP on UPDATE CURRENT_TIMESTAMP COMMENT '更新日時',
+    CONSTRAINT `cats_user_id` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`),
+    INDEX idx_cats_user_id(user_id)
+)COMMENT '猫テーブル';
+
+CREATE TABLE IF NOT EXISTS `posting_cats` (
+    `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT 'サロゲートキー',
+    `posting_id` INT NOT NULL,
+    `cat_id` INT NOT NULL,
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP on UPDATE CURRENT_TIMESTAMP COMMENT '更新日時',
+    CONSTRAINT `posting_cats_posting_id` FOREIGN KEY (`posting_id`) REFERENCES `postings` (`id`),
+    CONSTRAINT `posting_cats_cat_id` FOREIGN KEY (`cat_id`) REFERENCES `cats` (`id`),
+    UNIQUE `uk_posting_id_cat_id` (`posting_id`, `cat_id`),
+    INDEX idx_posting_cats_cat_id(cat_id)
+)COMMENT '投稿に写っている猫のテーブル';
@@ -0,0 +1,36 @@
+-- 既存DB向け。ブックマークと、コレクションおよびその中の投稿のテーブルを追加する。
+CREATE TABLE IF NOT EXISTS `bookmarks` (
+    `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT 'サロゲートキー',
+    `user_id` INT NOT NULL,
+    `posting_id` INT NOT NULL,
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP on UPDATE CURRENT_TIMESTAMP COMMENT '更新日時',
+    CONSTRAINT `bookmarks_user_id` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`),
+    CONSTRAINT `bookmarks_posting_id` FOREIGN KEY (`posting_id`) REFERENCES `postings` (`id`),
+    UNIQUE `uk_user_id_posting_id` (`user_id`, `posting_id`),
+    INDEX idx_bookmarks_posting_id(posting_id)
+)COMMENT 'ブックマークテーブル。本人にしか見えない。';
+
+CREATE TABLE IF NOT EXISTS `collections` (
+    `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT 'サロゲートキー',
+    `user_id` INT NOT NULL,
+    `name` VARCHAR(255) NOT NULL,
+    `is_public` BOOLEAN NOT NULL DEFAULT FALSE COMMENT '他のユーザにも見せるかどうか',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP on UPDATE CURRENT_TIMESTAMP COMMENT '更新日時',
+    CONSTRAINT `collections_user_id` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`),
+    INDEX idx_collections_user_id(user_id)
+)COMMENT 'コレクションテーブル';
+
+CREATE TABLE IF NOT EXISTS `collection_postings` (
+    `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT 'サロゲートキー',
+    `collection_id` INT NOT NULL,
+    `posting_id` INT NOT NULL,
+    `position` INT UNSIGNED NOT NULL COMMENT 'コレクション内での表示順。並べ替えで振り直す。',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP on UPDATE CURRENT_TIMESTAMP COMMENT '更新日時',
+    CONSTRAINT `collection_postings_collection_id` FOREIGN KEY (`collection_id`) REFERENCES `collections` (`id`),
+    CONSTRAINT `collection_postings_posting_id` FOREIGN KEY (`posting_id`) REFERENCES `postings` (`id`),
+    UNIQUE `uk_collection_id_posting_id` (`collection_id`, `posting_id`),
+    INDEX idx_collection_postings_posting_id(posting_id)
+)COMMENT 'コレクションに入っている投稿のテーブル';
@@ -0,0 +1,12 @@
+-- 既存DB向け。投稿の閲覧数を記録するテーブルを追加する。
+CREATE TABLE IF NOT EXISTS `posting_views` (
+    `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT 'サロゲートキー',
+    `posting_id` INT NOT NULL COMMENT 'メモリ上のバッファから遅れて書き込まれ、削除済みの投稿を指すことがあるため外部キーは張らない',
+    `user_id` INT NOT NULL COMMENT '閲覧したユーザ',
+    `kind` ENUM('impression', 'detail') NOT NULL COMMENT 'impressionはフィードでの表示、detailは投稿詳細の閲覧',
+    `window_start` DATETIME NOT NULL COMMENT '重複排除する時間枠の開始日時。同じ枠内の同じユーザの閲覧は1回と数える',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP on UPDATE CURRENT_TIMESTAMP COMMENT '更新日時',
+    UNIQUE `uk_posting_id_kind_window_start_user_id` (`posting_id`, `kind`, `window_start`, `user_id`),
+    INDEX idx_posting_views_user_id(user_id)
+)COMMENT '投稿閲覧テーブル。定期ジョブがまとめて書き込む。';
@@ -0,0 +1,2 @@
+-- 既存DB向け。投稿画像の代替テキストを追加する。既存の投稿は空文字になるので、cmd/backfill-alt-textsで画像認識のラベルから作る。
+ALTER TABLE `postings` ADD COLUMN `alt_text` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '画像の代替テキスト。未入力なら画像認識のラベルから作る。' AFTER `image_url`;
@@ -0,0 +1,14 @@
+-- 既存DB向け。下書きのテーブルを追加する。
+CREATE TABLE IF NOT EXISTS `drafts` (
+    `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT 'サロゲートキー',
+    `user_id` INT NOT NULL,
+    `title` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '未入力のまま保存できる。公開時に投稿と同じ検証をする。',
+    `alt_text` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '画像の代替テキスト。未入力なら公開時に画像認識のラベルから作る。',
+    `object_key` VARCHAR(255) NOT NULL COMMENT 'POST /uploadsでアップロードされた画像のキー。公開時に投稿の画像に変換する。',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP on UPDATE CURRENT_TIMESTAMP COMMENT '更新日時。保存期間はここから数える。',
+    CONSTRAINT `drafts_user_id` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`),
+    UNIQUE `uk_object_key` (`object_key`),
+    INDEX idx_drafts_user_id(user_id),
+    INDEX idx_drafts_updated_at(updated_at)
+)COMMENT '下書きテーブル。本人にしか見えない。保存期間を過ぎると画像と一緒に削除する。';
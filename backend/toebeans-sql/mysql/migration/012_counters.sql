@@ -0,0 +1,44 @@
+-- 既存DB向け。いいね、コメント、フォロー、投稿の件数テーブルを追加し、既存の行から数えて埋める。
+CREATE TABLE IF NOT EXISTS `posting_counters` (
+    `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT 'サロゲートキー',
+    `posting_id` INT NOT NULL,
+    `liked_count` INT NOT NULL DEFAULT 0 COMMENT 'いいねされた数',
+    `comment_count` INT NOT NULL DEFAULT 0 COMMENT 'コメントされた数',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP on UPDATE CURRENT_TIMESTAMP COMMENT '更新日時',
+    CONSTRAINT `posting_counters_posting_id` FOREIGN KEY (`posting_id`) REFERENCES `postings` (`id`),
+    UNIQUE `uk_posting_id` (`posting_id`)
+)COMMENT '投稿の件数テーブル。いいねとコメントと同じトランザクションで増減させ、ずれは定期ジョブが数え直す。行がなければ0件。';
+
+CREATE TABLE IF NOT EXISTS `user_counters` (
+    `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT 'サロゲートキー',
+    `user_id` INT NOT NULL,
+    `posting_count` INT NOT NULL DEFAULT 0 COMMENT '投稿数。公開前の予約投稿も含む。',
+    `like_count` INT NOT NULL DEFAULT 0 COMMENT 'いいねした数',
+    `liked_count` INT NOT NULL DEFAULT 0 COMMENT '投稿がいいねされた数',
+    `follow_count` INT NOT NULL DEFAULT 0 COMMENT 'フォローしている数',
+    `followed_count` INT NOT NULL DEFAULT 0 COMMENT 'フォローされている数',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP on UPDATE CURRENT_TIMESTAMP COMMENT '更新日時',
+    CONSTRAINT `user_counters_user_id` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`),
+    UNIQUE `uk_user_id` (`user_id`)
+)COMMENT 'ユーザの件数テーブル。投稿、いいね、フォローと同じトランザクションで増減させ、ずれは定期ジョブが数え直す。行がなければ0件。';
+
+ALTER TABLE `postings` ADD INDEX idx_postings_user_id_publish_at(user_id, publish_at) COMMENT '公開前の予約投稿を数える用';
+
+INSERT INTO `posting_counters` (`posting_id`, `liked_count`, `comment_count`)
+SELECT `p`.`id`,
+    (SELECT COUNT(*) FROM `likes` AS `l` WHERE `l`.`posting_id` = `p`.`id`),
+    (SELECT COUNT(*) FROM `comments` AS `c` WHERE `c`.`posting_id` = `p`.`id`)
+FROM `postings` AS `p`
+ON DUPLICATE KEY UPDATE `liked_count` = VALUES(`liked_count`), `comment_count` = VALUES(`comment_count`);
+
+INSERT INTO `user_counters` (`user_id`, `posting_count`, `like_count`, `liked_count`, `follow_count`, `followed_count`)
+SELECT `u`.`id`,
+    (SELECT COUNT(*) FROM `postings` AS `p` WHERE `p`.`user_id` = `u`.`id`),
+    (SELECT COUNT(*) FROM `likes` AS `l` WHERE `l`.`user_id` = `u`.`id`),
+    (SELECT COUNT(*) FROM `likes` AS `l` INNER JOIN `postings` AS `p` ON `l`.`posting_id` = `p`.`id` WHERE `p`.`user_id` = `u`.`id`),
+    (SELECT COUNT(*) FROM `follows` AS `f` WHERE `f`.`following_user_id` = `u`.`id`),
+    (SELECT COUNT(*) FROM `follows` AS `f` WHERE `f`.`followed_user_id` = `u`.`id`)
+FROM `users` AS `u`
+ON DUPLICATE KEY UPDATE `posting_count` = VALUES(`posting_count`), `like_count` = VALUES(`like_count`), `liked_count` = VALUES(`liked_count`), `follow_count` = VALUES(`follow_count`), `followed_count` = VALUES(`followed_count`);
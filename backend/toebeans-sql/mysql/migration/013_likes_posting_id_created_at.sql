@@ -0,0 +1,2 @@
+-- 既存DB向け。投稿にいいねしたユーザを新しい順に取得するためのインデックスを追加する。
+ALTER TABLE `likes` ADD INDEX idx_likes_posting_id_created_at(posting_id, created_at) COMMENT '投稿にいいねしたユーザを新しい順に取得する用';
@@ -0,0 +1,22 @@
+-- 既存DB向け。タグテーブルと投稿タグテーブルを作成する。
+-- 既存の投稿のタイトルに含まれるハッシュタグは、このあと go run ./cmd/backfill-tags で紐付ける。
+CREATE TABLE IF NOT EXISTS `tags` (
+    `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT 'サロゲートキー',
+    `name` VARCHAR(100) NOT NULL COMMENT '#を除き小文字に正規化したタグ名',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP on UPDATE CURRENT_TIMESTAMP COMMENT '更新日時',
+    UNIQUE `uk_name` (`name`)
+)COMMENT 'タグテーブル';
+
+CREATE TABLE IF NOT EXISTS `posting_tags` (
+    `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT 'サロゲートキー',
+    `posting_id` INT NOT NULL,
+    `tag_id` INT NOT NULL,
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP on UPDATE CURRENT_TIMESTAMP COMMENT '更新日時',
+    CONSTRAINT `posting_tags_posting_id` FOREIGN KEY (`posting_id`) REFERENCES `postings` (`id`),
+    CONSTRAINT `posting_tags_tag_id` FOREIGN KEY (`tag_id`) REFERENCES `tags` (`id`),
+    UNIQUE `uk_posting_id_tag_id` (`posting_id`, `tag_id`),
+    INDEX idx_posting_tags_tag_id_created_at(tag_id, created_at),
+    INDEX idx_posting_tags_created_at(created_at)
+)COMMENT '投稿タグテーブル';
@@ -0,0 +1,6 @@
+-- 既存DB向け。予約投稿は公開されてから投稿数に数えるので、公開済みの投稿だけで数え直す。公開時刻を過ぎた予約投稿は定期ジョブが公開済みにして数える。
+ALTER TABLE `postings` MODIFY COLUMN `publish_at` DATETIME DEFAULT NULL COMMENT '予約投稿の公開日時。即時公開と公開済みはNULL。公開時刻を過ぎたら定期ジョブがNULLにして投稿数に数える。';
+ALTER TABLE `user_counters` MODIFY COLUMN `posting_count` INT NOT NULL DEFAULT 0 COMMENT '投稿数。予約投稿は公開されてから数える。';
+
+UPDATE `user_counters` AS `uc`
+SET `posting_count` = (SELECT COUNT(*) FROM `postings` AS `p` WHERE `p`.`user_id` = `uc`.`user_id` AND `p`.`publish_at` IS NULL);
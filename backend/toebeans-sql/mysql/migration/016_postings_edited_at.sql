@@ -0,0 +1,11 @@
+-- 既存DB向け。投稿タイトルの編集日時と編集履歴テーブルを追加する。既存の投稿は未編集なのでNULLのままでよい。
+ALTER TABLE `postings` ADD COLUMN `edited_at` DATETIME DEFAULT NULL COMMENT 'タイトル編集日時。未編集ならNULL。' AFTER `alt_text`;
+
+CREATE TABLE IF NOT EXISTS `posting_title_histories` (
+    `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY COMMENT 'サロゲートキー',
+    `posting_id` INT NOT NULL COMMENT 'モデレーション用に投稿削除後も残すため外部キーにしない',
+    `title` VARCHAR(255) NOT NULL COMMENT '編集前のタイトル',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '作成日時',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP on UPDATE CURRENT_TIMESTAMP COMMENT '更新日時',
+    INDEX idx_posting_title_histories_posting_id(posting_id)
+)COMMENT '投稿タイトル編集履歴テーブル';
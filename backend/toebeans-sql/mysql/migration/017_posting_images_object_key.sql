@@ -0,0 +1,2 @@
+-- 既存DB向け。S3オブジェクトを削除する前に投稿画像からの参照が残っていないか調べるためのインデックスを追加する。
+ALTER TABLE `posting_images` ADD INDEX idx_posting_images_object_key(object_key) COMMENT 'S3オブジェクトを削除する前に参照が残っていないか調べる用';
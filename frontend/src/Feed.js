@@ -14,7 +14,7 @@ import "./common.css";
 
 function Feed() {
   const [posts, setPosts] = useState([]);
-  const [sinceAt, setSinceAt] = useState("2100-01-01T00:00:00+09:00");
+  const [cursor, setCursor] = useState("");
   const [hasMore, setHasMore] = useState(true); //再読み込み判定
   const [errMessage, setErrMessage] = useState("");
   const history = useHistory();
@@ -45,19 +45,15 @@ function Feed() {
 
   const getPosts = async () => {
     await axios
-      .get(`/postings?since_at=${sinceAt}&limit=10`)
+      .get(`/postings?cursor=${cursor}&limit=10`)
       .then((response) => {
-        if (response.data.postings.length < 10) {
+        setPosts([...posts, ...response.data.postings]);
+        // next_cursor がなければ最後のページ
+        if (response.data.next_cursor) {
+          setCursor(response.data.next_cursor);
+        } else {
           setHasMore(false);
         }
-        if (response.data.postings.length !== 0) {
-          setPosts([...posts, ...response.data.postings]);
-          // 取得データのうち一番古い uploaded_at を次のリクエスト用に保持しておく
-          setSinceAt(
-            response.data.postings[response.data.postings.length - 1]
-              .uploaded_at
-          );
-        }
       })
       .catch((error) => {
         if (error.response) {
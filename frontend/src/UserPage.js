@@ -26,7 +26,7 @@ const UserPage = (props) => {
   const [createdAt, setCreatedAt] = useState("");
   const [isFollow, setIsFollow] = useState(false);
   const [posts, setPosts] = useState([]);
-  const [sinceAt, setSinceAt] = useState("2100-01-01T00:00:00+09:00");
+  const [cursor, setCursor] = useState("");
   const [hasMore, setHasMore] = useState(true);
   const [errMessage, setErrMessage] = useState("");
 
@@ -134,18 +134,15 @@ const UserPage = (props) => {
 
   const getUserPosts = async () => {
     await axios
-      .get(`/postings?since_at=${sinceAt}&limit=10&user_name=${userName}`)
+      .get(`/postings?cursor=${cursor}&limit=10&user_name=${userName}`)
       .then((response) => {
-        if (response.data.postings.length < 10) {
+        setPosts([...posts, ...response.data.postings]);
+        // next_cursor がなければ最後のページ
+        if (response.data.next_cursor) {
+          setCursor(response.data.next_cursor);
+        } else {
           setHasMore(false);
         }
-        if (response.data.postings.length !== 0) {
-          setPosts([...posts, ...response.data.postings]);
-          setSinceAt(
-            response.data.postings[response.data.postings.length - 1]
-              .uploaded_at
-          );
-        }
       })
       .catch((error) => {
         if (error.response) {